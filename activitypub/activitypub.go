@@ -0,0 +1,230 @@
+// Package activitypub implements the parts of ActivityPub the services use
+// to federate: the vocabulary of actors, notes and activities, the IRIs of
+// local objects, HTTP Signatures, and a client that fetches remote actors
+// and delivers activities to their inboxes.
+package activitypub
+
+import (
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strings"
+)
+
+const (
+	// ContentType is the media type of ActivityPub documents
+	ContentType = "application/activity+json"
+
+	// LDContentType is the JSON-LD form of ContentType, which some servers
+	// ask for instead
+	LDContentType = `application/ld+json; profile="https://www.w3.org/ns/activitystreams"`
+
+	// Public addresses an object to everyone
+	Public = "https://www.w3.org/ns/activitystreams#Public"
+)
+
+// Context is the JSON-LD context of the documents we serve and send
+var Context = []string{"https://www.w3.org/ns/activitystreams", "https://w3id.org/security/v1"}
+
+// ErrNoObject is returned for an activity without an object
+var ErrNoObject = errors.New("activity has no object")
+
+// Actor is a user, as served from their actor IRI and fetched from other
+// servers
+type Actor struct {
+	Context                   any        `json:"@context,omitempty"`
+	ID                        string     `json:"id"`
+	Type                      string     `json:"type"`
+	PreferredUsername         string     `json:"preferredUsername"`
+	Name                      string     `json:"name,omitempty"`
+	Summary                   string     `json:"summary,omitempty"`
+	URL                       string     `json:"url,omitempty"`
+	Icon                      *Document  `json:"icon,omitempty"`
+	Inbox                     string     `json:"inbox"`
+	Outbox                    string     `json:"outbox,omitempty"`
+	Followers                 string     `json:"followers,omitempty"`
+	Endpoints                 *Endpoints `json:"endpoints,omitempty"`
+	PublicKey                 *PublicKey `json:"publicKey,omitempty"`
+	ManuallyApprovesFollowers bool       `json:"manuallyApprovesFollowers"`
+	Published                 string     `json:"published,omitempty"`
+}
+
+// Endpoints lists an actor's server-wide endpoints
+type Endpoints struct {
+	SharedInbox string `json:"sharedInbox,omitempty"`
+}
+
+// PublicKey is the key an actor signs requests with
+type PublicKey struct {
+	ID           string `json:"id"`
+	Owner        string `json:"owner"`
+	PublicKeyPem string `json:"publicKeyPem"`
+}
+
+// Document is an image, as an avatar or a note attachment
+type Document struct {
+	Type      string `json:"type"`
+	MediaType string `json:"mediaType,omitempty"`
+	URL       string `json:"url"`
+	Name      string `json:"name,omitempty"`
+}
+
+// Tag is a mention in a note
+type Tag struct {
+	Type string `json:"type"`
+	Href string `json:"href"`
+	Name string `json:"name"`
+}
+
+// Note is a post
+type Note struct {
+	Context      any        `json:"@context,omitempty"`
+	ID           string     `json:"id"`
+	Type         string     `json:"type"`
+	AttributedTo string     `json:"attributedTo"`
+	Content      string     `json:"content"`
+	URL          string     `json:"url,omitempty"`
+	Published    string     `json:"published"`
+	Updated      string     `json:"updated,omitempty"`
+	To           []string   `json:"to"`
+	Cc           []string   `json:"cc,omitempty"`
+	Tag          []Tag      `json:"tag,omitempty"`
+	Attachment   []Document `json:"attachment,omitempty"`
+}
+
+// Tombstone replaces a deleted note
+type Tombstone struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// Activity is an activity we send or serve. Object is a Note, Tombstone,
+// embedded Activity or IRI.
+type Activity struct {
+	Context   any      `json:"@context,omitempty"`
+	ID        string   `json:"id"`
+	Type      string   `json:"type"`
+	Actor     string   `json:"actor"`
+	Object    any      `json:"object"`
+	To        []string `json:"to,omitempty"`
+	Cc        []string `json:"cc,omitempty"`
+	Published string   `json:"published,omitempty"`
+}
+
+// OrderedCollection is an outbox or followers collection. Outboxes link to
+// their first page rather than listing items.
+type OrderedCollection struct {
+	Context    any    `json:"@context,omitempty"`
+	ID         string `json:"id"`
+	Type       string `json:"type"`
+	TotalItems *int   `json:"totalItems,omitempty"`
+	First      string `json:"first,omitempty"`
+}
+
+// OrderedCollectionPage is a page of an outbox, newest first
+type OrderedCollectionPage struct {
+	Context      any         `json:"@context,omitempty"`
+	ID           string      `json:"id"`
+	Type         string      `json:"type"`
+	PartOf       string      `json:"partOf"`
+	Next         string      `json:"next,omitempty"`
+	OrderedItems []*Activity `json:"orderedItems"`
+}
+
+// Incoming is an activity received in an inbox. Its object is kept raw,
+// since it may be an IRI or an embedded object.
+type Incoming struct {
+	ID     string          `json:"id"`
+	Type   string          `json:"type"`
+	Actor  string          `json:"actor"`
+	Object json.RawMessage `json:"object"`
+}
+
+// ObjectID returns the IRI of the activity's object, whether it is
+// referenced or embedded
+func (a *Incoming) ObjectID() (string, error) {
+	var iri string
+	if err := json.Unmarshal(a.Object, &iri); err == nil {
+		return iri, nil
+	}
+	embedded, err := a.Embedded()
+	if err != nil {
+		return "", err
+	}
+	return embedded.ID, nil
+}
+
+// Embedded parses an object embedded in the activity, such as the Follow
+// an Undo takes back
+func (a *Incoming) Embedded() (*Incoming, error) {
+	if len(a.Object) == 0 {
+		return nil, ErrNoObject
+	}
+	var embedded Incoming
+	if err := json.Unmarshal(a.Object, &embedded); err != nil {
+		return nil, err
+	}
+	return &embedded, nil
+}
+
+// IRIs builds the IDs of local actors and objects from the public URL of
+// the server that serves them. The service serving the documents and the
+// one delivering activities must agree on it.
+type IRIs struct {
+	BaseURL string // e.g. http://localhost:8080
+}
+
+// Host returns the domain of acct: handles, e.g. localhost:8080
+func (i IRIs) Host() string {
+	u, err := url.Parse(i.BaseURL)
+	if err != nil {
+		return ""
+	}
+	return u.Host
+}
+
+// Actor returns the IRI of a local user
+func (i IRIs) Actor(username string) string {
+	return i.BaseURL + "/users/" + url.PathEscape(username)
+}
+
+// Key returns the ID of a local user's public key
+func (i IRIs) Key(username string) string {
+	return i.Actor(username) + "#main-key"
+}
+
+// Inbox returns a local user's inbox
+func (i IRIs) Inbox(username string) string {
+	return i.Actor(username) + "/inbox"
+}
+
+// Outbox returns a local user's outbox
+func (i IRIs) Outbox(username string) string {
+	return i.Actor(username) + "/outbox"
+}
+
+// Followers returns a local user's followers collection
+func (i IRIs) Followers(username string) string {
+	return i.Actor(username) + "/followers"
+}
+
+// SharedInbox returns the inbox shared by every local user
+func (i IRIs) SharedInbox() string {
+	return i.BaseURL + "/inbox"
+}
+
+// Post returns the IRI of a local post, which is served as a note
+func (i IRIs) Post(username string, postID string) string {
+	return i.Actor(username) + "/posts/" + url.PathEscape(postID)
+}
+
+// Username returns the local user an actor IRI names, or false if it isn't
+// a local actor
+func (i IRIs) Username(actorIRI string) (string, bool) {
+	rest, found := strings.CutPrefix(actorIRI, i.BaseURL+"/users/")
+	if !found || rest == "" || strings.ContainsAny(rest, "/#?") {
+		return "", false
+	}
+	username, err := url.PathUnescape(rest)
+	return username, err == nil
+}
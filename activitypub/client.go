@@ -0,0 +1,129 @@
+package activitypub
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/paper-social/feed-service/linkpreview"
+)
+
+// maxDocumentBytes caps the size of fetched actor documents and of
+// responses read from inboxes
+const maxDocumentBytes = 1 << 20
+
+// Client talks to other servers. Like link previews and webhooks, it
+// refuses to reach addresses that aren't publicly routable.
+type Client struct {
+	http      *http.Client
+	userAgent string
+}
+
+// NewClient creates a client. allowPrivateNetworks lets it reach loopback
+// and private addresses, only for local testing.
+func NewClient(timeout time.Duration, userAgent string, allowPrivateNetworks bool) *Client {
+	dialer := linkpreview.NewDialer(timeout, allowPrivateNetworks)
+	return &Client{
+		http: &http.Client{
+			Transport: &http.Transport{
+				DialContext:           dialer.DialContext,
+				TLSHandshakeTimeout:   timeout,
+				ResponseHeaderTimeout: timeout,
+				MaxIdleConns:          10,
+				IdleConnTimeout:       30 * time.Second,
+			},
+			Timeout: timeout,
+		},
+		userAgent: userAgent,
+	}
+}
+
+// FetchActor fetches a remote actor document. The document must name
+// itself with the IRI it was fetched from, so a server can't pass off
+// another's actor.
+func (c *Client) FetchActor(ctx context.Context, iri string) (*Actor, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, iri, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", ContentType+", "+LDContentType)
+	req.Header.Set("User-Agent", c.userAgent)
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching actor %s: %s", iri, resp.Status)
+	}
+
+	var actor Actor
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxDocumentBytes)).Decode(&actor); err != nil {
+		return nil, fmt.Errorf("decoding actor %s: %w", iri, err)
+	}
+	if actor.ID != iri {
+		return nil, fmt.Errorf("actor fetched from %s is %s", iri, actor.ID)
+	}
+	if actor.Inbox == "" || actor.PublicKey == nil {
+		return nil, fmt.Errorf("actor %s has no inbox or public key", iri)
+	}
+	return &actor, nil
+}
+
+// KeyOwner fetches the actor a key ID belongs to, for verifying a request
+// signed with it, and returns the actor with its parsed key
+func (c *Client) KeyOwner(ctx context.Context, keyID string) (*Actor, *rsa.PublicKey, error) {
+	iri, _, _ := strings.Cut(keyID, "#")
+	actor, err := c.FetchActor(ctx, iri)
+	if err != nil {
+		return nil, nil, err
+	}
+	if actor.PublicKey.ID != keyID || actor.PublicKey.Owner != actor.ID {
+		return nil, nil, fmt.Errorf("key %s does not belong to actor %s", keyID, actor.ID)
+	}
+
+	key, err := ParsePublicKey(actor.PublicKey.PublicKeyPem)
+	if err != nil {
+		return nil, nil, err
+	}
+	return actor, key, nil
+}
+
+// Deliver posts an activity to an inbox, signed with a local user's key
+func (c *Client) Deliver(ctx context.Context, inbox string, activity *Activity, keyID string, key *rsa.PrivateKey) error {
+	document := *activity
+	document.Context = Context
+	body, err := json.Marshal(&document)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, inbox, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", ContentType)
+	req.Header.Set("User-Agent", c.userAgent)
+	if err := Sign(req, body, keyID, key); err != nil {
+		return err
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, maxDocumentBytes))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("inbox %s responded %s", inbox, resp.Status)
+	}
+	return nil
+}
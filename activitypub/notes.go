@@ -0,0 +1,148 @@
+package activitypub
+
+import (
+	"html"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Post is what a note is made of, independent of the services' post types
+type Post struct {
+	ID        string
+	Content   string
+	URL       string // The post's web page, if any
+	Published time.Time
+	Updated   time.Time // Zero unless edited
+	Links     []Link
+	Images    []Document
+}
+
+// Link is a link or mention in post content, by byte offsets
+type Link struct {
+	Start int
+	End   int
+	Href  string // Empty for mentions
+	// Mention is the username of the local user mentioned
+	Mention string
+}
+
+// Note returns a public post of a local user as a note, addressed to
+// everyone and copied to the user's followers and anyone mentioned
+func (i IRIs) Note(username string, p Post) *Note {
+	note := &Note{
+		ID:           i.Post(username, p.ID),
+		Type:         "Note",
+		AttributedTo: i.Actor(username),
+		Content:      i.contentHTML(p),
+		URL:          p.URL,
+		Published:    p.Published.UTC().Format(time.RFC3339),
+		To:           []string{Public},
+		Cc:           []string{i.Followers(username)},
+		Attachment:   p.Images,
+	}
+	if !p.Updated.IsZero() {
+		note.Updated = p.Updated.UTC().Format(time.RFC3339)
+	}
+	for _, l := range p.Links {
+		if l.Mention == "" {
+			continue
+		}
+		actor := i.Actor(l.Mention)
+		note.Tag = append(note.Tag, Tag{Type: "Mention", Href: actor, Name: "@" + l.Mention + "@" + i.Host()})
+		note.Cc = append(note.Cc, actor)
+	}
+	return note
+}
+
+// contentHTML renders post content as the HTML other servers display,
+// with links and mentions as anchors and line breaks kept
+func (i IRIs) contentHTML(p Post) string {
+	links := append([]Link(nil), p.Links...)
+	sort.Slice(links, func(a, b int) bool { return links[a].Start < links[b].Start })
+
+	var b strings.Builder
+	b.WriteString("<p>")
+	pos := 0
+	for _, l := range links {
+		if l.Start < pos || l.End > len(p.Content) {
+			continue
+		}
+
+		writeText(&b, p.Content[pos:l.Start])
+		if l.Mention != "" {
+			b.WriteString(`<a href="` + html.EscapeString(i.Actor(l.Mention)) + `" class="u-url mention">`)
+		} else {
+			b.WriteString(`<a href="` + html.EscapeString(l.Href) + `" rel="nofollow noopener noreferrer">`)
+		}
+		writeText(&b, p.Content[l.Start:l.End])
+		b.WriteString("</a>")
+		pos = l.End
+	}
+	writeText(&b, p.Content[pos:])
+	b.WriteString("</p>")
+	return b.String()
+}
+
+// writeText writes escaped text, turning newlines into line breaks
+func writeText(b *strings.Builder, s string) {
+	b.WriteString(strings.ReplaceAll(html.EscapeString(s), "\n", "<br>"))
+}
+
+// Create wraps a new note in the activity announcing it
+func Create(note *Note) *Activity {
+	return &Activity{
+		ID:        note.ID + "/activity",
+		Type:      "Create",
+		Actor:     note.AttributedTo,
+		Object:    note,
+		To:        note.To,
+		Cc:        note.Cc,
+		Published: note.Published,
+	}
+}
+
+// Update wraps an edited note in the activity announcing the edit. Each
+// edit needs its own ID, so it is made unique by the time of the edit.
+func Update(note *Note, at time.Time) *Activity {
+	return &Activity{
+		ID:        note.ID + "#updates/" + at.UTC().Format("20060102T150405.000000000Z"),
+		Type:      "Update",
+		Actor:     note.AttributedTo,
+		Object:    note,
+		To:        note.To,
+		Cc:        note.Cc,
+		Published: at.UTC().Format(time.RFC3339),
+	}
+}
+
+// Delete announces that a local user deleted a post
+func Delete(iris IRIs, username string, postID string) *Activity {
+	id := iris.Post(username, postID)
+	return &Activity{
+		ID:     id + "#delete",
+		Type:   "Delete",
+		Actor:  iris.Actor(username),
+		Object: &Tombstone{ID: id, Type: "Tombstone"},
+		To:     []string{Public},
+		Cc:     []string{iris.Followers(username)},
+	}
+}
+
+// Accept answers a remote actor's follow of a local user. id must be
+// unique to this acceptance.
+func Accept(iris IRIs, username string, id string, followID string, followerIRI string) *Activity {
+	actor := iris.Actor(username)
+	return &Activity{
+		ID:    actor + "#accepts/" + id,
+		Type:  "Accept",
+		Actor: actor,
+		Object: &Activity{
+			ID:     followID,
+			Type:   "Follow",
+			Actor:  followerIRI,
+			Object: actor,
+		},
+		To: []string{followerIRI},
+	}
+}
@@ -0,0 +1,188 @@
+package activitypub
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testKeys are generated once, since RSA key generation is slow
+var testKeys = sync.OnceValues(func() ([2]*rsa.PrivateKey, error) {
+	var keys [2]*rsa.PrivateKey
+	for i := range keys {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			return keys, err
+		}
+		keys[i] = key
+	}
+	return keys, nil
+})
+
+// testKey returns one of two test keys
+func testKey(t *testing.T, i int) *rsa.PrivateKey {
+	t.Helper()
+	keys, err := testKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return keys[i]
+}
+
+func TestSignVerify(t *testing.T) {
+	key := testKey(t, 0)
+	const keyID = "https://remote.example/users/alice#main-key"
+	activity := []byte(`{"type":"Follow","actor":"https://remote.example/users/alice"}`)
+
+	// The server verifies requests as it receives them, with the Host
+	// header moved to req.Host
+	verified := make(chan error, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if id, err := SignatureKeyID(r); err == nil && id != keyID {
+			t.Errorf("SignatureKeyID() = %q, want %q", id, keyID)
+		}
+		verified <- Verify(r, body, &key.PublicKey)
+	}))
+	defer server.Close()
+
+	// errRejected stands for any error
+	errRejected := errors.New("rejected")
+
+	tests := []struct {
+		name     string
+		body     []byte
+		signWith *rsa.PrivateKey // Defaults to key
+		tamper   func(req *http.Request, body *[]byte)
+		want     error // nil for a valid request
+	}{
+		{name: "signed body", body: activity},
+		{name: "signed without body"},
+		{
+			name:   "body changed",
+			body:   activity,
+			tamper: func(req *http.Request, body *[]byte) { *body = []byte(`{"type":"Delete"}`) },
+			want:   ErrDigestMismatch,
+		},
+		{
+			name: "body and digest changed",
+			body: activity,
+			tamper: func(req *http.Request, body *[]byte) {
+				*body = []byte(`{"type":"Delete"}`)
+				req.Header.Set("Digest", digest(*body))
+			},
+			want: ErrInvalidSignature,
+		},
+		{
+			name: "date changed",
+			body: activity,
+			tamper: func(req *http.Request, body *[]byte) {
+				req.Header.Set("Date", time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+			},
+			want: ErrInvalidSignature,
+		},
+		{
+			name: "date too old",
+			body: activity,
+			tamper: func(req *http.Request, body *[]byte) {
+				req.Header.Set("Date", time.Now().Add(-MaxSignatureAge-time.Hour).UTC().Format(http.TimeFormat))
+			},
+			want: ErrSignatureExpired,
+		},
+		{
+			name:   "path changed",
+			body:   activity,
+			tamper: func(req *http.Request, body *[]byte) { req.URL.Path = "/users/bob/inbox" },
+			want:   ErrInvalidSignature,
+		},
+		{
+			name:   "host changed",
+			body:   activity,
+			tamper: func(req *http.Request, body *[]byte) { req.Host = "other.example" },
+			want:   ErrInvalidSignature,
+		},
+		{
+			name:   "signature removed",
+			body:   activity,
+			tamper: func(req *http.Request, body *[]byte) { req.Header.Del("Signature") },
+			want:   ErrNotSigned,
+		},
+		{
+			name: "digest not signed",
+			body: activity,
+			tamper: func(req *http.Request, body *[]byte) {
+				req.Header.Set("Signature", `keyId="`+keyID+`",headers="(request-target) host date",signature="AAAA"`)
+			},
+			want: errRejected,
+		},
+		{
+			name:     "other key",
+			body:     activity,
+			signWith: testKey(t, 1),
+			want:     ErrInvalidSignature,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPost, server.URL+"/users/alice/inbox", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			signWith := key
+			if tt.signWith != nil {
+				signWith = tt.signWith
+			}
+			if err := Sign(req, tt.body, keyID, signWith); err != nil {
+				t.Fatal(err)
+			}
+
+			body := tt.body
+			if tt.tamper != nil {
+				tt.tamper(req, &body)
+			}
+			req.Body = io.NopCloser(bytes.NewReader(body))
+			req.ContentLength = int64(len(body))
+
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+
+			switch err := <-verified; {
+			case tt.want == nil && err != nil:
+				t.Errorf("Verify() = %v, want a valid signature", err)
+			case tt.want != nil && err == nil:
+				t.Error("Verify() accepted the request")
+			case tt.want != nil && tt.want != errRejected && !errors.Is(err, tt.want):
+				t.Errorf("Verify() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestPublicKeyRoundTrip(t *testing.T) {
+	key := testKey(t, 0)
+	text, err := EncodePublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := ParsePublicKey(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !parsed.Equal(&key.PublicKey) {
+		t.Error("parsed key differs from the encoded one")
+	}
+
+	if _, err := ParsePublicKey("not a key"); err == nil {
+		t.Error("ParsePublicKey() accepted text that isn't PEM")
+	}
+}
@@ -90,7 +90,7 @@ func main() {
 	fmt.Println("🚀 Access GraphQL Playground: http://localhost:8080")
 	fmt.Println("📋 Try the example queries in the README.md file")
 	fmt.Println("⚠️ Press Ctrl+C to stop all services")
-	fmt.Println("----------------------------------------------------------\n")
+	fmt.Print("----------------------------------------------------------\n\n")
 
 	// Set up signal catching
 	signals := make(chan os.Signal, 1)
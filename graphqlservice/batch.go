@@ -0,0 +1,47 @@
+package graphqlservice
+
+import (
+	"context"
+	"log"
+
+	"github.com/paper-social/feed-service/proto/post"
+	"github.com/paper-social/feed-service/proto/user"
+)
+
+// UsersByIDs gets several users in one call, keyed by ID. Unknown IDs are
+// missing from the result.
+func (s *Service) UsersByIDs(ctx context.Context, ids []string) (map[string]*User, error) {
+	// Call the user service for the whole batch
+	resp, err := s.postClient.GetUsers(ctx, &user.GetUsersRequest{Ids: ids})
+	if err != nil {
+		log.Printf("Error fetching %d users: %v", len(ids), err)
+		return nil, err
+	}
+
+	users := make(map[string]*User, len(resp.Users))
+	for _, u := range resp.Users {
+		users[u.Id] = userFromProto(u)
+	}
+	return users, nil
+}
+
+// PostsByIDs gets several posts as seen by viewerID in one call, keyed by
+// ID. Posts that don't exist or the viewer can't see are missing from the
+// result.
+func (s *Service) PostsByIDs(ctx context.Context, viewerID string, ids []string) (map[string]*Post, error) {
+	// Call the post service for the whole batch
+	resp, err := s.postClient.GetPosts(ctx, &post.GetPostsRequest{
+		Ids:      ids,
+		ViewerId: viewerID,
+	})
+	if err != nil {
+		log.Printf("Error fetching %d posts: %v", len(ids), err)
+		return nil, err
+	}
+
+	posts := make(map[string]*Post, len(resp.Posts))
+	for _, p := range resp.Posts {
+		posts[p.Id] = postFromProto(p)
+	}
+	return posts, nil
+}
@@ -0,0 +1,129 @@
+package graphqlservice
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/paper-social/feed-service/proto/user"
+)
+
+// MutedUser is a muted account and when the mute ends
+type MutedUser struct {
+	User      *User  `json:"user"`
+	ExpiresAt string `json:"expiresAt,omitempty"` // RFC3339, empty if the mute never ends
+}
+
+// mutedUserFromProto converts a proto mute to our MutedUser type
+func mutedUserFromProto(m *user.MutedUser) *MutedUser {
+	muted := &MutedUser{User: userFromProto(m.User)}
+	if m.ExpiresAt != 0 {
+		muted.ExpiresAt = time.Unix(m.ExpiresAt, 0).Format(time.RFC3339)
+	}
+	return muted
+}
+
+// BlockUser blocks targetID for userID
+func (s *Service) BlockUser(ctx context.Context, userID string, targetID string) (*User, error) {
+	// Call the user service to block
+	resp, err := s.postClient.BlockUser(ctx, &user.UserRelationRequest{
+		UserId:   userID,
+		TargetId: targetID,
+	})
+	if err != nil {
+		log.Printf("Error blocking user: %v", err)
+		return nil, err
+	}
+
+	return userFromProto(resp), nil
+}
+
+// UnblockUser lifts userID's block of targetID
+func (s *Service) UnblockUser(ctx context.Context, userID string, targetID string) (*User, error) {
+	// Call the user service to unblock
+	resp, err := s.postClient.UnblockUser(ctx, &user.UserRelationRequest{
+		UserId:   userID,
+		TargetId: targetID,
+	})
+	if err != nil {
+		log.Printf("Error unblocking user: %v", err)
+		return nil, err
+	}
+
+	return userFromProto(resp), nil
+}
+
+// MuteUser hides targetID's posts from userID's timeline until expiresAt
+// (RFC3339), or indefinitely if expiresAt is empty
+func (s *Service) MuteUser(ctx context.Context, userID string, targetID string, expiresAt string) (*MutedUser, error) {
+	req := &user.MuteUserRequest{
+		UserId:   userID,
+		TargetId: targetID,
+	}
+	if expiresAt != "" {
+		t, err := time.Parse(time.RFC3339, expiresAt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid mute expiry: %w", err)
+		}
+		req.ExpiresAt = t.Unix()
+	}
+
+	// Call the user service to mute
+	resp, err := s.postClient.MuteUser(ctx, req)
+	if err != nil {
+		log.Printf("Error muting user: %v", err)
+		return nil, err
+	}
+
+	return mutedUserFromProto(resp), nil
+}
+
+// UnmuteUser lifts userID's mute of targetID
+func (s *Service) UnmuteUser(ctx context.Context, userID string, targetID string) (*User, error) {
+	// Call the user service to unmute
+	resp, err := s.postClient.UnmuteUser(ctx, &user.UserRelationRequest{
+		UserId:   userID,
+		TargetId: targetID,
+	})
+	if err != nil {
+		log.Printf("Error unmuting user: %v", err)
+		return nil, err
+	}
+
+	return userFromProto(resp), nil
+}
+
+// BlockedUsers lists the users userID has blocked
+func (s *Service) BlockedUsers(ctx context.Context, userID string) ([]*User, error) {
+	// Call the user service to list blocks
+	resp, err := s.postClient.ListBlockedUsers(ctx, &user.ListBlockedUsersRequest{UserId: userID})
+	if err != nil {
+		log.Printf("Error listing blocked users: %v", err)
+		return nil, err
+	}
+
+	users := make([]*User, 0, len(resp.Users))
+	for _, u := range resp.Users {
+		users = append(users, userFromProto(u))
+	}
+
+	return users, nil
+}
+
+// MutedUsers lists the users userID has muted
+func (s *Service) MutedUsers(ctx context.Context, userID string) ([]*MutedUser, error) {
+	// Call the user service to list mutes
+	resp, err := s.postClient.ListMutedUsers(ctx, &user.ListMutedUsersRequest{UserId: userID})
+	if err != nil {
+		log.Printf("Error listing muted users: %v", err)
+		return nil, err
+	}
+
+	muted := make([]*MutedUser, 0, len(resp.Users))
+	for _, m := range resp.Users {
+		muted = append(muted, mutedUserFromProto(m))
+	}
+
+	return muted, nil
+}
@@ -3,32 +3,65 @@ package main
 import (
 	"log"
 	"net/http"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
+	"github.com/gorilla/websocket"
 	"github.com/paper-social/feed-service/graphqlservice"
+	"github.com/paper-social/feed-service/graphqlservice/federation"
+	"github.com/paper-social/feed-service/graphqlservice/feed"
 	"github.com/paper-social/feed-service/graphqlservice/graph"
 	"github.com/paper-social/feed-service/graphqlservice/graph/generated"
-	"github.com/paper-social/feed-service/model"
+	"github.com/paper-social/feed-service/media"
+	"github.com/vektah/gqlparser/v2/ast"
 )
 
 func main() {
-	// Create in-memory database with mock data
-	db := model.NewDatabase()
-
 	// Connect to the internal post service
 	postServiceAddr := "localhost:50051"
 	log.Printf("Connecting to internal post service at %s", postServiceAddr)
 
+	// Store uploaded media on the local filesystem
+	blobs, err := media.NewFileStore("data/media")
+	if err != nil {
+		log.Fatalf("Failed to create media store: %v", err)
+	}
+	mediaService := media.NewService(blobs, media.DefaultConfig())
+
 	// Create service
-	service := graphqlservice.NewService(db, postServiceAddr)
+	service := graphqlservice.NewService(postServiceAddr, mediaService)
 
-	// Set up GraphQL server
-	srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{
+	// Set up GraphQL server. Queries and mutations are served over HTTP;
+	// subscriptions over WebSocket, where the client names its user in
+	// connection_init.
+	srv := handler.New(generated.NewExecutableSchema(generated.Config{
 		Resolvers: &graph.Resolver{
 			Service: service,
 		},
 	}))
+	srv.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+		Upgrader: websocket.Upgrader{
+			// Any origin may connect, as with CORS below
+			CheckOrigin: func(r *http.Request) bool { return true },
+		},
+		InitFunc: graph.WebsocketInit(service),
+	})
+	srv.AddTransport(transport.Options{})
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.MultipartForm{})
+
+	srv.SetQueryCache(lru.New[*ast.QueryDocument](1000))
+
+	srv.Use(extension.Introspection{})
+	srv.Use(extension.AutomaticPersistedQuery{
+		Cache: lru.New[string](100),
+	})
 
 	// Set up CORS middleware
 	setupCORS := func(h http.Handler) http.Handler {
@@ -51,8 +84,23 @@ func main() {
 	// Register the GraphQL playground at the root
 	http.Handle("/", playground.Handler("GraphQL Playground", "/query"))
 
-	// Register the GraphQL query handler with CORS support
-	http.Handle("/query", setupCORS(srv))
+	// Register the GraphQL query handler with CORS support. Every request
+	// gets its own dataloaders, so related objects such as post authors
+	// are fetched in batches across the whole response.
+	http.Handle("/query", setupCORS(graph.LoaderMiddleware(service, srv)))
+
+	// Serve uploaded media and thumbnails
+	http.Handle("/media/", setupCORS(http.StripPrefix("/media", mediaService.Handler())))
+
+	// Serve users' public posts as RSS, Atom and JSON feeds
+	feeds := feed.NewHandler(service, feed.DefaultConfig())
+	http.Handle("/users/", setupCORS(http.StripPrefix("/users", feeds)))
+
+	// Federate users' public posts over ActivityPub
+	federator := federation.NewHandler(service, federation.DefaultConfig())
+	for _, pattern := range federation.Patterns {
+		http.Handle(pattern, federator)
+	}
 
 	// Start the public GraphQL service
 	log.Println("Starting public GraphQL API...")
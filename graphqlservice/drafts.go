@@ -0,0 +1,89 @@
+package graphqlservice
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/paper-social/feed-service/proto/post"
+)
+
+// SaveDraft saves a post without publishing it. A draft replying to
+// replyToID becomes a reply when it's published.
+func (s *Service) SaveDraft(ctx context.Context, userID string, content string, mediaIDs []string, poll *PollInput, visibility string, replyToID string) (*Post, error) {
+	req, err := s.newCreatePostRequest(userID, content, mediaIDs, poll, visibility, replyToID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the post service to save the draft
+	resp, err := s.postClient.SaveDraft(ctx, req)
+	if err != nil {
+		log.Printf("Error saving draft: %v", err)
+		return nil, err
+	}
+
+	return postFromProto(resp), nil
+}
+
+// SchedulePost schedules a draft to be published at publishAt (RFC3339)
+func (s *Service) SchedulePost(ctx context.Context, id string, publishAt string) (*Post, error) {
+	t, err := time.Parse(time.RFC3339, publishAt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid publish time: %w", err)
+	}
+
+	// Call the post service to schedule the post
+	resp, err := s.postClient.SchedulePost(ctx, &post.SchedulePostRequest{
+		Id:        id,
+		PublishAt: t.Unix(),
+	})
+	if err != nil {
+		log.Printf("Error scheduling post: %v", err)
+		return nil, err
+	}
+
+	return postFromProto(resp), nil
+}
+
+// CancelScheduledPost turns a scheduled post back into a draft
+func (s *Service) CancelScheduledPost(ctx context.Context, id string) (*Post, error) {
+	// Call the post service to unschedule the post
+	resp, err := s.postClient.CancelScheduledPost(ctx, &post.CancelScheduledPostRequest{Id: id})
+	if err != nil {
+		log.Printf("Error cancelling scheduled post: %v", err)
+		return nil, err
+	}
+
+	return postFromProto(resp), nil
+}
+
+// PublishPost publishes a draft or scheduled post immediately
+func (s *Service) PublishPost(ctx context.Context, id string) (*Post, error) {
+	// Call the post service to publish the post
+	resp, err := s.postClient.PublishPost(ctx, &post.PublishPostRequest{Id: id})
+	if err != nil {
+		log.Printf("Error publishing post: %v", err)
+		return nil, err
+	}
+
+	return postFromProto(resp), nil
+}
+
+// Drafts lists a user's drafts and scheduled posts
+func (s *Service) Drafts(ctx context.Context, userID string) ([]*Post, error) {
+	// Call the post service to list the drafts
+	resp, err := s.postClient.ListDrafts(ctx, &post.ListDraftsRequest{UserId: userID})
+	if err != nil {
+		log.Printf("Error listing drafts: %v", err)
+		return nil, err
+	}
+
+	posts := make([]*Post, 0, len(resp.Posts))
+	for _, p := range resp.Posts {
+		posts = append(posts, postFromProto(p))
+	}
+
+	return posts, nil
+}
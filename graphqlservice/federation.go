@@ -0,0 +1,81 @@
+package graphqlservice
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/paper-social/feed-service/proto/user"
+)
+
+// RemoteFollower is an account on another server following a user over
+// ActivityPub
+type RemoteFollower struct {
+	UserID      string `json:"userId"`
+	ActorID     string `json:"actorId"` // The remote actor's IRI
+	Inbox       string `json:"inbox"`
+	SharedInbox string `json:"sharedInbox,omitempty"`
+	FollowID    string `json:"followId"` // The IRI of the Follow activity
+	FollowedAt  string `json:"followedAt"`
+}
+
+// remoteFollowerFromProto converts a proto remote follower to our
+// RemoteFollower type
+func remoteFollowerFromProto(f *user.RemoteFollower) *RemoteFollower {
+	return &RemoteFollower{
+		UserID:      f.UserId,
+		ActorID:     f.ActorId,
+		Inbox:       f.Inbox,
+		SharedInbox: f.SharedInbox,
+		FollowID:    f.FollowId,
+		FollowedAt:  time.Unix(f.FollowedAt, 0).Format(time.RFC3339),
+	}
+}
+
+// ActorPublicKey returns the PEM public key userID signs federated
+// requests with
+func (s *Service) ActorPublicKey(ctx context.Context, userID string) (string, error) {
+	// Call the user service to get the key
+	resp, err := s.postClient.GetActorKey(ctx, &user.ActorKeyRequest{UserId: userID})
+	if err != nil {
+		log.Printf("Error getting actor key of user %s: %v", userID, err)
+		return "", err
+	}
+
+	return resp.PublicKeyPem, nil
+}
+
+// AddRemoteFollower makes an account on another server follow userID. The
+// post service then accepts the follow on the user's behalf.
+func (s *Service) AddRemoteFollower(ctx context.Context, follower RemoteFollower) (*RemoteFollower, error) {
+	// Call the user service to add the follower
+	resp, err := s.postClient.AddRemoteFollower(ctx, &user.RemoteFollowerRequest{
+		UserId:      follower.UserID,
+		ActorId:     follower.ActorID,
+		Inbox:       follower.Inbox,
+		SharedInbox: follower.SharedInbox,
+		FollowId:    follower.FollowID,
+	})
+	if err != nil {
+		log.Printf("Error adding remote follower: %v", err)
+		return nil, err
+	}
+
+	return remoteFollowerFromProto(resp), nil
+}
+
+// RemoveRemoteFollower stops an account on another server following
+// userID
+func (s *Service) RemoveRemoteFollower(ctx context.Context, userID string, actorID string) (*RemoteFollower, error) {
+	// Call the user service to remove the follower
+	resp, err := s.postClient.RemoveRemoteFollower(ctx, &user.RemoteFollowerRequest{
+		UserId:  userID,
+		ActorId: actorID,
+	})
+	if err != nil {
+		log.Printf("Error removing remote follower: %v", err)
+		return nil, err
+	}
+
+	return remoteFollowerFromProto(resp), nil
+}
@@ -0,0 +1,338 @@
+// Package federation serves the ActivityPub side of Paper.Social:
+// WebFinger discovery, actor documents, outboxes, notes, and the inboxes
+// that take follows from other servers. Activities are delivered to other
+// servers by the post service.
+package federation
+
+import (
+	"encoding/json"
+	"html"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/paper-social/feed-service/activitypub"
+	"github.com/paper-social/feed-service/graphqlservice"
+)
+
+// Config controls the URLs of federated documents and how other servers
+// are reached
+type Config struct {
+	BaseURL  string // Public URL of this server, which actor IRIs start with; must match the post service's
+	WebURL   string // Public URL prefix of profile and post pages
+	PageSize int    // Activities per outbox page
+
+	Timeout   time.Duration // For fetching remote actors
+	UserAgent string
+
+	// AllowPrivateNetworks lets remote actors be fetched from loopback and
+	// private addresses. Only for local testing.
+	AllowPrivateNetworks bool
+}
+
+// DefaultConfig returns the configuration used by the GraphQL service
+func DefaultConfig() Config {
+	return Config{
+		BaseURL:   "http://localhost:8080",
+		WebURL:    "https://paper.social",
+		PageSize:  20,
+		Timeout:   10 * time.Second,
+		UserAgent: "PaperSocialFederation/1.0 (+https://paper.social)",
+	}
+}
+
+// Patterns lists the paths the handler serves, for registering it with a
+// ServeMux
+var Patterns = []string{
+	"/.well-known/webfinger",
+	"/users/{username}",
+	"/users/{username}/inbox",
+	"/users/{username}/outbox",
+	"/users/{username}/followers",
+	"/users/{username}/posts/{id}",
+	"/inbox",
+}
+
+// Handler serves the ActivityPub endpoints. Only accounts that aren't
+// protected are federated; protected ones are reported as not found, as
+// are posts that aren't public.
+type Handler struct {
+	service *graphqlservice.Service
+	cfg     Config
+	iris    activitypub.IRIs
+	client  *activitypub.Client
+	mux     *http.ServeMux
+}
+
+// NewHandler creates a federation handler reading users and posts through
+// service
+func NewHandler(service *graphqlservice.Service, cfg Config) *Handler {
+	h := &Handler{
+		service: service,
+		cfg:     cfg,
+		iris:    activitypub.IRIs{BaseURL: cfg.BaseURL},
+		client:  activitypub.NewClient(cfg.Timeout, cfg.UserAgent, cfg.AllowPrivateNetworks),
+		mux:     http.NewServeMux(),
+	}
+	h.mux.HandleFunc("GET /.well-known/webfinger", h.webFinger)
+	h.mux.HandleFunc("GET /users/{username}", h.actor)
+	h.mux.HandleFunc("GET /users/{username}/outbox", h.outbox)
+	h.mux.HandleFunc("GET /users/{username}/followers", h.followers)
+	h.mux.HandleFunc("GET /users/{username}/posts/{id}", h.note)
+	h.mux.HandleFunc("POST /users/{username}/inbox", h.inbox)
+	h.mux.HandleFunc("POST /inbox", h.inbox)
+	return h
+}
+
+// ServeHTTP implements http.Handler
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+// webFinger is a JSON Resource Descriptor, as WebFinger answers with
+type webFinger struct {
+	Subject string          `json:"subject"`
+	Aliases []string        `json:"aliases"`
+	Links   []webFingerLink `json:"links"`
+}
+
+// webFingerLink is a link of a JSON Resource Descriptor
+type webFingerLink struct {
+	Rel  string `json:"rel"`
+	Type string `json:"type"`
+	Href string `json:"href"`
+}
+
+// webFinger resolves acct:username@host, or an actor IRI, to the actor
+func (h *Handler) webFinger(w http.ResponseWriter, r *http.Request) {
+	resource := r.URL.Query().Get("resource")
+	username, found := h.iris.Username(resource)
+	if acct, isAcct := strings.CutPrefix(resource, "acct:"); isAcct {
+		var host string
+		username, host, found = strings.Cut(strings.TrimPrefix(acct, "@"), "@")
+		found = found && strings.EqualFold(host, h.iris.Host())
+	}
+	if !found {
+		http.Error(w, "unknown resource", http.StatusNotFound)
+		return
+	}
+
+	user := h.localUser(w, r, username)
+	if user == nil {
+		return
+	}
+
+	actor := h.iris.Actor(user.Username)
+	w.Header().Set("Content-Type", "application/jrd+json")
+	json.NewEncoder(w).Encode(&webFinger{
+		Subject: "acct:" + user.Username + "@" + h.iris.Host(),
+		Aliases: []string{actor, h.profileURL(user.Username)},
+		Links: []webFingerLink{
+			{Rel: "self", Type: activitypub.ContentType, Href: actor},
+			{Rel: "http://webfinger.net/rel/profile-page", Type: "text/html", Href: h.profileURL(user.Username)},
+		},
+	})
+}
+
+// actor serves a user's actor document. Browsers are sent to the profile
+// page instead.
+func (h *Handler) actor(w http.ResponseWriter, r *http.Request) {
+	user := h.localUser(w, r, r.PathValue("username"))
+	if user == nil {
+		return
+	}
+	if !wantsActivity(r) {
+		http.Redirect(w, r, h.profileURL(user.Username), http.StatusSeeOther)
+		return
+	}
+
+	key, err := h.service.ActorPublicKey(r.Context(), user.ID)
+	if err != nil {
+		http.Error(w, "actor unavailable", http.StatusBadGateway)
+		return
+	}
+
+	name := user.DisplayName
+	if name == "" {
+		name = user.Username
+	}
+	id := h.iris.Actor(user.Username)
+	actor := &activitypub.Actor{
+		Context:           activitypub.Context,
+		ID:                id,
+		Type:              "Person",
+		PreferredUsername: user.Username,
+		Name:              name,
+		URL:               h.profileURL(user.Username),
+		Inbox:             h.iris.Inbox(user.Username),
+		Outbox:            h.iris.Outbox(user.Username),
+		Followers:         h.iris.Followers(user.Username),
+		Endpoints:         &activitypub.Endpoints{SharedInbox: h.iris.SharedInbox()},
+		PublicKey:         &activitypub.PublicKey{ID: h.iris.Key(user.Username), Owner: id, PublicKeyPem: key},
+		Published:         user.CreatedAt,
+	}
+	if user.Bio != "" {
+		actor.Summary = "<p>" + html.EscapeString(user.Bio) + "</p>"
+	}
+	if user.AvatarURL != "" {
+		actor.Icon = &activitypub.Document{Type: "Image", URL: user.AvatarURL}
+	}
+	writeActivity(w, actor)
+}
+
+// outbox serves a user's outbox: the collection, which links to its first
+// page, or with ?page=true a page of Create activities for their public
+// posts, newest first
+func (h *Handler) outbox(w http.ResponseWriter, r *http.Request) {
+	user := h.localUser(w, r, r.PathValue("username"))
+	if user == nil {
+		return
+	}
+
+	outbox := h.iris.Outbox(user.Username)
+	query := r.URL.Query()
+	if query.Get("page") == "" {
+		writeActivity(w, &activitypub.OrderedCollection{
+			Context: activitypub.Context,
+			ID:      outbox,
+			Type:    "OrderedCollection",
+			First:   pageURL(outbox, ""),
+		})
+		return
+	}
+
+	after := query.Get("after")
+	conn, err := h.service.PublicPosts(r.Context(), user.ID, h.cfg.PageSize, after)
+	if err != nil {
+		if after != "" {
+			http.Error(w, "invalid page cursor", http.StatusBadRequest)
+			return
+		}
+		http.Error(w, "outbox unavailable", http.StatusBadGateway)
+		return
+	}
+
+	page := &activitypub.OrderedCollectionPage{
+		Context:      activitypub.Context,
+		ID:           pageURL(outbox, after),
+		Type:         "OrderedCollectionPage",
+		PartOf:       outbox,
+		OrderedItems: make([]*activitypub.Activity, 0, len(conn.Posts)),
+	}
+	if conn.PageInfo.HasNextPage {
+		page.Next = pageURL(outbox, conn.PageInfo.EndCursor)
+	}
+	for _, p := range conn.Posts {
+		page.OrderedItems = append(page.OrderedItems, activitypub.Create(h.iris.Note(user.Username, h.federatedPost(user, p))))
+	}
+	writeActivity(w, page)
+}
+
+// followers serves the size of a user's followers collection, local and
+// remote. The followers themselves aren't listed.
+func (h *Handler) followers(w http.ResponseWriter, r *http.Request) {
+	user := h.localUser(w, r, r.PathValue("username"))
+	if user == nil {
+		return
+	}
+
+	writeActivity(w, &activitypub.OrderedCollection{
+		Context:    activitypub.Context,
+		ID:         h.iris.Followers(user.Username),
+		Type:       "OrderedCollection",
+		TotalItems: &user.FollowerCount,
+	})
+}
+
+// note serves a public post as a note. Browsers are sent to the post page
+// instead.
+func (h *Handler) note(w http.ResponseWriter, r *http.Request) {
+	user := h.localUser(w, r, r.PathValue("username"))
+	if user == nil {
+		return
+	}
+
+	// With no viewer, only public posts are found
+	p, err := h.service.GetPost(r.Context(), r.PathValue("id"), "")
+	if err != nil || p.UserID != user.ID {
+		http.NotFound(w, r)
+		return
+	}
+
+	post := h.federatedPost(user, p)
+	if !wantsActivity(r) {
+		http.Redirect(w, r, post.URL, http.StatusSeeOther)
+		return
+	}
+
+	note := h.iris.Note(user.Username, post)
+	note.Context = activitypub.Context
+	writeActivity(w, note)
+}
+
+// localUser looks up a federated user, writing a 404 if there is none
+func (h *Handler) localUser(w http.ResponseWriter, r *http.Request, username string) *graphqlservice.User {
+	user, err := h.service.UserByUsername(r.Context(), username)
+	if err != nil || user.Protected {
+		http.NotFound(w, r)
+		return nil
+	}
+	return user
+}
+
+// federatedPost converts a post to what notes are made of. Image links
+// are attached as well as linked, like uploaded media.
+func (h *Handler) federatedPost(user *graphqlservice.User, p *graphqlservice.Post) activitypub.Post {
+	published, _ := time.Parse(time.RFC3339, p.CreatedAt)
+	post := activitypub.Post{
+		ID:        p.ID,
+		Content:   p.Content,
+		URL:       h.profileURL(user.Username) + "/" + url.PathEscape(p.ID),
+		Published: published,
+	}
+	for _, e := range p.Entities {
+		switch e.Type {
+		case "LINK":
+			post.Links = append(post.Links, activitypub.Link{Start: e.ByteStart, End: e.ByteEnd, Href: e.Value})
+		case "IMAGE_LINK":
+			post.Links = append(post.Links, activitypub.Link{Start: e.ByteStart, End: e.ByteEnd, Href: e.Value})
+			post.Images = append(post.Images, activitypub.Document{Type: "Image", URL: e.Value})
+		case "MENTION":
+			post.Links = append(post.Links, activitypub.Link{Start: e.ByteStart, End: e.ByteEnd, Mention: e.Value})
+		}
+	}
+	for _, m := range p.Media {
+		post.Images = append(post.Images, activitypub.Document{Type: "Image", MediaType: m.ContentType, URL: m.URL, Name: m.AltText})
+	}
+	return post
+}
+
+// profileURL returns the public page of a user
+func (h *Handler) profileURL(username string) string {
+	return h.cfg.WebURL + "/@" + url.PathEscape(username)
+}
+
+// wantsActivity reports whether a request asks for an ActivityPub document
+// rather than a web page
+func wantsActivity(r *http.Request) bool {
+	accept := r.Header.Get("Accept")
+	return strings.Contains(accept, activitypub.ContentType) || strings.Contains(accept, "application/ld+json")
+}
+
+// writeActivity writes an ActivityPub document
+func writeActivity(w http.ResponseWriter, document any) {
+	w.Header().Set("Content-Type", activitypub.ContentType+"; charset=utf-8")
+	if err := json.NewEncoder(w).Encode(document); err != nil {
+		log.Printf("Error writing ActivityPub document: %v", err)
+	}
+}
+
+// pageURL returns the URL of the outbox page after a cursor
+func pageURL(outbox string, after string) string {
+	if after == "" {
+		return outbox + "?page=true"
+	}
+	return outbox + "?page=true&after=" + url.QueryEscape(after)
+}
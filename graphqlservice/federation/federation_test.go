@@ -0,0 +1,81 @@
+package federation
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/paper-social/feed-service/activitypub"
+	"github.com/paper-social/feed-service/model"
+)
+
+// getActivity fetches an ActivityPub document, returning its status and
+// decoding it into v when found
+func getActivity(t *testing.T, rawURL string, v any) int {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, rawURL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Accept", activitypub.ContentType)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusOK {
+		if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return resp.StatusCode
+}
+
+func TestNonPublicPostsNotServed(t *testing.T) {
+	s := newTestServer(t)
+
+	var hidden []string
+	for _, visibility := range []model.Visibility{model.VisibilityFollowers, model.VisibilityMentioned} {
+		p, err := s.db.CreatePost("user1", "Not for everyone @bob", model.PostOptions{Visibility: visibility})
+		if err != nil {
+			t.Fatal(err)
+		}
+		hidden = append(hidden, p.ID)
+	}
+
+	var note activitypub.Note
+	if code := getActivity(t, s.iris.Post("alice", "post1"), &note); code != http.StatusOK || note.Content == "" {
+		t.Fatalf("public note: status = %d, note = %+v", code, note)
+	}
+	for _, id := range hidden {
+		if code := getActivity(t, s.iris.Post("alice", id), &note); code != http.StatusNotFound {
+			t.Errorf("note %s: status = %d, want 404", id, code)
+		}
+	}
+
+	// The outbox lists only Alice's public posts
+	var page struct {
+		OrderedItems []struct {
+			Object activitypub.Note
+		}
+	}
+	if code := getActivity(t, s.iris.Outbox("alice")+"?page=true", &page); code != http.StatusOK {
+		t.Fatalf("outbox: status = %d", code)
+	}
+	var notes []string
+	for _, item := range page.OrderedItems {
+		notes = append(notes, item.Object.ID)
+	}
+	want := []string{s.iris.Post("alice", "post1"), s.iris.Post("alice", "post2")}
+	if len(notes) != len(want) || notes[0] != want[0] || notes[1] != want[1] {
+		t.Errorf("outbox notes = %v, want %v", notes, want)
+	}
+
+	// Protected accounts aren't federated at all
+	if code := getActivity(t, s.iris.Actor("eve"), &note); code != http.StatusNotFound {
+		t.Errorf("protected actor: status = %d, want 404", code)
+	}
+	if code := getActivity(t, s.iris.Post("eve", "post9"), &note); code != http.StatusNotFound {
+		t.Errorf("protected user's note: status = %d, want 404", code)
+	}
+}
@@ -0,0 +1,152 @@
+package federation
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/paper-social/feed-service/activitypub"
+	"github.com/paper-social/feed-service/graphqlservice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxActivityBytes caps the size of activities posted to inboxes
+const maxActivityBytes = 256 * 1024
+
+// inbox takes activities from other servers, at a user's inbox or the
+// shared one. Requests must be signed by the activity's actor. Follows
+// and their undoing are acted on; other activities are accepted and
+// ignored.
+func (h *Handler) inbox(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxActivityBytes+1))
+	if err != nil {
+		http.Error(w, "could not read activity", http.StatusBadRequest)
+		return
+	}
+	if len(body) > maxActivityBytes {
+		http.Error(w, "activity too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	var activity activitypub.Incoming
+	if err := json.Unmarshal(body, &activity); err != nil || activity.Type == "" || activity.Actor == "" {
+		http.Error(w, "invalid activity", http.StatusBadRequest)
+		return
+	}
+
+	actor, err := h.verify(r, body)
+	if err != nil {
+		log.Printf("Rejected %s activity from %s: %v", activity.Type, activity.Actor, err)
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+	if actor.ID != activity.Actor {
+		http.Error(w, "activity was not signed by its actor", http.StatusUnauthorized)
+		return
+	}
+
+	switch activity.Type {
+	case "Follow":
+		h.follow(w, r, actor, &activity)
+	case "Undo":
+		h.undo(w, r, &activity)
+	default:
+		w.WriteHeader(http.StatusAccepted)
+	}
+}
+
+// verify checks a request's signature, fetching the actor who signed it
+func (h *Handler) verify(r *http.Request, body []byte) (*activitypub.Actor, error) {
+	keyID, err := activitypub.SignatureKeyID(r)
+	if err != nil {
+		return nil, err
+	}
+	actor, key, err := h.client.KeyOwner(r.Context(), keyID)
+	if err != nil {
+		return nil, err
+	}
+	if err := activitypub.Verify(r, body, key); err != nil {
+		return nil, err
+	}
+	return actor, nil
+}
+
+// follow makes a remote actor follow a local user. The post service sends
+// the Accept.
+func (h *Handler) follow(w http.ResponseWriter, r *http.Request, actor *activitypub.Actor, activity *activitypub.Incoming) {
+	object, err := activity.ObjectID()
+	if err != nil || activity.ID == "" {
+		http.Error(w, "invalid follow", http.StatusBadRequest)
+		return
+	}
+	user := h.target(w, r, object)
+	if user == nil {
+		return
+	}
+
+	follower := graphqlservice.RemoteFollower{
+		UserID:   user.ID,
+		ActorID:  actor.ID,
+		Inbox:    actor.Inbox,
+		FollowID: activity.ID,
+	}
+	if actor.Endpoints != nil {
+		follower.SharedInbox = actor.Endpoints.SharedInbox
+	}
+	if _, err := h.service.AddRemoteFollower(r.Context(), follower); err != nil {
+		if status.Code(err) == codes.FailedPrecondition {
+			http.Error(w, "account can't be followed", http.StatusForbidden)
+			return
+		}
+		http.Error(w, "follow failed", http.StatusBadGateway)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// undo takes back a remote actor's follow. Undoing a follow that isn't
+// there succeeds, so servers retrying don't see errors.
+func (h *Handler) undo(w http.ResponseWriter, r *http.Request, activity *activitypub.Incoming) {
+	follow, err := activity.Embedded()
+	if err != nil || follow.Type != "Follow" {
+		// Only follows are undone here; anything else is ignored
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+	if follow.Actor != activity.Actor {
+		http.Error(w, "can't undo another actor's follow", http.StatusForbidden)
+		return
+	}
+
+	object, err := follow.ObjectID()
+	if err != nil {
+		http.Error(w, "invalid follow", http.StatusBadRequest)
+		return
+	}
+	user := h.target(w, r, object)
+	if user == nil {
+		return
+	}
+
+	if _, err := h.service.RemoveRemoteFollower(r.Context(), user.ID, activity.Actor); err != nil && status.Code(err) != codes.NotFound {
+		http.Error(w, "undo failed", http.StatusBadGateway)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// target looks up the local user an activity's object names. At a user's
+// own inbox it must be that user. Writes an error if there is no such
+// user.
+func (h *Handler) target(w http.ResponseWriter, r *http.Request, object string) *graphqlservice.User {
+	username, local := h.iris.Username(object)
+	inboxOwner := r.PathValue("username")
+	if !local || (inboxOwner != "" && !strings.EqualFold(username, inboxOwner)) {
+		http.Error(w, "object is not this server's actor", http.StatusBadRequest)
+		return nil
+	}
+	return h.localUser(w, r, username)
+}
@@ -0,0 +1,298 @@
+package federation
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/paper-social/feed-service/activitypub"
+	"github.com/paper-social/feed-service/graphqlservice"
+	"github.com/paper-social/feed-service/linkpreview"
+	"github.com/paper-social/feed-service/model"
+	"github.com/paper-social/feed-service/postservice"
+	"github.com/paper-social/feed-service/proto/post"
+	"github.com/paper-social/feed-service/proto/user"
+	"google.golang.org/grpc"
+)
+
+// memDraftStore keeps drafts in memory
+type memDraftStore struct{}
+
+func (memDraftStore) Load() (postservice.SavedDrafts, error)   { return postservice.SavedDrafts{}, nil }
+func (memDraftStore) Save(saved postservice.SavedDrafts) error { return nil }
+
+// testServer is the federation handler over a post service on the mock
+// data, with the post service's federator delivering its activities. Eve
+// is protected.
+type testServer struct {
+	db   *model.Database
+	url  string
+	iris activitypub.IRIs
+}
+
+func newTestServer(t *testing.T) *testServer {
+	cfg := linkpreview.DefaultConfig()
+	cfg.Workers = 0
+	previews := linkpreview.NewService(cfg)
+	t.Cleanup(previews.Close)
+
+	db := model.NewDatabase()
+	if _, err := db.SetProtected("user5", true); err != nil {
+		t.Fatal(err)
+	}
+
+	backend := grpc.NewServer()
+	post.RegisterPostServiceServer(backend, postservice.NewServer(db, previews, memDraftStore{}))
+	user.RegisterUserServiceServer(backend, postservice.NewUserServer(db))
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go backend.Serve(listener)
+	t.Cleanup(backend.Stop)
+
+	// Actor IRIs are made from the server's URL, so the handler is created
+	// once it is known
+	var handler http.Handler
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	fedCfg := DefaultConfig()
+	fedCfg.BaseURL = server.URL
+	fedCfg.Timeout = 5 * time.Second
+	fedCfg.AllowPrivateNetworks = true
+	handler = NewHandler(graphqlservice.NewService(listener.Addr().String(), nil), fedCfg)
+
+	deliveryCfg := postservice.DefaultFederationConfig()
+	deliveryCfg.BaseURL = server.URL
+	deliveryCfg.Timeout = 5 * time.Second
+	deliveryCfg.Workers = 1
+	deliveryCfg.AllowPrivateNetworks = true
+	bus := postservice.NewEventBus(db)
+	federator := postservice.NewFederator(db, deliveryCfg)
+	bus.Register("federation", federator)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go bus.Run(ctx)
+	go federator.Run(ctx)
+	t.Cleanup(cancel)
+
+	return &testServer{db: db, url: server.URL, iris: activitypub.IRIs{BaseURL: server.URL}}
+}
+
+// remoteActor is an actor on another server, whose inbox takes activities
+// signed by the actors they're from
+type remoteActor struct {
+	id       string
+	inbox    string
+	key      *rsa.PrivateKey
+	received chan *activitypub.Incoming
+}
+
+func newRemoteActor(t *testing.T) *remoteActor {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pem, err := activitypub.EncodePublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := &remoteActor{key: key, received: make(chan *activitypub.Incoming, 10)}
+	client := activitypub.NewClient(5*time.Second, "test", true)
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /actor", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", activitypub.ContentType)
+		json.NewEncoder(w).Encode(&activitypub.Actor{
+			ID:                a.id,
+			Type:              "Person",
+			PreferredUsername: "remote",
+			Inbox:             a.inbox,
+			PublicKey:         &activitypub.PublicKey{ID: a.id + "#main-key", Owner: a.id, PublicKeyPem: pem},
+		})
+	})
+	mux.HandleFunc("POST /inbox", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		keyID, err := activitypub.SignatureKeyID(r)
+		if err != nil {
+			t.Errorf("unsigned delivery: %v", err)
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		signer, key, err := client.KeyOwner(r.Context(), keyID)
+		if err == nil {
+			err = activitypub.Verify(r, body, key)
+		}
+		var activity activitypub.Incoming
+		if err == nil {
+			err = json.Unmarshal(body, &activity)
+		}
+		if err == nil && activity.Actor != signer.ID {
+			t.Errorf("%s activity by %s was signed by %s", activity.Type, activity.Actor, signer.ID)
+		}
+		if err != nil {
+			t.Errorf("invalid delivery: %v", err)
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		a.received <- &activity
+		w.WriteHeader(http.StatusAccepted)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	a.id = server.URL + "/actor"
+	a.inbox = server.URL + "/inbox"
+	return a
+}
+
+// send posts an activity to an inbox, signed with the actor's key, and
+// returns the response status
+func (a *remoteActor) send(t *testing.T, inbox string, activity any) int {
+	t.Helper()
+	body, err := json.Marshal(activity)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, err := http.NewRequest(http.MethodPost, inbox, bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", activitypub.ContentType)
+	if err := activitypub.Sign(req, body, a.id+"#main-key", a.key); err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	return resp.StatusCode
+}
+
+// next waits for the next activity delivered to the actor's inbox
+func (a *remoteActor) next(t *testing.T) *activitypub.Incoming {
+	t.Helper()
+	select {
+	case activity := <-a.received:
+		return activity
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for a delivery")
+		return nil
+	}
+}
+
+// follow makes a Follow of a local actor
+func (a *remoteActor) follow(id string, object string) map[string]any {
+	return map[string]any{"id": a.id + "/follows/" + id, "type": "Follow", "actor": a.id, "object": object}
+}
+
+func TestInboxFollowAccept(t *testing.T) {
+	s := newTestServer(t)
+	remote := newRemoteActor(t)
+	alice := s.iris.Actor("alice")
+
+	follow := remote.follow("1", alice)
+	if code := remote.send(t, s.iris.Inbox("alice"), follow); code != http.StatusAccepted {
+		t.Fatalf("Follow: status = %d, want 202", code)
+	}
+	follower := s.db.GetRemoteFollower("user1", remote.id)
+	if follower == nil || follower.Inbox != remote.inbox || follower.FollowID != follow["id"] {
+		t.Fatalf("remote follower = %+v", follower)
+	}
+
+	// Alice's server accepts the follow, signed as Alice, whose key the
+	// remote server fetches from her actor document
+	accept := remote.next(t)
+	if accept.Type != "Accept" || accept.Actor != alice {
+		t.Fatalf("got %s by %s, want Accept by %s", accept.Type, accept.Actor, alice)
+	}
+	accepted, err := accept.Embedded()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if object, _ := accepted.ObjectID(); accepted.Type != "Follow" || accepted.ID != follow["id"] || accepted.Actor != remote.id || object != alice {
+		t.Errorf("accepted %+v, want the Follow", accepted)
+	}
+
+	// The follow can be undone at the shared inbox, and undoing it again
+	// still succeeds
+	undo := map[string]any{"id": remote.id + "/undos/1", "type": "Undo", "actor": remote.id, "object": follow}
+	for range 2 {
+		if code := remote.send(t, s.iris.SharedInbox(), undo); code != http.StatusAccepted {
+			t.Fatalf("Undo: status = %d, want 202", code)
+		}
+		if s.db.GetRemoteFollower("user1", remote.id) != nil {
+			t.Fatal("remote follower is still there after Undo")
+		}
+	}
+}
+
+func TestInboxRejects(t *testing.T) {
+	s := newTestServer(t)
+	remote := newRemoteActor(t)
+	other := newRemoteActor(t)
+	alice := s.iris.Actor("alice")
+
+	// The other actor follows Alice, so there's a follow to try undoing
+	if code := other.send(t, s.iris.Inbox("alice"), other.follow("1", alice)); code != http.StatusAccepted {
+		t.Fatalf("Follow: status = %d, want 202", code)
+	}
+	other.next(t)
+
+	tests := []struct {
+		name     string
+		inbox    string
+		activity map[string]any
+		want     int
+	}{
+		{"protected user", s.iris.Inbox("eve"), remote.follow("1", s.iris.Actor("eve")), http.StatusNotFound},
+		{"unknown user", s.iris.SharedInbox(), remote.follow("2", s.iris.Actor("nobody")), http.StatusNotFound},
+		{"another user's inbox", s.iris.Inbox("bob"), remote.follow("3", alice), http.StatusBadRequest},
+		{"remote object", s.iris.SharedInbox(), remote.follow("4", other.id), http.StatusBadRequest},
+		{"signed by another actor", s.iris.SharedInbox(), other.follow("5", alice), http.StatusUnauthorized},
+		{
+			"undo of another actor's follow",
+			s.iris.SharedInbox(),
+			map[string]any{"id": remote.id + "/undos/1", "type": "Undo", "actor": remote.id, "object": other.follow("1", alice)},
+			http.StatusForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if code := remote.send(t, tt.inbox, tt.activity); code != tt.want {
+				t.Errorf("status = %d, want %d", code, tt.want)
+			}
+		})
+	}
+
+	if followers := s.db.GetRemoteFollowers("user1"); len(followers) != 1 || followers[0].ActorID != other.id {
+		t.Errorf("Alice's remote followers = %+v, want only %s", followers, other.id)
+	}
+	if len(s.db.GetRemoteFollowers("user5")) != 0 {
+		t.Error("protected account gained a remote follower")
+	}
+
+	// Unsigned activities are turned away
+	body, _ := json.Marshal(remote.follow("6", alice))
+	resp, err := http.Post(s.iris.SharedInbox(), activitypub.ContentType, bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("unsigned Follow: status = %d, want 401", resp.StatusCode)
+	}
+}
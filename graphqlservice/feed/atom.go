@@ -0,0 +1,106 @@
+package feed
+
+import (
+	"encoding/xml"
+	"strconv"
+	"time"
+)
+
+const atomNamespace = "http://www.w3.org/2005/Atom"
+
+// Atom (RFC 4287) documents, with RFC 5005 pagination links
+type atomFeed struct {
+	XMLName xml.Name    `xml:"feed"`
+	XMLNS   string      `xml:"xmlns,attr"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Author  atomAuthor  `xml:"author"`
+	Links   []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+	URI  string `xml:"uri"`
+}
+
+type atomLink struct {
+	Rel    string `xml:"rel,attr"`
+	Href   string `xml:"href,attr"`
+	Type   string `xml:"type,attr,omitempty"`
+	Length string `xml:"length,attr,omitempty"`
+	Title  string `xml:"title,attr,omitempty"`
+}
+
+type atomEntry struct {
+	ID        string      `xml:"id"`
+	Title     string      `xml:"title"`
+	Published string      `xml:"published"`
+	Updated   string      `xml:"updated"`
+	Links     []atomLink  `xml:"link"`
+	Content   atomContent `xml:"content"`
+}
+
+type atomContent struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+// renderAtom renders a feed page as Atom
+func renderAtom(doc *document) ([]byte, error) {
+	feed := atomFeed{
+		XMLNS:   atomNamespace,
+		ID:      doc.FirstURL,
+		Title:   doc.Title,
+		Updated: doc.Updated.UTC().Format(time.RFC3339),
+		Author:  atomAuthor{Name: doc.AuthorName, URI: doc.HomeURL},
+		Links: append([]atomLink{
+			{Rel: "alternate", Href: doc.HomeURL, Type: "text/html"},
+		}, feedLinks(doc, "application/atom+xml")...),
+		Entries: make([]atomEntry, 0, len(doc.Items)),
+	}
+	for _, it := range doc.Items {
+		// Posts carry no edit time, so updated is when they were published
+		published := it.Published.UTC().Format(time.RFC3339)
+		entry := atomEntry{
+			ID:        it.URL,
+			Title:     it.Title,
+			Published: published,
+			Updated:   published,
+			Links:     []atomLink{{Rel: "alternate", Href: it.URL, Type: "text/html"}},
+			Content:   atomContent{Type: "html", Value: it.ContentHTML},
+		}
+		for _, e := range it.Enclosures {
+			link := atomLink{Rel: "enclosure", Href: e.URL, Type: e.Type, Title: e.Title}
+			if e.Length > 0 {
+				link.Length = strconv.FormatInt(e.Length, 10)
+			}
+			entry.Links = append(entry.Links, link)
+		}
+		feed.Entries = append(feed.Entries, entry)
+	}
+
+	return marshalXML(feed)
+}
+
+// feedLinks returns the self and pagination links of a feed page
+func feedLinks(doc *document, contentType string) []atomLink {
+	links := []atomLink{
+		{Rel: "self", Href: doc.SelfURL, Type: contentType},
+		{Rel: "first", Href: doc.FirstURL, Type: contentType},
+	}
+	if doc.NextURL != "" {
+		links = append(links, atomLink{Rel: "next", Href: doc.NextURL, Type: contentType})
+	}
+	return links
+}
+
+// marshalXML renders an XML document with its declaration
+func marshalXML(v any) ([]byte, error) {
+	body, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), append(body, '\n')...), nil
+}
@@ -0,0 +1,294 @@
+// Package feed serves users' public posts as RSS, Atom and JSON Feed
+// syndication feeds.
+package feed
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"html"
+	"log"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/paper-social/feed-service/graphqlservice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxTitleRunes caps item titles, which are taken from the post content
+const maxTitleRunes = 80
+
+// Config controls the URLs and page size of feeds
+type Config struct {
+	BaseURL  string // Public URL prefix of the feeds, e.g. http://localhost:8080
+	WebURL   string // Public URL prefix of profile and post pages, e.g. https://paper.social
+	PageSize int    // Posts per feed page
+	MaxAge   time.Duration
+}
+
+// DefaultConfig returns the configuration used by the GraphQL service
+func DefaultConfig() Config {
+	return Config{
+		BaseURL:  "http://localhost:8080",
+		WebURL:   "https://paper.social",
+		PageSize: 20,
+		MaxAge:   5 * time.Minute,
+	}
+}
+
+// format renders a feed document in one syndication format
+type format struct {
+	contentType string
+	render      func(doc *document) ([]byte, error)
+}
+
+// formats maps feed file names to their formats
+var formats = map[string]format{
+	"feed.rss":  {"application/rss+xml; charset=utf-8", renderRSS},
+	"feed.atom": {"application/atom+xml; charset=utf-8", renderAtom},
+	"feed.json": {"application/feed+json; charset=utf-8", renderJSON},
+}
+
+// document is a page of a user's feed, independent of format
+type document struct {
+	Title       string
+	Description string
+	AuthorName  string
+	HomeURL     string // The user's profile page
+	SelfURL     string // This page of the feed
+	FirstURL    string // The first page of the feed
+	NextURL     string // Empty on the last page
+	Updated     time.Time
+	Items       []item
+}
+
+// item is one post in a feed
+type item struct {
+	URL         string // Also the item's ID, which stays the same across edits
+	Title       string
+	ContentHTML string
+	ContentText string
+	Published   time.Time
+	Enclosures  []enclosure
+}
+
+// enclosure is an image attached to an item
+type enclosure struct {
+	URL    string
+	Type   string
+	Length int64 // Zero when unknown
+	Title  string
+}
+
+// Handler serves /users/{username}/feed.rss, feed.atom and feed.json.
+// Feeds hold the posts anyone can see, newest first, a page at a time;
+// protected accounts have none. Responses carry an ETag and Last-Modified
+// for conditional requests.
+type Handler struct {
+	service *graphqlservice.Service
+	cfg     Config
+}
+
+// NewHandler creates a feed handler reading posts through service
+func NewHandler(service *graphqlservice.Service, cfg Config) *Handler {
+	return &Handler{service: service, cfg: cfg}
+}
+
+// ServeHTTP implements http.Handler. Paths are relative to /users/.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	username, file, _ := strings.Cut(strings.Trim(r.URL.Path, "/"), "/")
+	f, known := formats[file]
+	if username == "" || !known {
+		http.NotFound(w, r)
+		return
+	}
+
+	user, err := h.service.UserByUsername(r.Context(), username)
+	if status.Code(err) == codes.NotFound || (err == nil && user.Protected) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		http.Error(w, "feed unavailable", http.StatusBadGateway)
+		return
+	}
+
+	after := r.URL.Query().Get("after")
+	conn, err := h.service.PublicPosts(r.Context(), user.ID, h.cfg.PageSize, after)
+	if status.Code(err) == codes.InvalidArgument {
+		http.Error(w, "invalid page cursor", http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		http.Error(w, "feed unavailable", http.StatusBadGateway)
+		return
+	}
+
+	doc := h.document(user, file, after, conn)
+	body, err := f.render(doc)
+	if err != nil {
+		log.Printf("Error rendering %s for user %s: %v", file, user.ID, err)
+		http.Error(w, "feed unavailable", http.StatusInternalServerError)
+		return
+	}
+
+	// The ETag covers the whole body, so edits and deletes change it even
+	// when Last-Modified, the newest post's time, stays the same
+	sum := sha256.Sum256(body)
+	w.Header().Set("Content-Type", f.contentType)
+	w.Header().Set("ETag", `"`+hex.EncodeToString(sum[:16])+`"`)
+	w.Header().Set("Cache-Control", "public, max-age="+strconv.Itoa(int(h.cfg.MaxAge.Seconds())))
+	http.ServeContent(w, r, "", doc.Updated, bytes.NewReader(body))
+}
+
+// document builds the format-independent feed page
+func (h *Handler) document(user *graphqlservice.User, file string, after string, conn *graphqlservice.PostConnection) *document {
+	name := user.DisplayName
+	if name == "" {
+		name = user.Username
+	}
+
+	first := h.cfg.BaseURL + "/users/" + url.PathEscape(user.Username) + "/" + file
+	doc := &document{
+		Title:       name + " (@" + user.Username + ")",
+		Description: user.Bio,
+		AuthorName:  name,
+		HomeURL:     h.profileURL(user.Username),
+		SelfURL:     pageURL(first, after),
+		FirstURL:    first,
+		Items:       make([]item, 0, len(conn.Posts)),
+	}
+	if doc.Description == "" {
+		doc.Description = "Posts by " + doc.Title
+	}
+	if conn.PageInfo.HasNextPage {
+		doc.NextURL = pageURL(first, conn.PageInfo.EndCursor)
+	}
+
+	for _, p := range conn.Posts {
+		published, _ := time.Parse(time.RFC3339, p.CreatedAt)
+		if published.After(doc.Updated) {
+			doc.Updated = published
+		}
+		doc.Items = append(doc.Items, item{
+			URL:         h.profileURL(user.Username) + "/" + url.PathEscape(p.ID),
+			Title:       title(p.Content),
+			ContentHTML: h.contentHTML(p),
+			ContentText: p.Content,
+			Published:   published,
+			Enclosures:  enclosures(p),
+		})
+	}
+
+	// An empty page has no post to date it by
+	if doc.Updated.IsZero() {
+		doc.Updated, _ = time.Parse(time.RFC3339, user.CreatedAt)
+	}
+	return doc
+}
+
+// profileURL returns the public page of a user
+func (h *Handler) profileURL(username string) string {
+	return h.cfg.WebURL + "/@" + url.PathEscape(username)
+}
+
+// contentHTML renders post content as HTML, with links and mentions
+// turned into anchors and line breaks kept
+func (h *Handler) contentHTML(p *graphqlservice.Post) string {
+	entities := append([]graphqlservice.Entity(nil), p.Entities...)
+	sort.Slice(entities, func(i, j int) bool { return entities[i].ByteStart < entities[j].ByteStart })
+
+	var b strings.Builder
+	b.WriteString("<p>")
+	pos := 0
+	for _, e := range entities {
+		if e.ByteStart < pos || e.ByteEnd > len(p.Content) {
+			continue
+		}
+
+		var href string
+		switch e.Type {
+		case "LINK", "IMAGE_LINK":
+			href = e.Value
+		case "MENTION":
+			href = h.profileURL(e.Value)
+		default:
+			continue
+		}
+		writeText(&b, p.Content[pos:e.ByteStart])
+		b.WriteString(`<a href="` + html.EscapeString(href) + `">`)
+		writeText(&b, p.Content[e.ByteStart:e.ByteEnd])
+		b.WriteString("</a>")
+		pos = e.ByteEnd
+	}
+	writeText(&b, p.Content[pos:])
+	b.WriteString("</p>")
+
+	for _, m := range p.Media {
+		b.WriteString(`<p><img src="` + html.EscapeString(m.URL) + `" alt="` + html.EscapeString(m.AltText) + `"></p>`)
+	}
+	return b.String()
+}
+
+// writeText writes escaped text, turning newlines into line breaks
+func writeText(b *strings.Builder, s string) {
+	b.WriteString(strings.ReplaceAll(html.EscapeString(s), "\n", "<br>"))
+}
+
+// enclosures returns the images of a post: its attached media, then image
+// links in its content
+func enclosures(p *graphqlservice.Post) []enclosure {
+	var result []enclosure
+	for _, m := range p.Media {
+		result = append(result, enclosure{URL: m.URL, Type: m.ContentType, Title: m.AltText})
+	}
+	for _, e := range p.Entities {
+		if e.Type != "IMAGE_LINK" {
+			continue
+		}
+		result = append(result, enclosure{URL: e.Value, Type: imageType(e.Value)})
+	}
+	return result
+}
+
+// imageType guesses the media type of an image link from its extension
+func imageType(rawURL string) string {
+	if u, err := url.Parse(rawURL); err == nil {
+		if t := mime.TypeByExtension(strings.ToLower(path.Ext(u.Path))); t != "" {
+			return t
+		}
+	}
+	return "image/*"
+}
+
+// title shortens post content to its first line, at most maxTitleRunes
+// long
+func title(content string) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(content), "\n")
+	if utf8.RuneCountInString(line) <= maxTitleRunes {
+		return line
+	}
+	runes := []rune(line)
+	return strings.TrimSpace(string(runes[:maxTitleRunes-1])) + "…"
+}
+
+// pageURL returns the URL of the feed page after a cursor
+func pageURL(first string, after string) string {
+	if after == "" {
+		return first
+	}
+	return first + "?after=" + url.QueryEscape(after)
+}
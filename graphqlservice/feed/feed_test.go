@@ -0,0 +1,305 @@
+package feed
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/paper-social/feed-service/graphqlservice"
+	"github.com/paper-social/feed-service/linkpreview"
+	"github.com/paper-social/feed-service/model"
+	"github.com/paper-social/feed-service/postservice"
+	"github.com/paper-social/feed-service/proto/post"
+	"github.com/paper-social/feed-service/proto/user"
+	"google.golang.org/grpc"
+)
+
+// memDraftStore keeps drafts in memory
+type memDraftStore struct{}
+
+func (memDraftStore) Load() (postservice.SavedDrafts, error)   { return postservice.SavedDrafts{}, nil }
+func (memDraftStore) Save(saved postservice.SavedDrafts) error { return nil }
+
+// newTestServer serves feeds the way the GraphQL service does, two posts a
+// page, over a post service on the mock data. Alice has a new public post
+// with an image link and a followers-only post, and Eve is protected.
+func newTestServer(t *testing.T) (*httptest.Server, *grpc.Server) {
+	cfg := linkpreview.DefaultConfig()
+	cfg.Workers = 0
+	previews := linkpreview.NewService(cfg)
+	t.Cleanup(previews.Close)
+
+	db := model.NewDatabase()
+	if _, err := db.CreatePost("user1", "Cats & dogs\nhttps://example.com/cat.png", model.PostOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.CreatePost("user1", "Just for followers", model.PostOptions{Visibility: model.VisibilityFollowers}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.SetProtected("user5", true); err != nil {
+		t.Fatal(err)
+	}
+
+	backend := grpc.NewServer()
+	post.RegisterPostServiceServer(backend, postservice.NewServer(db, previews, memDraftStore{}))
+	user.RegisterUserServiceServer(backend, postservice.NewUserServer(db))
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go backend.Serve(listener)
+	t.Cleanup(backend.Stop)
+
+	feedCfg := DefaultConfig()
+	feedCfg.PageSize = 2
+	handler := NewHandler(graphqlservice.NewService(listener.Addr().String(), nil), feedCfg)
+	server := httptest.NewServer(http.StripPrefix("/users", handler))
+	t.Cleanup(server.Close)
+
+	// Feed URLs point at the test server, so next links can be followed
+	handler.cfg.BaseURL = server.URL
+	return server, backend
+}
+
+// get fetches a feed URL, failing the test on transport errors
+func get(t *testing.T, rawURL string, header http.Header) (*http.Response, []byte) {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, rawURL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp, body
+}
+
+// linkHref returns the href of the link with rel, or "" if there's none
+func linkHref(links []atomLink, rel string) string {
+	for _, l := range links {
+		if l.Rel == rel {
+			return l.Href
+		}
+	}
+	return ""
+}
+
+// rssChannelLinks splits the links of an RSS channel, which share a local
+// name, into the channel's own link and its Atom feed links
+func rssChannelLinks(body []byte) (string, []atomLink, error) {
+	var doc struct {
+		Links []struct {
+			XMLName xml.Name
+			atomLink
+			Value string `xml:",chardata"`
+		} `xml:"channel>link"`
+	}
+	if err := xml.Unmarshal(body, &doc); err != nil {
+		return "", nil, err
+	}
+
+	var home string
+	var links []atomLink
+	for _, l := range doc.Links {
+		if l.XMLName.Space == atomNamespace {
+			links = append(links, l.atomLink)
+		} else {
+			home = l.Value
+		}
+	}
+	return home, links, nil
+}
+
+func TestRSSFeed(t *testing.T) {
+	server, _ := newTestServer(t)
+	first := server.URL + "/users/alice/feed.rss"
+
+	resp, body := get(t, first, nil)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", resp.StatusCode, body)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "application/rss+xml; charset=utf-8" {
+		t.Errorf("Content-Type = %q", ct)
+	}
+
+	var doc rssDocument
+	if err := xml.Unmarshal(body, &doc); err != nil {
+		t.Fatalf("invalid RSS: %v\n%s", err, body)
+	}
+	ch := doc.Channel
+	home, links, err := rssChannelLinks(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if doc.Version != "2.0" || ch.Title != "Alice (@alice)" || home != "https://paper.social/@alice" {
+		t.Errorf("channel = %q %q %q", doc.Version, ch.Title, home)
+	}
+	if len(ch.Items) != 2 {
+		t.Fatalf("got %d items, want a page of 2", len(ch.Items))
+	}
+
+	// The newest post comes first, titled by its first line, with its image
+	// link as an enclosure
+	newest := ch.Items[0]
+	if newest.Title != "Cats & dogs" || !strings.Contains(newest.Description, "Cats &amp; dogs<br>") {
+		t.Errorf("newest item = %q, %q", newest.Title, newest.Description)
+	}
+	if len(newest.Enclosures) != 1 || newest.Enclosures[0].URL != "https://example.com/cat.png" || newest.Enclosures[0].Type != "image/png" {
+		t.Errorf("enclosures = %+v", newest.Enclosures)
+	}
+	if ch.Items[1].Link != "https://paper.social/@alice/post1" || !ch.Items[1].GUID.IsPermaLink {
+		t.Errorf("second item = %+v, want post1", ch.Items[1])
+	}
+
+	if self := linkHref(links, "self"); self != first {
+		t.Errorf("self link = %q, want %q", self, first)
+	}
+	if linkHref(links, "first") != first || !strings.HasPrefix(linkHref(links, "next"), first+"?after=") {
+		t.Errorf("links = %+v", links)
+	}
+}
+
+func TestAtomFeed(t *testing.T) {
+	server, _ := newTestServer(t)
+
+	resp, body := get(t, server.URL+"/users/alice/feed.atom", nil)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", resp.StatusCode, body)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "application/atom+xml; charset=utf-8" {
+		t.Errorf("Content-Type = %q", ct)
+	}
+
+	var feed atomFeed
+	if err := xml.Unmarshal(body, &feed); err != nil {
+		t.Fatalf("invalid Atom: %v\n%s", err, body)
+	}
+	if feed.XMLName.Space != atomNamespace || feed.Author.Name != "Alice" || feed.Updated == "" {
+		t.Errorf("feed = %q, author %q, updated %q", feed.XMLName.Space, feed.Author.Name, feed.Updated)
+	}
+	if len(feed.Entries) != 2 {
+		t.Fatalf("got %d entries, want a page of 2", len(feed.Entries))
+	}
+	entry := feed.Entries[1]
+	if entry.ID != "https://paper.social/@alice/post1" || entry.Title != "Hello, world!" || entry.Content.Type != "html" {
+		t.Errorf("entry = %+v, want post1", entry)
+	}
+	if linkHref(feed.Links, "next") == "" {
+		t.Errorf("first page has no next link: %+v", feed.Links)
+	}
+}
+
+func TestJSONFeedPagination(t *testing.T) {
+	server, _ := newTestServer(t)
+	first := server.URL + "/users/alice/feed.json"
+
+	// Following next links walks every public post once, and skips the
+	// followers-only one
+	var titles []string
+	next := first
+	for pages := 0; next != ""; pages++ {
+		if pages == 3 {
+			t.Fatal("feed doesn't end")
+		}
+		resp, body := get(t, next, nil)
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("GET %s: status = %d: %s", next, resp.StatusCode, body)
+		}
+		if ct := resp.Header.Get("Content-Type"); ct != "application/feed+json; charset=utf-8" {
+			t.Errorf("Content-Type = %q", ct)
+		}
+
+		var feed jsonFeed
+		if err := json.Unmarshal(body, &feed); err != nil {
+			t.Fatalf("invalid JSON Feed: %v\n%s", err, body)
+		}
+		if feed.Version != "https://jsonfeed.org/version/1.1" || feed.FeedURL != next {
+			t.Errorf("feed version %q, feed_url %q, want %q", feed.Version, feed.FeedURL, next)
+		}
+		for _, it := range feed.Items {
+			titles = append(titles, it.Title)
+		}
+		next = feed.NextURL
+	}
+
+	if got, want := strings.Join(titles, "|"), "Cats & dogs|Hello, world!|GraphQL is awesome"; got != want {
+		t.Errorf("items = %q, want %q", got, want)
+	}
+}
+
+func TestFeedErrors(t *testing.T) {
+	server, backend := newTestServer(t)
+
+	tests := []struct {
+		name string
+		path string
+		want int
+	}{
+		{"protected user", "/users/eve/feed.rss", http.StatusNotFound},
+		{"unknown user", "/users/nobody/feed.atom", http.StatusNotFound},
+		{"unknown format", "/users/alice/feed.xml", http.StatusNotFound},
+		{"invalid cursor", "/users/alice/feed.json?after=bogus", http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if resp, body := get(t, server.URL+tt.path, nil); resp.StatusCode != tt.want {
+				t.Errorf("status = %d, want %d: %s", resp.StatusCode, tt.want, body)
+			}
+		})
+	}
+
+	// Backend failures aren't the client's fault, whatever it asked for
+	backend.Stop()
+	for _, path := range []string{"/users/alice/feed.rss", "/users/alice/feed.json?after=bogus"} {
+		if resp, body := get(t, server.URL+path, nil); resp.StatusCode != http.StatusBadGateway {
+			t.Errorf("GET %s with the backend down: status = %d, want 502: %s", path, resp.StatusCode, body)
+		}
+	}
+}
+
+func TestFeedConditionalRequests(t *testing.T) {
+	server, _ := newTestServer(t)
+	feedURL := server.URL + "/users/alice/feed.atom"
+
+	resp, _ := get(t, feedURL, nil)
+	etag, lastModified := resp.Header.Get("ETag"), resp.Header.Get("Last-Modified")
+	if etag == "" || lastModified == "" {
+		t.Fatalf("ETag = %q, Last-Modified = %q, want both", etag, lastModified)
+	}
+
+	tests := []struct {
+		name   string
+		header http.Header
+		want   int
+	}{
+		{"matching ETag", http.Header{"If-None-Match": {etag}}, http.StatusNotModified},
+		{"not modified since", http.Header{"If-Modified-Since": {lastModified}}, http.StatusNotModified},
+		{"other ETag", http.Header{"If-None-Match": {`"stale"`}}, http.StatusOK},
+		{"modified since", http.Header{"If-Modified-Since": {"Mon, 02 Jan 2006 15:04:05 GMT"}}, http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, body := get(t, feedURL, tt.header)
+			if resp.StatusCode != tt.want {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.want)
+			}
+			if tt.want == http.StatusNotModified && len(body) != 0 {
+				t.Errorf("304 response has a body: %s", body)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package feed
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// JSON Feed 1.1 documents
+type jsonFeed struct {
+	Version     string           `json:"version"`
+	Title       string           `json:"title"`
+	HomePageURL string           `json:"home_page_url"`
+	FeedURL     string           `json:"feed_url"`
+	Description string           `json:"description,omitempty"`
+	NextURL     string           `json:"next_url,omitempty"`
+	Authors     []jsonFeedAuthor `json:"authors"`
+	Items       []jsonFeedItem   `json:"items"`
+}
+
+type jsonFeedAuthor struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+type jsonFeedItem struct {
+	ID            string               `json:"id"`
+	URL           string               `json:"url"`
+	Title         string               `json:"title,omitempty"`
+	ContentHTML   string               `json:"content_html"`
+	ContentText   string               `json:"content_text"`
+	DatePublished string               `json:"date_published"`
+	Attachments   []jsonFeedAttachment `json:"attachments,omitempty"`
+}
+
+type jsonFeedAttachment struct {
+	URL         string `json:"url"`
+	MimeType    string `json:"mime_type"`
+	Title       string `json:"title,omitempty"`
+	SizeInBytes int64  `json:"size_in_bytes,omitempty"`
+}
+
+// renderJSON renders a feed page as JSON Feed
+func renderJSON(doc *document) ([]byte, error) {
+	feed := jsonFeed{
+		Version:     "https://jsonfeed.org/version/1.1",
+		Title:       doc.Title,
+		HomePageURL: doc.HomeURL,
+		FeedURL:     doc.SelfURL,
+		Description: doc.Description,
+		NextURL:     doc.NextURL,
+		Authors:     []jsonFeedAuthor{{Name: doc.AuthorName, URL: doc.HomeURL}},
+		Items:       make([]jsonFeedItem, 0, len(doc.Items)),
+	}
+	for _, it := range doc.Items {
+		fi := jsonFeedItem{
+			ID:            it.URL,
+			URL:           it.URL,
+			Title:         it.Title,
+			ContentHTML:   it.ContentHTML,
+			ContentText:   it.ContentText,
+			DatePublished: it.Published.UTC().Format(time.RFC3339),
+		}
+		for _, e := range it.Enclosures {
+			fi.Attachments = append(fi.Attachments, jsonFeedAttachment{URL: e.URL, MimeType: e.Type, Title: e.Title, SizeInBytes: e.Length})
+		}
+		feed.Items = append(feed.Items, fi)
+	}
+
+	body, err := json.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(body, '\n'), nil
+}
@@ -0,0 +1,72 @@
+package feed
+
+import (
+	"encoding/xml"
+	"strconv"
+	"time"
+)
+
+// RSS 2.0 documents. Feed links use the Atom namespace, which RSS readers
+// understand for self and pagination links.
+type rssDocument struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Atom    string     `xml:"xmlns:atom,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string     `xml:"title"`
+	Link          string     `xml:"link"`
+	Description   string     `xml:"description"`
+	LastBuildDate string     `xml:"lastBuildDate"`
+	Links         []atomLink `xml:"atom:link"`
+	Items         []rssItem  `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string         `xml:"title"`
+	Link        string         `xml:"link"`
+	Description string         `xml:"description"`
+	GUID        rssGUID        `xml:"guid"`
+	PubDate     string         `xml:"pubDate"`
+	Enclosures  []rssEnclosure `xml:"enclosure"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type rssEnclosure struct {
+	URL    string `xml:"url,attr"`
+	Type   string `xml:"type,attr"`
+	Length string `xml:"length,attr"` // Required by RSS; 0 when unknown
+}
+
+// renderRSS renders a feed page as RSS 2.0
+func renderRSS(doc *document) ([]byte, error) {
+	channel := rssChannel{
+		Title:         doc.Title,
+		Link:          doc.HomeURL,
+		Description:   doc.Description,
+		LastBuildDate: doc.Updated.UTC().Format(time.RFC1123Z),
+		Links:         feedLinks(doc, "application/rss+xml"),
+		Items:         make([]rssItem, 0, len(doc.Items)),
+	}
+	for _, it := range doc.Items {
+		ri := rssItem{
+			Title:       it.Title,
+			Link:        it.URL,
+			Description: it.ContentHTML,
+			GUID:        rssGUID{IsPermaLink: true, Value: it.URL},
+			PubDate:     it.Published.UTC().Format(time.RFC1123Z),
+		}
+		for _, e := range it.Enclosures {
+			ri.Enclosures = append(ri.Enclosures, rssEnclosure{URL: e.URL, Type: e.Type, Length: strconv.FormatInt(e.Length, 10)})
+		}
+		channel.Items = append(channel.Items, ri)
+	}
+
+	return marshalXML(rssDocument{Version: "2.0", Atom: atomNamespace, Channel: channel})
+}
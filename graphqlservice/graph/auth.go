@@ -0,0 +1,51 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/paper-social/feed-service/graphqlservice"
+)
+
+// connectionUserKey is the context key of the user a WebSocket connection
+// identified as
+type connectionUserKey struct{}
+
+// WebsocketInit checks the connection_init payload of a WebSocket
+// connection. The client names its user as userId, which must exist, and
+// subscriptions on the connection are limited to that user. There are no
+// credentials yet, so this identifies rather than authenticates the user;
+// a token check belongs here once there is an auth service.
+func WebsocketInit(service *graphqlservice.Service) transport.WebsocketInitFunc {
+	return func(ctx context.Context, payload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+		userID := payload.GetString("userId")
+		if userID == "" {
+			return ctx, nil, errors.New("connection_init payload must set userId")
+		}
+
+		users, err := service.UsersByIDs(ctx, []string{userID})
+		if err != nil {
+			return ctx, nil, err
+		}
+		if _, exists := users[userID]; !exists {
+			return ctx, nil, fmt.Errorf("user with ID %s not found", userID)
+		}
+
+		return context.WithValue(ctx, connectionUserKey{}, userID), nil, nil
+	}
+}
+
+// requireConnectionUser checks that a subscription is for the user its
+// connection identified as
+func requireConnectionUser(ctx context.Context, userID string) error {
+	connUserID, _ := ctx.Value(connectionUserKey{}).(string)
+	if connUserID == "" {
+		return errors.New("subscriptions need a WebSocket connection initialized with a userId")
+	}
+	if connUserID != userID {
+		return fmt.Errorf("connection is not authorized for user %s", userID)
+	}
+	return nil
+}
@@ -0,0 +1,350 @@
+package graph
+
+import (
+	"github.com/paper-social/feed-service/graphqlservice"
+	"github.com/paper-social/feed-service/graphqlservice/graph/model"
+)
+
+// toGraphPosts converts a list of service posts to the GraphQL model
+func toGraphPosts(posts []*graphqlservice.Post) []*model.Post {
+	result := make([]*model.Post, len(posts))
+	for i, p := range posts {
+		result[i] = toGraphPost(p)
+	}
+	return result
+}
+
+// toGraphPost converts a service post to the GraphQL model
+func toGraphPost(p *graphqlservice.Post) *model.Post {
+	entities := make([]*model.Entity, len(p.Entities))
+	for i, e := range p.Entities {
+		entities[i] = &model.Entity{
+			Type:      model.EntityType(e.Type),
+			Text:      e.Text,
+			Value:     e.Value,
+			Start:     e.Start,
+			End:       e.End,
+			ByteStart: e.ByteStart,
+			ByteEnd:   e.ByteEnd,
+		}
+	}
+
+	previews := make([]*model.LinkPreview, len(p.LinkPreviews))
+	for i, lp := range p.LinkPreviews {
+		previews[i] = &model.LinkPreview{
+			URL:         lp.URL,
+			Title:       optionalString(lp.Title),
+			Description: optionalString(lp.Description),
+			ImageURL:    optionalString(lp.ImageURL),
+			SiteName:    optionalString(lp.SiteName),
+			AuthorName:  optionalString(lp.AuthorName),
+			Type:        optionalString(lp.Type),
+			FetchedAt:   lp.FetchedAt,
+		}
+	}
+
+	attachments := make([]*model.Media, len(p.Media))
+	for i := range p.Media {
+		attachments[i] = toGraphMedia(&p.Media[i])
+	}
+
+	return &model.Post{
+		ID:           p.ID,
+		UserID:       p.UserID,
+		Content:      p.Content,
+		CreatedAt:    p.CreatedAt,
+		Entities:     entities,
+		Media:        attachments,
+		Poll:         toGraphPoll(p.Poll),
+		Status:       model.PostStatus(p.Status),
+		PublishAt:    optionalString(p.PublishAt),
+		Pinned:       p.Pinned,
+		ReplyToID:    optionalString(p.ReplyToID),
+		Visibility:   model.Visibility(p.Visibility),
+		LikeCount:    p.LikeCount,
+		ReplyCount:   p.ReplyCount,
+		RepostCount:  p.RepostCount,
+		Liked:        p.Liked,
+		Reposted:     p.Reposted,
+		LinkPreviews: previews,
+	}
+}
+
+// toGraphConnection converts a service page of posts to the GraphQL model
+func toGraphConnection(c *graphqlservice.PostConnection) *model.PostConnection {
+	return &model.PostConnection{
+		Posts: toGraphPosts(c.Posts),
+		PageInfo: &model.PageInfo{
+			EndCursor:   optionalString(c.PageInfo.EndCursor),
+			HasNextPage: c.PageInfo.HasNextPage,
+		},
+	}
+}
+
+// toGraphUser converts a service user to the GraphQL model
+func toGraphUser(u *graphqlservice.User) *model.User {
+	return &model.User{
+		ID:             u.ID,
+		Username:       u.Username,
+		Protected:      u.Protected,
+		DisplayName:    u.DisplayName,
+		Bio:            u.Bio,
+		AvatarURL:      u.AvatarURL,
+		Location:       u.Location,
+		Website:        u.Website,
+		CreatedAt:      u.CreatedAt,
+		FollowerCount:  u.FollowerCount,
+		FollowingCount: u.FollowingCount,
+		PostCount:      u.PostCount,
+	}
+}
+
+// toGraphUsers converts a list of service users to the GraphQL model
+func toGraphUsers(users []*graphqlservice.User) []*model.User {
+	result := make([]*model.User, len(users))
+	for i, u := range users {
+		result[i] = toGraphUser(u)
+	}
+	return result
+}
+
+// toGraphList converts a service list to the GraphQL model
+func toGraphList(l *graphqlservice.List) *model.List {
+	return &model.List{
+		ID:          l.ID,
+		Owner:       toGraphUser(l.Owner),
+		Name:        l.Name,
+		Description: l.Description,
+		Private:     l.Private,
+		Members:     toGraphUsers(l.Members),
+		CreatedAt:   l.CreatedAt,
+	}
+}
+
+// toGraphSuggestedUser converts a service suggestion to the GraphQL model
+func toGraphSuggestedUser(s *graphqlservice.SuggestedUser) *model.SuggestedUser {
+	return &model.SuggestedUser{
+		User:             toGraphUser(s.User),
+		FriendsFollowing: s.FriendsFollowing,
+		MutualFollowers:  s.MutualFollowers,
+		RecentPosts:      s.RecentPosts,
+	}
+}
+
+// toGraphMutedUser converts a service mute to the GraphQL model
+func toGraphMutedUser(m *graphqlservice.MutedUser) *model.MutedUser {
+	return &model.MutedUser{
+		User:      toGraphUser(m.User),
+		ExpiresAt: optionalString(m.ExpiresAt),
+	}
+}
+
+// toGraphMuteFilter converts a service mute filter to the GraphQL model
+func toGraphMuteFilter(f *graphqlservice.MuteFilter) *model.MuteFilter {
+	return &model.MuteFilter{
+		ID:        f.ID,
+		Kind:      model.MuteFilterKind(f.Kind),
+		Pattern:   f.Pattern,
+		CreatedAt: f.CreatedAt,
+		ExpiresAt: optionalString(f.ExpiresAt),
+	}
+}
+
+// toGraphPoll converts a service poll to the GraphQL model, hiding counts
+// the viewer isn't allowed to see yet
+func toGraphPoll(p *graphqlservice.Poll) *model.Poll {
+	if p == nil {
+		return nil
+	}
+
+	poll := &model.Poll{
+		Options:   make([]*model.PollOption, len(p.Options)),
+		ExpiresAt: p.ExpiresAt,
+		Closed:    p.Closed,
+	}
+	if p.ResultsVisible {
+		total := p.TotalVotes
+		poll.TotalVotes = &total
+	}
+	if p.ViewerVote >= 0 {
+		vote := p.ViewerVote
+		poll.ViewerVote = &vote
+	}
+	for i, o := range p.Options {
+		poll.Options[i] = &model.PollOption{Text: o.Text}
+		if p.ResultsVisible {
+			votes := o.Votes
+			poll.Options[i].Votes = &votes
+		}
+	}
+
+	return poll
+}
+
+// toGraphMedia converts a service media attachment to the GraphQL model
+func toGraphMedia(m *graphqlservice.Media) *model.Media {
+	return &model.Media{
+		ID:           m.ID,
+		URL:          m.URL,
+		ThumbnailURL: m.ThumbnailURL,
+		ContentType:  m.ContentType,
+		Width:        m.Width,
+		Height:       m.Height,
+		AltText:      optionalString(m.AltText),
+	}
+}
+
+// optionalString maps an empty string to a GraphQL null
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// optionalInt maps zero to a GraphQL null
+func optionalInt(n int) *int {
+	if n == 0 {
+		return nil
+	}
+	return &n
+}
+
+// stringValue maps a GraphQL null to an empty string
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// boolValue maps a GraphQL null to false
+func boolValue(b *bool) bool {
+	return b != nil && *b
+}
+
+// visibilityValue maps an omitted visibility to an empty string, which the
+// service treats as public
+func visibilityValue(v *model.Visibility) string {
+	if v == nil {
+		return ""
+	}
+	return string(*v)
+}
+
+// timelineModeValue returns the service value of an optional timeline mode
+func timelineModeValue(m *model.TimelineMode) string {
+	if m == nil {
+		return graphqlservice.TimelineLatest
+	}
+	return string(*m)
+}
+
+// toServiceProfileInput gathers profile arguments for the service layer
+func toServiceProfileInput(displayName, bio, avatarURL, location, website *string) graphqlservice.ProfileInput {
+	return graphqlservice.ProfileInput{
+		DisplayName: displayName,
+		Bio:         bio,
+		AvatarURL:   avatarURL,
+		Location:    location,
+		Website:     website,
+	}
+}
+
+// toServicePollInput converts a GraphQL poll input for the service layer
+func toServicePollInput(poll *model.PollInput) *graphqlservice.PollInput {
+	if poll == nil {
+		return nil
+	}
+	return &graphqlservice.PollInput{Options: poll.Options, ExpiresAt: poll.ExpiresAt}
+}
+
+// toGraphTimelineUpdate converts a service timeline update to the GraphQL
+// model
+func toGraphTimelineUpdate(u *graphqlservice.TimelineUpdate) *model.TimelineUpdate {
+	update := &model.TimelineUpdate{
+		Type:   model.TimelineUpdateType(u.Type),
+		PostID: optionalString(u.PostID),
+	}
+	if u.Post != nil {
+		update.Post = toGraphPost(u.Post)
+	}
+	return update
+}
+
+// toGraphNotification converts a service notification to the GraphQL model
+func toGraphNotification(n *graphqlservice.Notification) *model.Notification {
+	return &model.Notification{
+		ID:        n.ID,
+		UserID:    n.UserID,
+		Kind:      model.NotificationKind(n.Kind),
+		ActorIDs:  n.ActorIDs,
+		PostID:    n.PostID,
+		Read:      n.Read,
+		CreatedAt: n.CreatedAt,
+		UpdatedAt: n.UpdatedAt,
+	}
+}
+
+// toGraphNotificationConnection converts a service page of notifications
+// to the GraphQL model
+func toGraphNotificationConnection(c *graphqlservice.NotificationConnection) *model.NotificationConnection {
+	notifications := make([]*model.Notification, len(c.Notifications))
+	for i, n := range c.Notifications {
+		notifications[i] = toGraphNotification(n)
+	}
+	return &model.NotificationConnection{
+		Notifications: notifications,
+		PageInfo: &model.PageInfo{
+			EndCursor:   optionalString(c.PageInfo.EndCursor),
+			HasNextPage: c.PageInfo.HasNextPage,
+		},
+	}
+}
+
+// toGraphWebhook converts a service webhook to the GraphQL model
+func toGraphWebhook(w *graphqlservice.Webhook) *model.Webhook {
+	events := make([]model.WebhookEvent, len(w.Events))
+	for i, e := range w.Events {
+		events[i] = model.WebhookEvent(e)
+	}
+	return &model.Webhook{
+		ID:                  w.ID,
+		URL:                 w.URL,
+		Secret:              optionalString(w.Secret),
+		Events:              events,
+		Active:              w.Active,
+		DisabledReason:      optionalString(w.DisabledReason),
+		ConsecutiveFailures: w.ConsecutiveFailures,
+		CreatedAt:           w.CreatedAt,
+	}
+}
+
+// toGraphWebhookDelivery converts a service webhook delivery to the
+// GraphQL model
+func toGraphWebhookDelivery(d *graphqlservice.WebhookDelivery) *model.WebhookDelivery {
+	return &model.WebhookDelivery{
+		ID:          d.ID,
+		EventID:     d.EventID,
+		Event:       model.WebhookEvent(d.Event),
+		Attempt:     d.Attempt,
+		StatusCode:  optionalInt(d.StatusCode),
+		Error:       optionalString(d.Error),
+		DurationMs:  d.DurationMs,
+		DeliveredAt: d.DeliveredAt,
+	}
+}
+
+// toGraphDeadLetter converts a service dead letter to the GraphQL model
+func toGraphDeadLetter(dl *graphqlservice.DeadLetter) *model.DeadLetter {
+	return &model.DeadLetter{
+		ID:        dl.ID,
+		WebhookID: dl.WebhookID,
+		EventID:   dl.EventID,
+		Event:     model.WebhookEvent(dl.Event),
+		Payload:   dl.Payload,
+		Attempts:  dl.Attempts,
+		LastError: dl.LastError,
+		FailedAt:  dl.FailedAt,
+	}
+}
@@ -0,0 +1,158 @@
+package graph
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	// loaderWait is how long a loader collects keys before fetching them.
+	// gqlgen resolves the fields of a list concurrently, so this is enough
+	// for a whole page of posts to queue up their lookups.
+	loaderWait = 2 * time.Millisecond
+
+	// loaderMaxBatch caps the keys fetched in one call
+	loaderMaxBatch = 100
+)
+
+// dataloader batches and deduplicates lookups by key made while serving
+// one request, so resolving a field on every item of a list costs one
+// backend call instead of one per item. Results, including errors, are
+// cached for the life of the loader.
+type dataloader[K comparable, V any] struct {
+	ctx      context.Context // The request the loader belongs to
+	fetch    func(ctx context.Context, keys []K) (map[K]V, error)
+	notFound func(key K) error
+
+	mu      sync.Mutex
+	calls   map[K]*loaderCall[V]
+	pending *loaderBatch[K, V]
+}
+
+// loaderCall is the result of loading one key
+type loaderCall[V any] struct {
+	done  chan struct{} // Closed once value and err are set
+	value V
+	err   error
+}
+
+// loaderBatch is a set of keys waiting to be fetched together
+type loaderBatch[K comparable, V any] struct {
+	keys  []K
+	calls []*loaderCall[V]
+	sent  bool // Set by the first dispatch
+}
+
+// newDataloader creates a loader for one request. fetch returns the values
+// it found by key; keys missing from the result fail with notFound.
+func newDataloader[K comparable, V any](ctx context.Context, fetch func(ctx context.Context, keys []K) (map[K]V, error), notFound func(key K) error) *dataloader[K, V] {
+	return &dataloader[K, V]{
+		ctx:      ctx,
+		fetch:    fetch,
+		notFound: notFound,
+		calls:    make(map[K]*loaderCall[V]),
+	}
+}
+
+// Load returns the value for key, waiting for the batch it joins
+func (l *dataloader[K, V]) Load(ctx context.Context, key K) (V, error) {
+	l.mu.Lock()
+	call := l.call(key)
+	l.mu.Unlock()
+
+	return call.wait(ctx)
+}
+
+// LoadAll returns the values for keys in order. The keys join the same
+// batch, so they are fetched together rather than one batch after another.
+func (l *dataloader[K, V]) LoadAll(ctx context.Context, keys []K) ([]V, error) {
+	l.mu.Lock()
+	calls := make([]*loaderCall[V], len(keys))
+	for i, key := range keys {
+		calls[i] = l.call(key)
+	}
+	l.mu.Unlock()
+
+	values := make([]V, len(keys))
+	for i, call := range calls {
+		value, err := call.wait(ctx)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+// call returns the cached call for key, queueing it for the next batch if
+// it hasn't been loaded yet. Must be called with mu held.
+func (l *dataloader[K, V]) call(key K) *loaderCall[V] {
+	call, seen := l.calls[key]
+	if !seen {
+		call = &loaderCall[V]{done: make(chan struct{})}
+		l.calls[key] = call
+		l.enqueue(key, call)
+	}
+	return call
+}
+
+// wait returns the call's result once it is loaded
+func (c *loaderCall[V]) wait(ctx context.Context) (V, error) {
+	select {
+	case <-c.done:
+		return c.value, c.err
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	}
+}
+
+// enqueue adds a key to the pending batch, starting a new batch if there
+// isn't one. Must be called with mu held.
+func (l *dataloader[K, V]) enqueue(key K, call *loaderCall[V]) {
+	if l.pending == nil {
+		batch := &loaderBatch[K, V]{}
+		l.pending = batch
+		time.AfterFunc(loaderWait, func() { l.dispatch(batch) })
+	}
+
+	batch := l.pending
+	batch.keys = append(batch.keys, key)
+	batch.calls = append(batch.calls, call)
+
+	// A full batch goes out straight away
+	if len(batch.keys) >= loaderMaxBatch {
+		l.pending = nil
+		go l.dispatch(batch)
+	}
+}
+
+// dispatch fetches a batch and hands out the results. It runs once for
+// each batch, from whichever of the timer and a full batch comes first.
+func (l *dataloader[K, V]) dispatch(batch *loaderBatch[K, V]) {
+	l.mu.Lock()
+	if batch.sent {
+		l.mu.Unlock()
+		return
+	}
+	batch.sent = true
+	if l.pending == batch {
+		l.pending = nil
+	}
+	l.mu.Unlock()
+
+	values, err := l.fetch(l.ctx, batch.keys)
+	for i, key := range batch.keys {
+		call := batch.calls[i]
+		switch value, found := values[key]; {
+		case err != nil:
+			call.err = err
+		case !found:
+			call.err = l.notFound(key)
+		default:
+			call.value = value
+		}
+		close(call.done)
+	}
+}
@@ -0,0 +1,132 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+)
+
+// countingFetch fetches squares of keys below limit, recording each batch
+type countingFetch struct {
+	mu      sync.Mutex
+	batches [][]int
+	limit   int
+	err     error
+}
+
+func (f *countingFetch) fetch(ctx context.Context, keys []int) (map[int]int, error) {
+	f.mu.Lock()
+	f.batches = append(f.batches, keys)
+	f.mu.Unlock()
+
+	if f.err != nil {
+		return nil, f.err
+	}
+	values := make(map[int]int)
+	for _, k := range keys {
+		if k < f.limit {
+			values[k] = k * k
+		}
+	}
+	return values, nil
+}
+
+func newTestLoader(f *countingFetch) *dataloader[int, int] {
+	return newDataloader(context.Background(), f.fetch, func(key int) error {
+		return fmt.Errorf("%d not found", key)
+	})
+}
+
+func TestDataloaderBatchesConcurrentLoads(t *testing.T) {
+	f := &countingFetch{limit: 100}
+	l := newTestLoader(f)
+
+	// Keys repeat, so each is fetched once and shared
+	var wg sync.WaitGroup
+	for i := range 40 {
+		wg.Add(1)
+		go func(key int) {
+			defer wg.Done()
+			value, err := l.Load(context.Background(), key)
+			if err != nil || value != key*key {
+				t.Errorf("Load(%d) = %d, %v", key, value, err)
+			}
+		}(i % 20)
+	}
+	wg.Wait()
+
+	if len(f.batches) != 1 || len(f.batches[0]) != 20 {
+		t.Fatalf("batches = %v, want one batch of 20 keys", f.batches)
+	}
+
+	// Loaded keys are cached
+	if value, err := l.Load(context.Background(), 7); err != nil || value != 49 {
+		t.Errorf("Load(7) = %d, %v", value, err)
+	}
+	if len(f.batches) != 1 {
+		t.Errorf("cached key was fetched again: %v", f.batches)
+	}
+}
+
+func TestDataloaderLoadAll(t *testing.T) {
+	f := &countingFetch{limit: 10}
+	l := newTestLoader(f)
+
+	values, err := l.LoadAll(context.Background(), []int{3, 1, 2, 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(values) != "[9 1 4 1]" {
+		t.Errorf("LoadAll() = %v, want [9 1 4 1]", values)
+	}
+	if len(f.batches) != 1 {
+		t.Errorf("batches = %v, want one", f.batches)
+	}
+
+	if _, err := l.LoadAll(context.Background(), []int{4, 11}); err == nil || err.Error() != "11 not found" {
+		t.Errorf("LoadAll() with a missing key: got %v, want 11 not found", err)
+	}
+}
+
+func TestDataloaderErrors(t *testing.T) {
+	fetchErr := errors.New("backend unavailable")
+	f := &countingFetch{limit: 10, err: fetchErr}
+	l := newTestLoader(f)
+
+	if _, err := l.Load(context.Background(), 1); !errors.Is(err, fetchErr) {
+		t.Errorf("Load() = %v, want %v", err, fetchErr)
+	}
+
+	// Errors are cached like values, for the life of the request
+	f.err = nil
+	if _, err := l.Load(context.Background(), 1); !errors.Is(err, fetchErr) {
+		t.Errorf("Load() after a failed fetch = %v, want the cached %v", err, fetchErr)
+	}
+	if len(f.batches) != 1 {
+		t.Errorf("batches = %v, want one", f.batches)
+	}
+}
+
+func TestDataloaderMaxBatch(t *testing.T) {
+	f := &countingFetch{limit: 1000}
+	l := newTestLoader(f)
+
+	keys := make([]int, loaderMaxBatch*2+1)
+	for i := range keys {
+		keys[i] = i
+	}
+	if _, err := l.LoadAll(context.Background(), keys); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(f.batches) != 3 {
+		t.Fatalf("fetched in %d batches, want 3", len(f.batches))
+	}
+	for _, batch := range f.batches {
+		if len(batch) > loaderMaxBatch {
+			t.Errorf("batch of %d keys, want at most %d", len(batch), loaderMaxBatch)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/paper-social/feed-service/graphqlservice"
+)
+
+// loadersKey is the context key of a request's loaders
+type loadersKey struct{}
+
+// postKey identifies a post as seen by a viewer, since visibility depends
+// on who is asking
+type postKey struct {
+	ViewerID string
+	PostID   string
+}
+
+// Loaders are the request-scoped dataloaders resolvers use to fetch
+// related objects. Every relation goes through one, so a field resolved on
+// each item of a list costs a single batch call per request.
+type Loaders struct {
+	Users *dataloader[string, *graphqlservice.User]
+	Posts *dataloader[postKey, *graphqlservice.Post]
+}
+
+// NewLoaders creates the loaders for one request
+func NewLoaders(ctx context.Context, service *graphqlservice.Service) *Loaders {
+	return &Loaders{
+		Users: newDataloader(ctx, service.UsersByIDs, func(id string) error {
+			return fmt.Errorf("user with ID %s not found", id)
+		}),
+		Posts: newDataloader(ctx, func(ctx context.Context, keys []postKey) (map[postKey]*graphqlservice.Post, error) {
+			return fetchPosts(ctx, service, keys)
+		}, func(key postKey) error {
+			return fmt.Errorf("post with ID %s not found", key.PostID)
+		}),
+	}
+}
+
+// LoaderMiddleware gives every request its own loaders. WebSocket
+// connections are left without, since they outlive any one operation and
+// the loaders' cache would go stale; their resolvers fall back to loaders
+// scoped to each call.
+func LoaderMiddleware(service *graphqlservice.Service, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+			next.ServeHTTP(w, r)
+			return
+		}
+		ctx := context.WithValue(r.Context(), loadersKey{}, NewLoaders(r.Context(), service))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// loaders returns the request's loaders. Outside LoaderMiddleware it
+// returns fresh ones, which still work but only batch within one call.
+func (r *Resolver) loaders(ctx context.Context) *Loaders {
+	if l, ok := ctx.Value(loadersKey{}).(*Loaders); ok {
+		return l
+	}
+	return NewLoaders(ctx, r.Service)
+}
+
+// fetchPosts makes one batch call per viewer; a request almost always has
+// a single viewer
+func fetchPosts(ctx context.Context, service *graphqlservice.Service, keys []postKey) (map[postKey]*graphqlservice.Post, error) {
+	byViewer := make(map[string][]string)
+	for _, k := range keys {
+		byViewer[k.ViewerID] = append(byViewer[k.ViewerID], k.PostID)
+	}
+
+	posts := make(map[postKey]*graphqlservice.Post, len(keys))
+	for viewerID, ids := range byViewer {
+		found, err := service.PostsByIDs(ctx, viewerID, ids)
+		if err != nil {
+			return nil, err
+		}
+		for id, p := range found {
+			posts[postKey{ViewerID: viewerID, PostID: id}] = p
+		}
+	}
+	return posts, nil
+}
@@ -0,0 +1,137 @@
+package graph
+
+import (
+	"context"
+	"net"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/paper-social/feed-service/graphqlservice"
+	"github.com/paper-social/feed-service/graphqlservice/graph/generated"
+	"github.com/paper-social/feed-service/linkpreview"
+	"github.com/paper-social/feed-service/model"
+	"github.com/paper-social/feed-service/postservice"
+	"github.com/paper-social/feed-service/proto/post"
+	"github.com/paper-social/feed-service/proto/user"
+	"google.golang.org/grpc"
+)
+
+// callCounter counts the calls made to each backend method
+type callCounter struct {
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+func (c *callCounter) intercept(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	c.mu.Lock()
+	c.calls[info.FullMethod]++
+	c.mu.Unlock()
+	return handler(ctx, req)
+}
+
+// take returns the counts since the last call and starts over
+func (c *callCounter) take() map[string]int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	calls := c.calls
+	c.calls = make(map[string]int)
+	return calls
+}
+
+// memDraftStore keeps drafts in memory
+type memDraftStore struct{}
+
+func (memDraftStore) Load() (postservice.SavedDrafts, error)   { return postservice.SavedDrafts{}, nil }
+func (memDraftStore) Save(saved postservice.SavedDrafts) error { return nil }
+
+// newTestClient serves the schema the way the public API does, over a post
+// service on the mock data whose calls are counted
+func newTestClient(t *testing.T) (*client.Client, *callCounter) {
+	counter := &callCounter{calls: make(map[string]int)}
+
+	cfg := linkpreview.DefaultConfig()
+	cfg.Workers = 0
+	previews := linkpreview.NewService(cfg)
+	t.Cleanup(previews.Close)
+
+	db := model.NewDatabase()
+	backend := grpc.NewServer(grpc.UnaryInterceptor(counter.intercept))
+	post.RegisterPostServiceServer(backend, postservice.NewServer(db, previews, memDraftStore{}))
+	user.RegisterUserServiceServer(backend, postservice.NewUserServer(db))
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go backend.Serve(listener)
+	t.Cleanup(backend.Stop)
+
+	service := graphqlservice.NewService(listener.Addr().String(), nil)
+	srv := handler.New(generated.NewExecutableSchema(generated.Config{
+		Resolvers: &Resolver{Service: service},
+	}))
+	srv.AddTransport(transport.POST{})
+
+	server := httptest.NewServer(LoaderMiddleware(service, srv))
+	t.Cleanup(server.Close)
+
+	return client.New(server.Config.Handler), counter
+}
+
+func TestLoadersBatchPerRequest(t *testing.T) {
+	c, counter := newTestClient(t)
+
+	// Every timeline post needs its author, and both posts go through the
+	// post loader
+	const query = `query {
+		getTimeline(userId: "user1") { posts { id author { id username } } }
+		first: post(id: "post1", viewerId: "user1") { id pinned }
+		second: post(id: "post2", viewerId: "user1") { id pinned }
+	}`
+
+	var resp struct {
+		GetTimeline struct {
+			Posts []struct {
+				ID     string
+				Author struct{ ID, Username string }
+			}
+		}
+		First, Second *struct {
+			ID     string
+			Pinned bool
+		}
+	}
+
+	// A second request must fetch again rather than reuse the first
+	// request's loaders
+	for i := range 2 {
+		c.MustPost(query, &resp)
+
+		authors := make(map[string]bool)
+		for _, p := range resp.GetTimeline.Posts {
+			if p.Author.ID == "" || p.Author.Username == "" {
+				t.Fatalf("post %s has no author", p.ID)
+			}
+			authors[p.Author.ID] = true
+		}
+		if len(authors) < 2 {
+			t.Fatalf("timeline has posts by %d authors, want several to batch", len(authors))
+		}
+		if resp.First == nil || resp.First.ID != "post1" || resp.Second == nil || resp.Second.ID != "post2" {
+			t.Fatalf("post lookups = %+v, %+v", resp.First, resp.Second)
+		}
+
+		// Building the timeline makes calls of its own; the loaders make
+		// one batch call each
+		calls := counter.take()
+		for _, method := range []string{"/user.UserService/GetUsers", "/post.PostService/GetPosts"} {
+			if calls[method] != 1 {
+				t.Errorf("request %d: %s called %d times, want 1", i+1, method, calls[method])
+			}
+		}
+	}
+}
@@ -5,11 +5,27 @@ package model
 
 // Post represents a post in the GraphQL model
 type Post struct {
-	ID        string   `json:"id"`
-	UserID    string   `json:"userId"`
-	Content   string   `json:"content"`
-	CreatedAt string   `json:"createdAt"`
-	ImageUrls []string `json:"imageUrls,omitempty"`
+	ID        string     `json:"id"`
+	UserID    string     `json:"userId"`
+	Content   string     `json:"content"`
+	CreatedAt string     `json:"createdAt"`
+	Entities  []*Entity  `json:"entities"`
+	Media     []*Media   `json:"media"`
+	Poll      *Poll      `json:"poll,omitempty"`
+	Status    PostStatus `json:"status"`
+	PublishAt *string    `json:"publishAt,omitempty"`
+	Pinned    bool       `json:"pinned"`
+	ReplyToID *string    `json:"replyToId,omitempty"`
+
+	Visibility Visibility `json:"visibility"`
+
+	LikeCount   int  `json:"likeCount"`
+	ReplyCount  int  `json:"replyCount"`
+	RepostCount int  `json:"repostCount"`
+	Liked       bool `json:"liked"`
+	Reposted    bool `json:"reposted"`
+
+	LinkPreviews []*LinkPreview `json:"linkPreviews"`
 }
 
 // DeleteResponse represents the response to a delete post operation
@@ -17,3 +33,16 @@ type DeleteResponse struct {
 	Success bool    `json:"success"`
 	Message *string `json:"message,omitempty"`
 }
+
+// Notification represents a notification in the GraphQL model. The actors
+// and post are resolved from their IDs.
+type Notification struct {
+	ID        string           `json:"id"`
+	UserID    string           `json:"-"`
+	Kind      NotificationKind `json:"kind"`
+	ActorIDs  []string         `json:"-"`
+	PostID    string           `json:"-"`
+	Read      bool             `json:"read"`
+	CreatedAt string           `json:"createdAt"`
+	UpdatedAt string           `json:"updatedAt"`
+}
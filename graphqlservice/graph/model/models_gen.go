@@ -2,8 +2,702 @@
 
 package model
 
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+type DeadLetter struct {
+	ID        string       `json:"id"`
+	WebhookID string       `json:"webhookId"`
+	EventID   string       `json:"eventId"`
+	Event     WebhookEvent `json:"event"`
+	Payload   string       `json:"payload"`
+	Attempts  int          `json:"attempts"`
+	LastError string       `json:"lastError"`
+	FailedAt  string       `json:"failedAt"`
+}
+
+type Entity struct {
+	Type      EntityType `json:"type"`
+	Text      string     `json:"text"`
+	Value     string     `json:"value"`
+	Start     int        `json:"start"`
+	End       int        `json:"end"`
+	ByteStart int        `json:"byteStart"`
+	ByteEnd   int        `json:"byteEnd"`
+}
+
+type FollowResult struct {
+	Status FollowStatus `json:"status"`
+	User   *User        `json:"user"`
+}
+
+type LinkPreview struct {
+	URL         string  `json:"url"`
+	Title       *string `json:"title,omitempty"`
+	Description *string `json:"description,omitempty"`
+	ImageURL    *string `json:"imageUrl,omitempty"`
+	SiteName    *string `json:"siteName,omitempty"`
+	AuthorName  *string `json:"authorName,omitempty"`
+	Type        *string `json:"type,omitempty"`
+	FetchedAt   string  `json:"fetchedAt"`
+}
+
+type List struct {
+	ID          string  `json:"id"`
+	Owner       *User   `json:"owner"`
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Private     bool    `json:"private"`
+	Members     []*User `json:"members"`
+	CreatedAt   string  `json:"createdAt"`
+}
+
+type Media struct {
+	ID           string  `json:"id"`
+	URL          string  `json:"url"`
+	ThumbnailURL string  `json:"thumbnailUrl"`
+	ContentType  string  `json:"contentType"`
+	Width        int     `json:"width"`
+	Height       int     `json:"height"`
+	AltText      *string `json:"altText,omitempty"`
+}
+
 type Mutation struct {
 }
 
+type MuteFilter struct {
+	ID        string         `json:"id"`
+	Kind      MuteFilterKind `json:"kind"`
+	Pattern   string         `json:"pattern"`
+	CreatedAt string         `json:"createdAt"`
+	ExpiresAt *string        `json:"expiresAt,omitempty"`
+}
+
+type MutedUser struct {
+	User      *User   `json:"user"`
+	ExpiresAt *string `json:"expiresAt,omitempty"`
+}
+
+type NotificationConnection struct {
+	Notifications []*Notification `json:"notifications"`
+	PageInfo      *PageInfo       `json:"pageInfo"`
+}
+
+type PageInfo struct {
+	EndCursor   *string `json:"endCursor,omitempty"`
+	HasNextPage bool    `json:"hasNextPage"`
+}
+
+type Poll struct {
+	Options    []*PollOption `json:"options"`
+	ExpiresAt  string        `json:"expiresAt"`
+	Closed     bool          `json:"closed"`
+	TotalVotes *int          `json:"totalVotes,omitempty"`
+	ViewerVote *int          `json:"viewerVote,omitempty"`
+}
+
+type PollInput struct {
+	Options   []string `json:"options"`
+	ExpiresAt string   `json:"expiresAt"`
+}
+
+type PollOption struct {
+	Text  string `json:"text"`
+	Votes *int   `json:"votes,omitempty"`
+}
+
+type PostConnection struct {
+	Posts    []*Post   `json:"posts"`
+	PageInfo *PageInfo `json:"pageInfo"`
+}
+
 type Query struct {
 }
+
+type Subscription struct {
+}
+
+type SuggestedUser struct {
+	User             *User `json:"user"`
+	FriendsFollowing int   `json:"friendsFollowing"`
+	MutualFollowers  int   `json:"mutualFollowers"`
+	RecentPosts      int   `json:"recentPosts"`
+}
+
+type TimelineUpdate struct {
+	Type   TimelineUpdateType `json:"type"`
+	PostID *string            `json:"postId,omitempty"`
+	Post   *Post              `json:"post,omitempty"`
+}
+
+type User struct {
+	ID             string `json:"id"`
+	Username       string `json:"username"`
+	Protected      bool   `json:"protected"`
+	DisplayName    string `json:"displayName"`
+	Bio            string `json:"bio"`
+	AvatarURL      string `json:"avatarUrl"`
+	Location       string `json:"location"`
+	Website        string `json:"website"`
+	CreatedAt      string `json:"createdAt"`
+	FollowerCount  int    `json:"followerCount"`
+	FollowingCount int    `json:"followingCount"`
+	PostCount      int    `json:"postCount"`
+}
+
+type Webhook struct {
+	ID                  string         `json:"id"`
+	URL                 string         `json:"url"`
+	Secret              *string        `json:"secret,omitempty"`
+	Events              []WebhookEvent `json:"events"`
+	Active              bool           `json:"active"`
+	DisabledReason      *string        `json:"disabledReason,omitempty"`
+	ConsecutiveFailures int            `json:"consecutiveFailures"`
+	CreatedAt           string         `json:"createdAt"`
+}
+
+type WebhookDelivery struct {
+	ID          string       `json:"id"`
+	EventID     string       `json:"eventId"`
+	Event       WebhookEvent `json:"event"`
+	Attempt     int          `json:"attempt"`
+	StatusCode  *int         `json:"statusCode,omitempty"`
+	Error       *string      `json:"error,omitempty"`
+	DurationMs  int          `json:"durationMs"`
+	DeliveredAt string       `json:"deliveredAt"`
+}
+
+type EntityType string
+
+const (
+	EntityTypeLink      EntityType = "LINK"
+	EntityTypeImageLink EntityType = "IMAGE_LINK"
+	EntityTypeMention   EntityType = "MENTION"
+	EntityTypeHashtag   EntityType = "HASHTAG"
+	EntityTypeCashtag   EntityType = "CASHTAG"
+)
+
+var AllEntityType = []EntityType{
+	EntityTypeLink,
+	EntityTypeImageLink,
+	EntityTypeMention,
+	EntityTypeHashtag,
+	EntityTypeCashtag,
+}
+
+func (e EntityType) IsValid() bool {
+	switch e {
+	case EntityTypeLink, EntityTypeImageLink, EntityTypeMention, EntityTypeHashtag, EntityTypeCashtag:
+		return true
+	}
+	return false
+}
+
+func (e EntityType) String() string {
+	return string(e)
+}
+
+func (e *EntityType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = EntityType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid EntityType", str)
+	}
+	return nil
+}
+
+func (e EntityType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *EntityType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e EntityType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type FollowStatus string
+
+const (
+	FollowStatusFollowing FollowStatus = "FOLLOWING"
+	FollowStatusRequested FollowStatus = "REQUESTED"
+)
+
+var AllFollowStatus = []FollowStatus{
+	FollowStatusFollowing,
+	FollowStatusRequested,
+}
+
+func (e FollowStatus) IsValid() bool {
+	switch e {
+	case FollowStatusFollowing, FollowStatusRequested:
+		return true
+	}
+	return false
+}
+
+func (e FollowStatus) String() string {
+	return string(e)
+}
+
+func (e *FollowStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FollowStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FollowStatus", str)
+	}
+	return nil
+}
+
+func (e FollowStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *FollowStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e FollowStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type MuteFilterKind string
+
+const (
+	MuteFilterKindWord    MuteFilterKind = "WORD"
+	MuteFilterKindPhrase  MuteFilterKind = "PHRASE"
+	MuteFilterKindHashtag MuteFilterKind = "HASHTAG"
+	MuteFilterKindRegex   MuteFilterKind = "REGEX"
+)
+
+var AllMuteFilterKind = []MuteFilterKind{
+	MuteFilterKindWord,
+	MuteFilterKindPhrase,
+	MuteFilterKindHashtag,
+	MuteFilterKindRegex,
+}
+
+func (e MuteFilterKind) IsValid() bool {
+	switch e {
+	case MuteFilterKindWord, MuteFilterKindPhrase, MuteFilterKindHashtag, MuteFilterKindRegex:
+		return true
+	}
+	return false
+}
+
+func (e MuteFilterKind) String() string {
+	return string(e)
+}
+
+func (e *MuteFilterKind) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MuteFilterKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MuteFilterKind", str)
+	}
+	return nil
+}
+
+func (e MuteFilterKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *MuteFilterKind) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e MuteFilterKind) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type NotificationKind string
+
+const (
+	NotificationKindFollow        NotificationKind = "FOLLOW"
+	NotificationKindFollowRequest NotificationKind = "FOLLOW_REQUEST"
+	NotificationKindMention       NotificationKind = "MENTION"
+	NotificationKindPollVote      NotificationKind = "POLL_VOTE"
+	NotificationKindLike          NotificationKind = "LIKE"
+	NotificationKindReply         NotificationKind = "REPLY"
+	NotificationKindRepost        NotificationKind = "REPOST"
+)
+
+var AllNotificationKind = []NotificationKind{
+	NotificationKindFollow,
+	NotificationKindFollowRequest,
+	NotificationKindMention,
+	NotificationKindPollVote,
+	NotificationKindLike,
+	NotificationKindReply,
+	NotificationKindRepost,
+}
+
+func (e NotificationKind) IsValid() bool {
+	switch e {
+	case NotificationKindFollow, NotificationKindFollowRequest, NotificationKindMention, NotificationKindPollVote, NotificationKindLike, NotificationKindReply, NotificationKindRepost:
+		return true
+	}
+	return false
+}
+
+func (e NotificationKind) String() string {
+	return string(e)
+}
+
+func (e *NotificationKind) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = NotificationKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid NotificationKind", str)
+	}
+	return nil
+}
+
+func (e NotificationKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *NotificationKind) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e NotificationKind) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type PostStatus string
+
+const (
+	PostStatusPublished PostStatus = "PUBLISHED"
+	PostStatusDraft     PostStatus = "DRAFT"
+	PostStatusScheduled PostStatus = "SCHEDULED"
+)
+
+var AllPostStatus = []PostStatus{
+	PostStatusPublished,
+	PostStatusDraft,
+	PostStatusScheduled,
+}
+
+func (e PostStatus) IsValid() bool {
+	switch e {
+	case PostStatusPublished, PostStatusDraft, PostStatusScheduled:
+		return true
+	}
+	return false
+}
+
+func (e PostStatus) String() string {
+	return string(e)
+}
+
+func (e *PostStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PostStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PostStatus", str)
+	}
+	return nil
+}
+
+func (e PostStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *PostStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e PostStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type TimelineMode string
+
+const (
+	TimelineModeLatest TimelineMode = "LATEST"
+	TimelineModeForYou TimelineMode = "FOR_YOU"
+)
+
+var AllTimelineMode = []TimelineMode{
+	TimelineModeLatest,
+	TimelineModeForYou,
+}
+
+func (e TimelineMode) IsValid() bool {
+	switch e {
+	case TimelineModeLatest, TimelineModeForYou:
+		return true
+	}
+	return false
+}
+
+func (e TimelineMode) String() string {
+	return string(e)
+}
+
+func (e *TimelineMode) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TimelineMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TimelineMode", str)
+	}
+	return nil
+}
+
+func (e TimelineMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *TimelineMode) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e TimelineMode) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type TimelineUpdateType string
+
+const (
+	TimelineUpdateTypeCreated TimelineUpdateType = "CREATED"
+	TimelineUpdateTypeUpdated TimelineUpdateType = "UPDATED"
+	TimelineUpdateTypeDeleted TimelineUpdateType = "DELETED"
+	TimelineUpdateTypeResync  TimelineUpdateType = "RESYNC"
+)
+
+var AllTimelineUpdateType = []TimelineUpdateType{
+	TimelineUpdateTypeCreated,
+	TimelineUpdateTypeUpdated,
+	TimelineUpdateTypeDeleted,
+	TimelineUpdateTypeResync,
+}
+
+func (e TimelineUpdateType) IsValid() bool {
+	switch e {
+	case TimelineUpdateTypeCreated, TimelineUpdateTypeUpdated, TimelineUpdateTypeDeleted, TimelineUpdateTypeResync:
+		return true
+	}
+	return false
+}
+
+func (e TimelineUpdateType) String() string {
+	return string(e)
+}
+
+func (e *TimelineUpdateType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TimelineUpdateType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TimelineUpdateType", str)
+	}
+	return nil
+}
+
+func (e TimelineUpdateType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *TimelineUpdateType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e TimelineUpdateType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type Visibility string
+
+const (
+	VisibilityPublic    Visibility = "PUBLIC"
+	VisibilityFollowers Visibility = "FOLLOWERS"
+	VisibilityMentioned Visibility = "MENTIONED"
+)
+
+var AllVisibility = []Visibility{
+	VisibilityPublic,
+	VisibilityFollowers,
+	VisibilityMentioned,
+}
+
+func (e Visibility) IsValid() bool {
+	switch e {
+	case VisibilityPublic, VisibilityFollowers, VisibilityMentioned:
+		return true
+	}
+	return false
+}
+
+func (e Visibility) String() string {
+	return string(e)
+}
+
+func (e *Visibility) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Visibility(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Visibility", str)
+	}
+	return nil
+}
+
+func (e Visibility) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *Visibility) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e Visibility) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type WebhookEvent string
+
+const (
+	WebhookEventPostCreated     WebhookEvent = "POST_CREATED"
+	WebhookEventPostUpdated     WebhookEvent = "POST_UPDATED"
+	WebhookEventPostDeleted     WebhookEvent = "POST_DELETED"
+	WebhookEventUserFollowed    WebhookEvent = "USER_FOLLOWED"
+	WebhookEventUserUnfollowed  WebhookEvent = "USER_UNFOLLOWED"
+	WebhookEventFollowRequested WebhookEvent = "FOLLOW_REQUESTED"
+)
+
+var AllWebhookEvent = []WebhookEvent{
+	WebhookEventPostCreated,
+	WebhookEventPostUpdated,
+	WebhookEventPostDeleted,
+	WebhookEventUserFollowed,
+	WebhookEventUserUnfollowed,
+	WebhookEventFollowRequested,
+}
+
+func (e WebhookEvent) IsValid() bool {
+	switch e {
+	case WebhookEventPostCreated, WebhookEventPostUpdated, WebhookEventPostDeleted, WebhookEventUserFollowed, WebhookEventUserUnfollowed, WebhookEventFollowRequested:
+		return true
+	}
+	return false
+}
+
+func (e WebhookEvent) String() string {
+	return string(e)
+}
+
+func (e *WebhookEvent) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WebhookEvent(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WebhookEvent", str)
+	}
+	return nil
+}
+
+func (e WebhookEvent) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *WebhookEvent) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e WebhookEvent) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
@@ -7,24 +7,54 @@ package graph
 import (
 	"context"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/paper-social/feed-service/graphqlservice/graph/generated"
 	"github.com/paper-social/feed-service/graphqlservice/graph/model"
 )
 
+// CreateUser is the resolver for the createUser field.
+func (r *mutationResolver) CreateUser(ctx context.Context, username string, displayName *string, bio *string, avatarURL *string, location *string, website *string) (*model.User, error) {
+	user, err := r.Service.CreateUser(ctx, username, toServiceProfileInput(displayName, bio, avatarURL, location, website))
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphUser(user), nil
+}
+
+// UpdateProfile is the resolver for the updateProfile field.
+func (r *mutationResolver) UpdateProfile(ctx context.Context, userID string, displayName *string, bio *string, avatarURL *string, location *string, website *string) (*model.User, error) {
+	user, err := r.Service.UpdateProfile(ctx, userID, toServiceProfileInput(displayName, bio, avatarURL, location, website))
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphUser(user), nil
+}
+
+// UploadMedia is the resolver for the uploadMedia field.
+func (r *mutationResolver) UploadMedia(ctx context.Context, userID string, file graphql.Upload, altText *string) (*model.Media, error) {
+	alt := ""
+	if altText != nil {
+		alt = *altText
+	}
+
+	media, err := r.Service.UploadMedia(ctx, userID, file.File, alt)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphMedia(media), nil
+}
+
 // CreatePost is the resolver for the createPost field.
-func (r *mutationResolver) CreatePost(ctx context.Context, userID string, content string) (*model.Post, error) {
-	post, err := r.Service.CreatePost(ctx, userID, content)
+func (r *mutationResolver) CreatePost(ctx context.Context, userID string, content string, mediaIds []string, poll *model.PollInput, visibility *model.Visibility, replyToID *string) (*model.Post, error) {
+	post, err := r.Service.CreatePost(ctx, userID, content, mediaIds, toServicePollInput(poll), visibilityValue(visibility), stringValue(replyToID))
 	if err != nil {
 		return nil, err
 	}
 
-	return &model.Post{
-		ID:        post.ID,
-		UserID:    post.UserID,
-		Content:   post.Content,
-		CreatedAt: post.CreatedAt,
-		ImageUrls: post.ImageURLs,
-	}, nil
+	return toGraphPost(post), nil
 }
 
 // UpdatePost is the resolver for the updatePost field.
@@ -34,13 +64,7 @@ func (r *mutationResolver) UpdatePost(ctx context.Context, id string, content st
 		return nil, err
 	}
 
-	return &model.Post{
-		ID:        post.ID,
-		UserID:    post.UserID,
-		Content:   post.Content,
-		CreatedAt: post.CreatedAt,
-		ImageUrls: post.ImageURLs,
-	}, nil
+	return toGraphPost(post), nil
 }
 
 // DeletePost is the resolver for the deletePost field.
@@ -57,41 +81,728 @@ func (r *mutationResolver) DeletePost(ctx context.Context, id string) (*model.De
 	}, nil
 }
 
-// ImageUrls is the resolver for the imageUrls field.
-func (r *postResolver) ImageUrls(ctx context.Context, obj *model.Post) ([]string, error) {
-	return obj.ImageUrls, nil
+// VotePoll is the resolver for the votePoll field.
+func (r *mutationResolver) VotePoll(ctx context.Context, userID string, postID string, option int) (*model.Post, error) {
+	post, err := r.Service.VotePoll(ctx, userID, postID, option)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphPost(post), nil
+}
+
+// SaveDraft is the resolver for the saveDraft field.
+func (r *mutationResolver) SaveDraft(ctx context.Context, userID string, content string, mediaIds []string, poll *model.PollInput, visibility *model.Visibility, replyToID *string) (*model.Post, error) {
+	post, err := r.Service.SaveDraft(ctx, userID, content, mediaIds, toServicePollInput(poll), visibilityValue(visibility), stringValue(replyToID))
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphPost(post), nil
+}
+
+// SchedulePost is the resolver for the schedulePost field.
+func (r *mutationResolver) SchedulePost(ctx context.Context, id string, publishAt string) (*model.Post, error) {
+	post, err := r.Service.SchedulePost(ctx, id, publishAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphPost(post), nil
+}
+
+// CancelScheduledPost is the resolver for the cancelScheduledPost field.
+func (r *mutationResolver) CancelScheduledPost(ctx context.Context, id string) (*model.Post, error) {
+	post, err := r.Service.CancelScheduledPost(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphPost(post), nil
+}
+
+// PublishPost is the resolver for the publishPost field.
+func (r *mutationResolver) PublishPost(ctx context.Context, id string) (*model.Post, error) {
+	post, err := r.Service.PublishPost(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphPost(post), nil
+}
+
+// PinPost is the resolver for the pinPost field.
+func (r *mutationResolver) PinPost(ctx context.Context, userID string, postID string) (*model.Post, error) {
+	post, err := r.Service.PinPost(ctx, userID, postID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphPost(post), nil
+}
+
+// UnpinPost is the resolver for the unpinPost field.
+func (r *mutationResolver) UnpinPost(ctx context.Context, userID string, postID string) (*model.Post, error) {
+	post, err := r.Service.UnpinPost(ctx, userID, postID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphPost(post), nil
+}
+
+// LikePost is the resolver for the likePost field.
+func (r *mutationResolver) LikePost(ctx context.Context, userID string, postID string) (*model.Post, error) {
+	post, err := r.Service.LikePost(ctx, userID, postID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphPost(post), nil
+}
+
+// UnlikePost is the resolver for the unlikePost field.
+func (r *mutationResolver) UnlikePost(ctx context.Context, userID string, postID string) (*model.Post, error) {
+	post, err := r.Service.UnlikePost(ctx, userID, postID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphPost(post), nil
+}
+
+// RepostPost is the resolver for the repostPost field.
+func (r *mutationResolver) RepostPost(ctx context.Context, userID string, postID string) (*model.Post, error) {
+	post, err := r.Service.RepostPost(ctx, userID, postID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphPost(post), nil
+}
+
+// UndoRepost is the resolver for the undoRepost field.
+func (r *mutationResolver) UndoRepost(ctx context.Context, userID string, postID string) (*model.Post, error) {
+	post, err := r.Service.UndoRepost(ctx, userID, postID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphPost(post), nil
+}
+
+// FollowUser is the resolver for the followUser field.
+func (r *mutationResolver) FollowUser(ctx context.Context, userID string, followeeID string) (*model.FollowResult, error) {
+	result, err := r.Service.FollowUser(ctx, userID, followeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.FollowResult{
+		Status: model.FollowStatus(result.Status),
+		User:   toGraphUser(result.User),
+	}, nil
+}
+
+// UnfollowUser is the resolver for the unfollowUser field.
+func (r *mutationResolver) UnfollowUser(ctx context.Context, userID string, followeeID string) (*model.User, error) {
+	user, err := r.Service.UnfollowUser(ctx, userID, followeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphUser(user), nil
+}
+
+// SetAccountProtected is the resolver for the setAccountProtected field.
+func (r *mutationResolver) SetAccountProtected(ctx context.Context, userID string, protected bool) (*model.User, error) {
+	user, err := r.Service.SetAccountProtected(ctx, userID, protected)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphUser(user), nil
+}
+
+// ApproveFollowRequest is the resolver for the approveFollowRequest field.
+func (r *mutationResolver) ApproveFollowRequest(ctx context.Context, userID string, requesterID string) (*model.User, error) {
+	user, err := r.Service.ApproveFollowRequest(ctx, userID, requesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphUser(user), nil
+}
+
+// RejectFollowRequest is the resolver for the rejectFollowRequest field.
+func (r *mutationResolver) RejectFollowRequest(ctx context.Context, userID string, requesterID string) (*model.User, error) {
+	user, err := r.Service.RejectFollowRequest(ctx, userID, requesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphUser(user), nil
+}
+
+// BlockUser is the resolver for the blockUser field.
+func (r *mutationResolver) BlockUser(ctx context.Context, userID string, targetID string) (*model.User, error) {
+	user, err := r.Service.BlockUser(ctx, userID, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphUser(user), nil
+}
+
+// UnblockUser is the resolver for the unblockUser field.
+func (r *mutationResolver) UnblockUser(ctx context.Context, userID string, targetID string) (*model.User, error) {
+	user, err := r.Service.UnblockUser(ctx, userID, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphUser(user), nil
+}
+
+// MuteUser is the resolver for the muteUser field.
+func (r *mutationResolver) MuteUser(ctx context.Context, userID string, targetID string, expiresAt *string) (*model.MutedUser, error) {
+	muted, err := r.Service.MuteUser(ctx, userID, targetID, stringValue(expiresAt))
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphMutedUser(muted), nil
+}
+
+// UnmuteUser is the resolver for the unmuteUser field.
+func (r *mutationResolver) UnmuteUser(ctx context.Context, userID string, targetID string) (*model.User, error) {
+	user, err := r.Service.UnmuteUser(ctx, userID, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphUser(user), nil
+}
+
+// AddMuteFilter is the resolver for the addMuteFilter field.
+func (r *mutationResolver) AddMuteFilter(ctx context.Context, userID string, kind model.MuteFilterKind, pattern string, expiresAt *string) (*model.MuteFilter, error) {
+	filter, err := r.Service.AddMuteFilter(ctx, userID, string(kind), pattern, stringValue(expiresAt))
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphMuteFilter(filter), nil
+}
+
+// RemoveMuteFilter is the resolver for the removeMuteFilter field.
+func (r *mutationResolver) RemoveMuteFilter(ctx context.Context, userID string, filterID string) (*model.MuteFilter, error) {
+	filter, err := r.Service.RemoveMuteFilter(ctx, userID, filterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphMuteFilter(filter), nil
+}
+
+// CreateList is the resolver for the createList field.
+func (r *mutationResolver) CreateList(ctx context.Context, userID string, name string, description *string, private *bool) (*model.List, error) {
+	list, err := r.Service.CreateList(ctx, userID, name, stringValue(description), boolValue(private))
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphList(list), nil
+}
+
+// UpdateList is the resolver for the updateList field.
+func (r *mutationResolver) UpdateList(ctx context.Context, userID string, listID string, name string, description *string, private *bool) (*model.List, error) {
+	list, err := r.Service.UpdateList(ctx, userID, listID, name, stringValue(description), boolValue(private))
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphList(list), nil
+}
+
+// DeleteList is the resolver for the deleteList field.
+func (r *mutationResolver) DeleteList(ctx context.Context, userID string, listID string) (*model.List, error) {
+	list, err := r.Service.DeleteList(ctx, userID, listID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphList(list), nil
+}
+
+// AddListMember is the resolver for the addListMember field.
+func (r *mutationResolver) AddListMember(ctx context.Context, userID string, listID string, memberID string) (*model.List, error) {
+	list, err := r.Service.AddListMember(ctx, userID, listID, memberID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphList(list), nil
+}
+
+// RemoveListMember is the resolver for the removeListMember field.
+func (r *mutationResolver) RemoveListMember(ctx context.Context, userID string, listID string, memberID string) (*model.List, error) {
+	list, err := r.Service.RemoveListMember(ctx, userID, listID, memberID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphList(list), nil
+}
+
+// MarkNotificationsRead is the resolver for the markNotificationsRead field.
+func (r *mutationResolver) MarkNotificationsRead(ctx context.Context, userID string, ids []string) (int, error) {
+	return r.Service.MarkNotificationsRead(ctx, userID, ids)
+}
+
+// CreateWebhook is the resolver for the createWebhook field.
+func (r *mutationResolver) CreateWebhook(ctx context.Context, userID string, url string, events []model.WebhookEvent) (*model.Webhook, error) {
+	names := make([]string, len(events))
+	for i, e := range events {
+		names[i] = string(e)
+	}
+
+	webhook, err := r.Service.CreateWebhook(ctx, userID, url, names)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphWebhook(webhook), nil
+}
+
+// DeleteWebhook is the resolver for the deleteWebhook field.
+func (r *mutationResolver) DeleteWebhook(ctx context.Context, userID string, webhookID string) (*model.Webhook, error) {
+	webhook, err := r.Service.DeleteWebhook(ctx, userID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphWebhook(webhook), nil
+}
+
+// SetWebhookActive is the resolver for the setWebhookActive field.
+func (r *mutationResolver) SetWebhookActive(ctx context.Context, userID string, webhookID string, active bool) (*model.Webhook, error) {
+	webhook, err := r.Service.SetWebhookActive(ctx, userID, webhookID, active)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphWebhook(webhook), nil
+}
+
+// RedeliverDeadLetter is the resolver for the redeliverDeadLetter field.
+func (r *mutationResolver) RedeliverDeadLetter(ctx context.Context, userID string, deadLetterID string) (*model.DeadLetter, error) {
+	deadLetter, err := r.Service.RedeliverDeadLetter(ctx, userID, deadLetterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphDeadLetter(deadLetter), nil
+}
+
+// Actors is the resolver for the actors field.
+func (r *notificationResolver) Actors(ctx context.Context, obj *model.Notification) ([]*model.User, error) {
+	actors, err := r.loaders(ctx).Users.LoadAll(ctx, obj.ActorIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphUsers(actors), nil
+}
+
+// ActorCount is the resolver for the actorCount field.
+func (r *notificationResolver) ActorCount(ctx context.Context, obj *model.Notification) (int, error) {
+	return len(obj.ActorIDs), nil
+}
+
+// Post is the resolver for the post field.
+func (r *notificationResolver) Post(ctx context.Context, obj *model.Notification) (*model.Post, error) {
+	if obj.PostID == "" {
+		return nil, nil
+	}
+
+	// Loaded as the recipient sees it
+	post, err := r.loaders(ctx).Posts.Load(ctx, postKey{ViewerID: obj.UserID, PostID: obj.PostID})
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphPost(post), nil
+}
+
+// Author is the resolver for the author field.
+func (r *postResolver) Author(ctx context.Context, obj *model.Post) (*model.User, error) {
+	author, err := r.loaders(ctx).Users.Load(ctx, obj.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphUser(author), nil
 }
 
 // GetTimeline is the resolver for the getTimeline field.
-func (r *queryResolver) GetTimeline(ctx context.Context, userID string) ([]*model.Post, error) {
-	posts, err := r.Service.GetTimeline(ctx, userID)
+func (r *queryResolver) GetTimeline(ctx context.Context, userID string, first *int, after *string, mode *model.TimelineMode) (*model.PostConnection, error) {
+	var n int
+	if first != nil {
+		n = *first
+	}
+
+	conn, err := r.Service.GetTimeline(ctx, userID, n, stringValue(after), timelineModeValue(mode))
 	if err != nil {
 		return nil, err
 	}
 
-	result := make([]*model.Post, len(posts))
-	for i, p := range posts {
-		result[i] = &model.Post{
-			ID:        p.ID,
-			UserID:    p.UserID,
-			Content:   p.Content,
-			CreatedAt: p.CreatedAt,
-			ImageUrls: p.ImageURLs,
-		}
+	return toGraphConnection(conn), nil
+}
+
+// Drafts is the resolver for the drafts field.
+func (r *queryResolver) Drafts(ctx context.Context, userID string) ([]*model.Post, error) {
+	posts, err := r.Service.Drafts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphPosts(posts), nil
+}
+
+// Post is the resolver for the post field.
+func (r *queryResolver) Post(ctx context.Context, id string, viewerID *string) (*model.Post, error) {
+	post, err := r.loaders(ctx).Posts.Load(ctx, postKey{ViewerID: stringValue(viewerID), PostID: id})
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphPost(post), nil
+}
+
+// UserPosts is the resolver for the userPosts field.
+func (r *queryResolver) UserPosts(ctx context.Context, userID string, viewerID *string, first *int, after *string) (*model.PostConnection, error) {
+	var n int
+	if first != nil {
+		n = *first
+	}
+
+	conn, err := r.Service.UserPosts(ctx, userID, stringValue(viewerID), n, stringValue(after))
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphConnection(conn), nil
+}
+
+// PendingFollowRequests is the resolver for the pendingFollowRequests field.
+func (r *queryResolver) PendingFollowRequests(ctx context.Context, userID string) ([]*model.User, error) {
+	users, err := r.Service.PendingFollowRequests(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphUsers(users), nil
+}
+
+// BlockedUsers is the resolver for the blockedUsers field.
+func (r *queryResolver) BlockedUsers(ctx context.Context, userID string) ([]*model.User, error) {
+	users, err := r.Service.BlockedUsers(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphUsers(users), nil
+}
+
+// MutedUsers is the resolver for the mutedUsers field.
+func (r *queryResolver) MutedUsers(ctx context.Context, userID string) ([]*model.MutedUser, error) {
+	muted, err := r.Service.MutedUsers(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.MutedUser, len(muted))
+	for i, m := range muted {
+		result[i] = toGraphMutedUser(m)
+	}
+	return result, nil
+}
+
+// MuteFilters is the resolver for the muteFilters field.
+func (r *queryResolver) MuteFilters(ctx context.Context, userID string) ([]*model.MuteFilter, error) {
+	filters, err := r.Service.MuteFilters(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.MuteFilter, len(filters))
+	for i, f := range filters {
+		result[i] = toGraphMuteFilter(f)
+	}
+	return result, nil
+}
+
+// List is the resolver for the list field.
+func (r *queryResolver) List(ctx context.Context, listID string, viewerID *string) (*model.List, error) {
+	list, err := r.Service.GetList(ctx, listID, stringValue(viewerID))
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphList(list), nil
+}
+
+// UserLists is the resolver for the userLists field.
+func (r *queryResolver) UserLists(ctx context.Context, userID string, viewerID *string) ([]*model.List, error) {
+	lists, err := r.Service.UserLists(ctx, userID, stringValue(viewerID))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.List, len(lists))
+	for i, l := range lists {
+		result[i] = toGraphList(l)
+	}
+	return result, nil
+}
+
+// ListTimeline is the resolver for the listTimeline field.
+func (r *queryResolver) ListTimeline(ctx context.Context, listID string, viewerID *string, first *int, after *string) (*model.PostConnection, error) {
+	var n int
+	if first != nil {
+		n = *first
 	}
 
+	conn, err := r.Service.ListTimeline(ctx, listID, stringValue(viewerID), n, stringValue(after))
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphConnection(conn), nil
+}
+
+// SuggestedUsers is the resolver for the suggestedUsers field.
+func (r *queryResolver) SuggestedUsers(ctx context.Context, userID string, first *int) ([]*model.SuggestedUser, error) {
+	var n int
+	if first != nil {
+		n = *first
+	}
+
+	suggestions, err := r.Service.SuggestedUsers(ctx, userID, n)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.SuggestedUser, len(suggestions))
+	for i, sg := range suggestions {
+		result[i] = toGraphSuggestedUser(sg)
+	}
+	return result, nil
+}
+
+// UserByUsername is the resolver for the userByUsername field.
+func (r *queryResolver) UserByUsername(ctx context.Context, username string) (*model.User, error) {
+	user, err := r.Service.UserByUsername(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphUser(user), nil
+}
+
+// Notifications is the resolver for the notifications field.
+func (r *queryResolver) Notifications(ctx context.Context, userID string, first *int, after *string) (*model.NotificationConnection, error) {
+	var n int
+	if first != nil {
+		n = *first
+	}
+
+	conn, err := r.Service.Notifications(ctx, userID, n, stringValue(after))
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphNotificationConnection(conn), nil
+}
+
+// UnreadNotificationCount is the resolver for the unreadNotificationCount field.
+func (r *queryResolver) UnreadNotificationCount(ctx context.Context, userID string) (int, error) {
+	return r.Service.UnreadNotificationCount(ctx, userID)
+}
+
+// Webhooks is the resolver for the webhooks field.
+func (r *queryResolver) Webhooks(ctx context.Context, userID string) ([]*model.Webhook, error) {
+	webhooks, err := r.Service.Webhooks(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.Webhook, len(webhooks))
+	for i, w := range webhooks {
+		result[i] = toGraphWebhook(w)
+	}
+	return result, nil
+}
+
+// WebhookDeliveries is the resolver for the webhookDeliveries field.
+func (r *queryResolver) WebhookDeliveries(ctx context.Context, userID string, webhookID string, first *int) ([]*model.WebhookDelivery, error) {
+	var n int
+	if first != nil {
+		n = *first
+	}
+
+	deliveries, err := r.Service.WebhookDeliveries(ctx, userID, webhookID, n)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.WebhookDelivery, len(deliveries))
+	for i, d := range deliveries {
+		result[i] = toGraphWebhookDelivery(d)
+	}
+	return result, nil
+}
+
+// WebhookDeadLetters is the resolver for the webhookDeadLetters field.
+func (r *queryResolver) WebhookDeadLetters(ctx context.Context, userID string, webhookID string) ([]*model.DeadLetter, error) {
+	deadLetters, err := r.Service.DeadLetters(ctx, userID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.DeadLetter, len(deadLetters))
+	for i, dl := range deadLetters {
+		result[i] = toGraphDeadLetter(dl)
+	}
 	return result, nil
 }
 
+// SearchPosts is the resolver for the searchPosts field.
+func (r *queryResolver) SearchPosts(ctx context.Context, query string, viewerID *string, first *int, after *string) (*model.PostConnection, error) {
+	var n int
+	if first != nil {
+		n = *first
+	}
+
+	conn, err := r.Service.SearchPosts(ctx, query, stringValue(viewerID), n, stringValue(after))
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphConnection(conn), nil
+}
+
+// SearchUsers is the resolver for the searchUsers field.
+func (r *queryResolver) SearchUsers(ctx context.Context, prefix string, viewerID *string, first *int) ([]*model.User, error) {
+	var n int
+	if first != nil {
+		n = *first
+	}
+
+	users, err := r.Service.SearchUsers(ctx, prefix, stringValue(viewerID), n)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphUsers(users), nil
+}
+
+// TimelineUpdates is the resolver for the timelineUpdates field.
+func (r *subscriptionResolver) TimelineUpdates(ctx context.Context, userID string) (<-chan *model.TimelineUpdate, error) {
+	if err := requireConnectionUser(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	updates, err := r.Service.TimelineUpdates(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// gqlgen writes each update to the socket as it is received; the
+	// service queues them, so a slow client only holds up its own queue
+	out := make(chan *model.TimelineUpdate)
+	go func() {
+		defer close(out)
+		for u := range updates {
+			select {
+			case out <- toGraphTimelineUpdate(u):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, nil
+}
+
+// NotificationAdded is the resolver for the notificationAdded field.
+func (r *subscriptionResolver) NotificationAdded(ctx context.Context, userID string) (<-chan *model.Notification, error) {
+	if err := requireConnectionUser(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	notifications, err := r.Service.NotificationsAdded(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan *model.Notification)
+	go func() {
+		defer close(out)
+		for n := range notifications {
+			select {
+			case out <- toGraphNotification(n):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, nil
+}
+
 // Mutation returns generated.MutationResolver implementation.
 func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
 
+// Notification returns generated.NotificationResolver implementation.
+func (r *Resolver) Notification() generated.NotificationResolver { return &notificationResolver{r} }
+
 // Post returns generated.PostResolver implementation.
 func (r *Resolver) Post() generated.PostResolver { return &postResolver{r} }
 
 // Query returns generated.QueryResolver implementation.
 func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 
+// Subscription returns generated.SubscriptionResolver implementation.
+func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
+
 type mutationResolver struct{ *Resolver }
+type notificationResolver struct{ *Resolver }
 type postResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
+
+// !!! WARNING !!!
+// The code below was going to be deleted when updating resolvers. It has been copied here so you have
+// one last chance to move it out of harms way if you want. There are two reasons this happens:
+//  - When renaming or deleting a resolver the old code will be put in here. You can safely delete
+//    it when you're done.
+//  - You have helper methods in this file. Move them out to keep these resolver files clean.
+/*
+	func (r *postResolver) ReplyToID(ctx context.Context, obj *model.Post) (*string, error) {
+	panic(fmt.Errorf("not implemented: ReplyToID - replyToId"))
+}
+func (r *postResolver) LikeCount(ctx context.Context, obj *model.Post) (int, error) {
+	panic(fmt.Errorf("not implemented: LikeCount - likeCount"))
+}
+func (r *postResolver) ReplyCount(ctx context.Context, obj *model.Post) (int, error) {
+	panic(fmt.Errorf("not implemented: ReplyCount - replyCount"))
+}
+func (r *postResolver) RepostCount(ctx context.Context, obj *model.Post) (int, error) {
+	panic(fmt.Errorf("not implemented: RepostCount - repostCount"))
+}
+func (r *postResolver) Liked(ctx context.Context, obj *model.Post) (bool, error) {
+	panic(fmt.Errorf("not implemented: Liked - liked"))
+}
+func (r *postResolver) Reposted(ctx context.Context, obj *model.Post) (bool, error) {
+	panic(fmt.Errorf("not implemented: Reposted - reposted"))
+}
+*/
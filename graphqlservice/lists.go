@@ -0,0 +1,178 @@
+package graphqlservice
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/paper-social/feed-service/proto/user"
+)
+
+// List is a named set of accounts curated by its owner
+type List struct {
+	ID          string  `json:"id"`
+	Owner       *User   `json:"owner"`
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Private     bool    `json:"private"`
+	Members     []*User `json:"members"`
+	CreatedAt   string  `json:"createdAt"`
+}
+
+// listFromProto converts a proto list to our List type
+func listFromProto(l *user.List) *List {
+	list := &List{
+		ID:          l.Id,
+		Name:        l.Name,
+		Description: l.Description,
+		Private:     l.Private,
+		Members:     make([]*User, 0, len(l.Members)),
+		CreatedAt:   time.Unix(l.CreatedAt, 0).Format(time.RFC3339),
+	}
+	if l.Owner != nil {
+		list.Owner = userFromProto(l.Owner)
+	}
+	for _, u := range l.Members {
+		list.Members = append(list.Members, userFromProto(u))
+	}
+	return list
+}
+
+// CreateList creates an empty list owned by userID
+func (s *Service) CreateList(ctx context.Context, userID string, name string, description string, private bool) (*List, error) {
+	// Call the user service to create the list
+	resp, err := s.postClient.CreateList(ctx, &user.CreateListRequest{
+		UserId:      userID,
+		Name:        name,
+		Description: description,
+		Private:     private,
+	})
+	if err != nil {
+		log.Printf("Error creating list: %v", err)
+		return nil, err
+	}
+
+	return listFromProto(resp), nil
+}
+
+// UpdateList replaces the name, description and privacy of a list
+func (s *Service) UpdateList(ctx context.Context, userID string, listID string, name string, description string, private bool) (*List, error) {
+	// Call the user service to update the list
+	resp, err := s.postClient.UpdateList(ctx, &user.UpdateListRequest{
+		UserId:      userID,
+		ListId:      listID,
+		Name:        name,
+		Description: description,
+		Private:     private,
+	})
+	if err != nil {
+		log.Printf("Error updating list: %v", err)
+		return nil, err
+	}
+
+	return listFromProto(resp), nil
+}
+
+// DeleteList deletes one of userID's lists
+func (s *Service) DeleteList(ctx context.Context, userID string, listID string) (*List, error) {
+	// Call the user service to delete the list
+	resp, err := s.postClient.DeleteList(ctx, &user.DeleteListRequest{
+		UserId: userID,
+		ListId: listID,
+	})
+	if err != nil {
+		log.Printf("Error deleting list: %v", err)
+		return nil, err
+	}
+
+	return listFromProto(resp), nil
+}
+
+// AddListMember adds memberID to one of userID's lists
+func (s *Service) AddListMember(ctx context.Context, userID string, listID string, memberID string) (*List, error) {
+	// Call the user service to add the member
+	resp, err := s.postClient.AddListMember(ctx, &user.ListMemberRequest{
+		UserId:   userID,
+		ListId:   listID,
+		MemberId: memberID,
+	})
+	if err != nil {
+		log.Printf("Error adding list member: %v", err)
+		return nil, err
+	}
+
+	return listFromProto(resp), nil
+}
+
+// RemoveListMember removes memberID from one of userID's lists
+func (s *Service) RemoveListMember(ctx context.Context, userID string, listID string, memberID string) (*List, error) {
+	// Call the user service to remove the member
+	resp, err := s.postClient.RemoveListMember(ctx, &user.ListMemberRequest{
+		UserId:   userID,
+		ListId:   listID,
+		MemberId: memberID,
+	})
+	if err != nil {
+		log.Printf("Error removing list member: %v", err)
+		return nil, err
+	}
+
+	return listFromProto(resp), nil
+}
+
+// GetList gets a list as seen by viewerID
+func (s *Service) GetList(ctx context.Context, listID string, viewerID string) (*List, error) {
+	// Call the user service to get the list
+	resp, err := s.postClient.GetList(ctx, &user.GetListRequest{
+		ListId:   listID,
+		ViewerId: viewerID,
+	})
+	if err != nil {
+		log.Printf("Error fetching list %s: %v", listID, err)
+		return nil, err
+	}
+
+	return listFromProto(resp), nil
+}
+
+// UserLists lists the lists userID owns that viewerID can see
+func (s *Service) UserLists(ctx context.Context, userID string, viewerID string) ([]*List, error) {
+	// Call the user service to list the lists
+	resp, err := s.postClient.ListUserLists(ctx, &user.ListUserListsRequest{
+		UserId:   userID,
+		ViewerId: viewerID,
+	})
+	if err != nil {
+		log.Printf("Error listing lists of user %s: %v", userID, err)
+		return nil, err
+	}
+
+	lists := make([]*List, 0, len(resp.Lists))
+	for _, l := range resp.Lists {
+		lists = append(lists, listFromProto(l))
+	}
+
+	return lists, nil
+}
+
+// ListTimeline returns a page of posts by the members of a list, newest
+// first. It goes through the same pipeline as GetTimeline, so the viewer's
+// mutes and mute filters apply.
+func (s *Service) ListTimeline(ctx context.Context, listID string, viewerID string, first int, after string) (*PostConnection, error) {
+	list, err := s.GetList(ctx, listID, viewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	memberIDs := make([]string, 0, len(list.Members))
+	for _, u := range list.Members {
+		memberIDs = append(memberIDs, u.ID)
+	}
+
+	authorIDs, err := s.withoutMuted(ctx, viewerID, memberIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.mergeTimeline(ctx, viewerID, authorIDs, first, after)
+}
@@ -0,0 +1,112 @@
+package graphqlservice
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/paper-social/feed-service/model"
+	"github.com/paper-social/feed-service/proto/user"
+)
+
+// MuteFilter hides posts matching its pattern from a user's timelines
+type MuteFilter struct {
+	ID        string `json:"id"`
+	Kind      string `json:"kind"` // GraphQL enum name, e.g. PHRASE
+	Pattern   string `json:"pattern"`
+	CreatedAt string `json:"createdAt"`
+	ExpiresAt string `json:"expiresAt,omitempty"` // RFC3339, empty if the filter never expires
+}
+
+// muteFilterFromProto converts a proto mute filter to our MuteFilter type
+func muteFilterFromProto(f *user.MuteFilter) *MuteFilter {
+	filter := &MuteFilter{
+		ID:        f.Id,
+		Kind:      strings.TrimPrefix(f.Kind.String(), "MUTE_FILTER_KIND_"),
+		Pattern:   f.Pattern,
+		CreatedAt: time.Unix(f.CreatedAt, 0).Format(time.RFC3339),
+	}
+	if f.ExpiresAt != 0 {
+		filter.ExpiresAt = time.Unix(f.ExpiresAt, 0).Format(time.RFC3339)
+	}
+	return filter
+}
+
+// muteFilterToModel converts a proto mute filter for matching
+func muteFilterToModel(f *user.MuteFilter) model.MuteFilter {
+	filter := model.MuteFilter{
+		ID:        f.Id,
+		Kind:      model.MuteFilterKind(strings.ToLower(strings.TrimPrefix(f.Kind.String(), "MUTE_FILTER_KIND_"))),
+		Pattern:   f.Pattern,
+		CreatedAt: time.Unix(f.CreatedAt, 0),
+	}
+	if f.ExpiresAt != 0 {
+		filter.ExpiresAt = time.Unix(f.ExpiresAt, 0)
+	}
+	return filter
+}
+
+// AddMuteFilter mutes posts matching pattern in userID's timelines. kind
+// is a GraphQL enum name; expiresAt is RFC3339, or empty to never expire.
+func (s *Service) AddMuteFilter(ctx context.Context, userID string, kind string, pattern string, expiresAt string) (*MuteFilter, error) {
+	pbKind, ok := user.MuteFilterKind_value["MUTE_FILTER_KIND_"+kind]
+	if !ok {
+		return nil, fmt.Errorf("unknown mute filter kind %q", kind)
+	}
+
+	req := &user.AddMuteFilterRequest{
+		UserId:  userID,
+		Kind:    user.MuteFilterKind(pbKind),
+		Pattern: pattern,
+	}
+	if expiresAt != "" {
+		t, err := time.Parse(time.RFC3339, expiresAt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid mute filter expiry: %w", err)
+		}
+		req.ExpiresAt = t.Unix()
+	}
+
+	// Call the user service to add the filter
+	resp, err := s.postClient.AddMuteFilter(ctx, req)
+	if err != nil {
+		log.Printf("Error adding mute filter: %v", err)
+		return nil, err
+	}
+
+	return muteFilterFromProto(resp), nil
+}
+
+// RemoveMuteFilter deletes one of userID's mute filters
+func (s *Service) RemoveMuteFilter(ctx context.Context, userID string, filterID string) (*MuteFilter, error) {
+	// Call the user service to remove the filter
+	resp, err := s.postClient.RemoveMuteFilter(ctx, &user.RemoveMuteFilterRequest{
+		UserId:   userID,
+		FilterId: filterID,
+	})
+	if err != nil {
+		log.Printf("Error removing mute filter: %v", err)
+		return nil, err
+	}
+
+	return muteFilterFromProto(resp), nil
+}
+
+// MuteFilters lists userID's active mute filters, oldest first
+func (s *Service) MuteFilters(ctx context.Context, userID string) ([]*MuteFilter, error) {
+	// Call the user service to list the filters
+	resp, err := s.postClient.ListMuteFilters(ctx, &user.ListMuteFiltersRequest{UserId: userID})
+	if err != nil {
+		log.Printf("Error listing mute filters: %v", err)
+		return nil, err
+	}
+
+	filters := make([]*MuteFilter, 0, len(resp.Filters))
+	for _, f := range resp.Filters {
+		filters = append(filters, muteFilterFromProto(f))
+	}
+
+	return filters, nil
+}
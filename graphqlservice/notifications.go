@@ -0,0 +1,163 @@
+package graphqlservice
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/paper-social/feed-service/proto/user"
+	"google.golang.org/grpc"
+)
+
+// notificationBuffer is how many notifications a subscriber may fall
+// behind before further ones are dropped
+const notificationBuffer = 16
+
+// Notification tells a user about activity involving them
+type Notification struct {
+	ID        string   `json:"id"`
+	UserID    string   `json:"userId"`
+	Kind      string   `json:"kind"`     // GraphQL enum name, e.g. FOLLOW
+	ActorIDs  []string `json:"actorIds"` // Most recent first
+	PostID    string   `json:"postId,omitempty"`
+	CreatedAt string   `json:"createdAt"`
+	UpdatedAt string   `json:"updatedAt"`
+	Read      bool     `json:"read"`
+}
+
+// NotificationConnection is one page of a user's notifications
+type NotificationConnection struct {
+	Notifications []*Notification `json:"notifications"`
+	PageInfo      PageInfo        `json:"pageInfo"`
+}
+
+// notificationFromProto converts a proto notification to our Notification
+// type
+func notificationFromProto(n *user.Notification) *Notification {
+	return &Notification{
+		ID:        n.Id,
+		UserID:    n.UserId,
+		Kind:      strings.TrimPrefix(n.Kind.String(), "NOTIFICATION_KIND_"),
+		ActorIDs:  n.ActorIds,
+		PostID:    n.PostId,
+		CreatedAt: time.Unix(n.CreatedAt, 0).Format(time.RFC3339),
+		UpdatedAt: time.Unix(n.UpdatedAt, 0).Format(time.RFC3339),
+		Read:      n.Read,
+	}
+}
+
+// Notifications returns a page of userID's notifications, most recently
+// changed first
+func (s *Service) Notifications(ctx context.Context, userID string, first int, after string) (*NotificationConnection, error) {
+	// Call the user service to list the notifications
+	resp, err := s.postClient.ListNotifications(ctx, &user.ListNotificationsRequest{
+		UserId: userID,
+		First:  int32(first),
+		After:  after,
+	})
+	if err != nil {
+		log.Printf("Error listing notifications: %v", err)
+		return nil, err
+	}
+
+	conn := &NotificationConnection{
+		Notifications: make([]*Notification, 0, len(resp.Notifications)),
+		PageInfo:      PageInfo{EndCursor: resp.EndCursor, HasNextPage: resp.HasNextPage},
+	}
+	for _, n := range resp.Notifications {
+		conn.Notifications = append(conn.Notifications, notificationFromProto(n))
+	}
+	return conn, nil
+}
+
+// UnreadNotificationCount counts userID's unread notifications
+func (s *Service) UnreadNotificationCount(ctx context.Context, userID string) (int, error) {
+	// Call the user service to count the unread notifications
+	resp, err := s.postClient.GetUnreadNotificationCount(ctx, &user.UnreadNotificationCountRequest{UserId: userID})
+	if err != nil {
+		log.Printf("Error counting unread notifications: %v", err)
+		return 0, err
+	}
+
+	return int(resp.Count), nil
+}
+
+// MarkNotificationsRead marks the given notifications of userID read, or
+// all of them if ids is empty, and returns how many are left unread
+func (s *Service) MarkNotificationsRead(ctx context.Context, userID string, ids []string) (int, error) {
+	// Call the user service to mark the notifications read
+	resp, err := s.postClient.MarkNotificationsRead(ctx, &user.MarkNotificationsReadRequest{
+		UserId: userID,
+		Ids:    ids,
+	})
+	if err != nil {
+		log.Printf("Error marking notifications read: %v", err)
+		return 0, err
+	}
+
+	return int(resp.Count), nil
+}
+
+// NotificationsAdded streams userID's notifications as they are created or
+// grouped with new activity, until ctx is cancelled, when the channel is
+// closed. When the user service stream drops it is reopened; notifications
+// in between are missed, but still listed by Notifications.
+func (s *Service) NotificationsAdded(ctx context.Context, userID string) (<-chan *Notification, error) {
+	req := &user.WatchNotificationsRequest{UserId: userID}
+
+	// Call the user service to watch the notifications
+	stream, err := s.postClient.WatchNotifications(ctx, req)
+	if err != nil {
+		log.Printf("Error watching notifications for user %s: %v", userID, err)
+		return nil, err
+	}
+
+	notifications := make(chan *Notification, notificationBuffer)
+	go s.pumpNotifications(ctx, req, stream, notifications)
+	return notifications, nil
+}
+
+// pumpNotifications forwards notifications to a subscriber's queue,
+// reopening the stream when it fails, until ctx is cancelled. A subscriber
+// that is behind misses notifications rather than holding up the stream.
+func (s *Service) pumpNotifications(ctx context.Context, req *user.WatchNotificationsRequest, stream grpc.ServerStreamingClient[user.Notification], notifications chan *Notification) {
+	defer close(notifications)
+
+	for {
+		n, err := stream.Recv()
+		if err == nil {
+			select {
+			case notifications <- notificationFromProto(n):
+			default:
+			}
+			continue
+		}
+		if ctx.Err() != nil {
+			return
+		}
+
+		log.Printf("Notifications for user %s interrupted: %v", req.UserId, err)
+		if stream, err = s.rewatchNotifications(ctx, req); err != nil {
+			return
+		}
+	}
+}
+
+// rewatchNotifications reopens a notification stream, retrying until it
+// succeeds or ctx is cancelled
+func (s *Service) rewatchNotifications(ctx context.Context, req *user.WatchNotificationsRequest) (grpc.ServerStreamingClient[user.Notification], error) {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(timelineRewatchDelay):
+		}
+
+		stream, err := s.postClient.WatchNotifications(ctx, req)
+		if err == nil {
+			return stream, nil
+		}
+		log.Printf("Error watching notifications for user %s: %v", req.UserId, err)
+	}
+}
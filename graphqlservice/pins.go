@@ -0,0 +1,110 @@
+package graphqlservice
+
+import (
+	"context"
+	"log"
+
+	"github.com/paper-social/feed-service/proto/post"
+)
+
+// PostConnection is a page of posts with the cursor to fetch the next one
+type PostConnection struct {
+	Posts    []*Post  `json:"posts"`
+	PageInfo PageInfo `json:"pageInfo"`
+}
+
+// PageInfo describes where a page ends
+type PageInfo struct {
+	EndCursor   string `json:"endCursor,omitempty"`
+	HasNextPage bool   `json:"hasNextPage"`
+}
+
+// connectionFromProto converts a proto page of posts
+func connectionFromProto(page *post.PostPage) *PostConnection {
+	posts := make([]*Post, 0, len(page.Posts))
+	for _, p := range page.Posts {
+		posts = append(posts, postFromProto(p))
+	}
+
+	return &PostConnection{
+		Posts: posts,
+		PageInfo: PageInfo{
+			EndCursor:   page.EndCursor,
+			HasNextPage: page.HasNextPage,
+		},
+	}
+}
+
+// PinPost pins one of a user's posts to their profile
+func (s *Service) PinPost(ctx context.Context, userID string, postID string) (*Post, error) {
+	// Call the post service to pin the post
+	resp, err := s.postClient.PinPost(ctx, &post.PinPostRequest{
+		UserId: userID,
+		PostId: postID,
+	})
+	if err != nil {
+		log.Printf("Error pinning post: %v", err)
+		return nil, err
+	}
+
+	return postFromProto(resp), nil
+}
+
+// UnpinPost removes a post from a user's pinned posts
+func (s *Service) UnpinPost(ctx context.Context, userID string, postID string) (*Post, error) {
+	// Call the post service to unpin the post
+	resp, err := s.postClient.UnpinPost(ctx, &post.UnpinPostRequest{
+		UserId: userID,
+		PostId: postID,
+	})
+	if err != nil {
+		log.Printf("Error unpinning post: %v", err)
+		return nil, err
+	}
+
+	return postFromProto(resp), nil
+}
+
+// UserPosts returns a page of a user's profile as seen by viewerID, pinned
+// posts first. Pass the endCursor of the previous page as after to continue.
+func (s *Service) UserPosts(ctx context.Context, userID string, viewerID string, first int, after string) (*PostConnection, error) {
+	// Call the post service to list the profile
+	resp, err := s.postClient.ListUserPosts(ctx, &post.ListUserPostsRequest{
+		UserId:   userID,
+		ViewerId: viewerID,
+		First:    int32(first),
+		After:    after,
+	})
+	if err != nil {
+		log.Printf("Error listing profile posts: %v", err)
+		return nil, err
+	}
+
+	return connectionFromProto(resp), nil
+}
+
+// PublicPosts returns a page of the posts of userID that anyone can see,
+// newest first and without pinned posts leading. Pass the endCursor of the
+// previous page as after to continue.
+func (s *Service) PublicPosts(ctx context.Context, userID string, first int, after string) (*PostConnection, error) {
+	// Call the post service to list the posts, as seen by no one in
+	// particular
+	resp, err := s.postClient.ListPostsByUser(ctx, &post.ListPostsRequest{
+		UserId: userID,
+		First:  int32(first),
+		After:  after,
+	})
+	if err != nil {
+		log.Printf("Error listing public posts: %v", err)
+		return nil, err
+	}
+
+	conn := &PostConnection{
+		Posts:    make([]*Post, 0, len(resp.Posts)),
+		PageInfo: PageInfo{EndCursor: resp.EndCursor, HasNextPage: resp.HasNextPage},
+	}
+	for _, p := range resp.Posts {
+		conn.Posts = append(conn.Posts, postFromProto(p))
+	}
+	return conn, nil
+}
@@ -0,0 +1,75 @@
+package graphqlservice
+
+import (
+	"context"
+	"log"
+
+	"github.com/paper-social/feed-service/proto/user"
+)
+
+// ProfileInput holds profile fields to set. Nil fields are left alone on
+// update and empty on signup; an empty string clears a field.
+type ProfileInput struct {
+	DisplayName *string
+	Bio         *string
+	AvatarURL   *string
+	Location    *string
+	Website     *string
+}
+
+// CreateUser signs up a new account
+func (s *Service) CreateUser(ctx context.Context, username string, profile ProfileInput) (*User, error) {
+	// Call the user service to create the account
+	resp, err := s.postClient.CreateUser(ctx, &user.CreateUserRequest{
+		Username:    username,
+		DisplayName: stringOrEmpty(profile.DisplayName),
+		Bio:         stringOrEmpty(profile.Bio),
+		AvatarUrl:   stringOrEmpty(profile.AvatarURL),
+		Location:    stringOrEmpty(profile.Location),
+		Website:     stringOrEmpty(profile.Website),
+	})
+	if err != nil {
+		log.Printf("Error creating user: %v", err)
+		return nil, err
+	}
+
+	return userFromProto(resp), nil
+}
+
+// UpdateProfile changes the profile fields of userID that are set
+func (s *Service) UpdateProfile(ctx context.Context, userID string, profile ProfileInput) (*User, error) {
+	// Call the user service to update the profile
+	resp, err := s.postClient.UpdateProfile(ctx, &user.UpdateProfileRequest{
+		UserId:      userID,
+		DisplayName: profile.DisplayName,
+		Bio:         profile.Bio,
+		AvatarUrl:   profile.AvatarURL,
+		Location:    profile.Location,
+		Website:     profile.Website,
+	})
+	if err != nil {
+		log.Printf("Error updating profile: %v", err)
+		return nil, err
+	}
+
+	return userFromProto(resp), nil
+}
+
+// UserByUsername looks up a user by username, ignoring case
+func (s *Service) UserByUsername(ctx context.Context, username string) (*User, error) {
+	// Call the user service to find the user
+	resp, err := s.postClient.GetUserByUsername(ctx, &user.GetUserByUsernameRequest{Username: username})
+	if err != nil {
+		log.Printf("Error fetching user %s: %v", username, err)
+		return nil, err
+	}
+
+	return userFromProto(resp), nil
+}
+
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
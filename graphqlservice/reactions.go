@@ -0,0 +1,68 @@
+package graphqlservice
+
+import (
+	"context"
+	"log"
+
+	"github.com/paper-social/feed-service/proto/post"
+)
+
+// LikePost records a user liking a post
+func (s *Service) LikePost(ctx context.Context, userID string, postID string) (*Post, error) {
+	// Call the post service to like the post
+	resp, err := s.postClient.LikePost(ctx, &post.ReactionRequest{
+		UserId: userID,
+		PostId: postID,
+	})
+	if err != nil {
+		log.Printf("Error liking post: %v", err)
+		return nil, err
+	}
+
+	return postFromProto(resp), nil
+}
+
+// UnlikePost takes back a user's like of a post
+func (s *Service) UnlikePost(ctx context.Context, userID string, postID string) (*Post, error) {
+	// Call the post service to unlike the post
+	resp, err := s.postClient.UnlikePost(ctx, &post.ReactionRequest{
+		UserId: userID,
+		PostId: postID,
+	})
+	if err != nil {
+		log.Printf("Error unliking post: %v", err)
+		return nil, err
+	}
+
+	return postFromProto(resp), nil
+}
+
+// RepostPost records a user reposting a public post
+func (s *Service) RepostPost(ctx context.Context, userID string, postID string) (*Post, error) {
+	// Call the post service to repost the post
+	resp, err := s.postClient.RepostPost(ctx, &post.ReactionRequest{
+		UserId: userID,
+		PostId: postID,
+	})
+	if err != nil {
+		log.Printf("Error reposting post: %v", err)
+		return nil, err
+	}
+
+	return postFromProto(resp), nil
+}
+
+// UndoRepost takes back a user's repost of a post
+func (s *Service) UndoRepost(ctx context.Context, userID string, postID string) (*Post, error) {
+	// Call the post service to undo the repost
+	resp, err := s.postClient.UndoRepost(ctx, &post.ReactionRequest{
+		UserId: userID,
+		PostId: postID,
+	})
+	if err != nil {
+		log.Printf("Error undoing repost: %v", err)
+		return nil, err
+	}
+
+	return postFromProto(resp), nil
+}
@@ -0,0 +1,50 @@
+package graphqlservice
+
+import (
+	"context"
+	"log"
+
+	"github.com/paper-social/feed-service/proto/post"
+	"github.com/paper-social/feed-service/proto/user"
+)
+
+// SearchPosts returns a page of the published posts matching query that
+// viewerID may see, best match first. Pass the endCursor of the previous
+// page as after to continue.
+func (s *Service) SearchPosts(ctx context.Context, query string, viewerID string, first int, after string) (*PostConnection, error) {
+	// Call the post service to run the search
+	resp, err := s.postClient.SearchPosts(ctx, &post.SearchPostsRequest{
+		Query:    query,
+		ViewerId: viewerID,
+		First:    int32(first),
+		After:    after,
+	})
+	if err != nil {
+		log.Printf("Error searching posts: %v", err)
+		return nil, err
+	}
+
+	return connectionFromProto(resp), nil
+}
+
+// SearchUsers returns up to first users whose username or display name
+// starts with prefix, for @-mention autocomplete. Accounts viewerID
+// follows come first.
+func (s *Service) SearchUsers(ctx context.Context, prefix string, viewerID string, first int) ([]*User, error) {
+	// Call the user service to run the search
+	resp, err := s.postClient.SearchUsers(ctx, &user.SearchUsersRequest{
+		Prefix:   prefix,
+		ViewerId: viewerID,
+		First:    int32(first),
+	})
+	if err != nil {
+		log.Printf("Error searching users for %q: %v", prefix, err)
+		return nil, err
+	}
+
+	users := make([]*User, 0, len(resp.Users))
+	for _, u := range resp.Users {
+		users = append(users, userFromProto(u))
+	}
+	return users, nil
+}
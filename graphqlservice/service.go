@@ -2,20 +2,23 @@ package graphqlservice
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"log"
-	"sort"
-	"sync"
+	"strings"
 	"time"
 
-	"github.com/paper-social/feed-service/model"
+	"github.com/paper-social/feed-service/media"
 	"github.com/paper-social/feed-service/postservice"
 	"github.com/paper-social/feed-service/proto/post"
+	"github.com/paper-social/feed-service/proto/user"
 )
 
-// Service represents the GraphQL service
+// Service represents the GraphQL service. Users, follows and posts all
+// live in the post service.
 type Service struct {
-	db         *model.Database
 	postClient *postservice.Client
+	media      *media.Service
 }
 
 // Post represents a post in the GraphQL schema
@@ -24,7 +27,35 @@ type Post struct {
 	UserID    string   `json:"userId"`
 	Content   string   `json:"content"`
 	CreatedAt string   `json:"createdAt"`
-	ImageURLs []string `json:"imageUrls,omitempty"`
+	Entities  []Entity `json:"entities"`
+	Media     []Media  `json:"media"`
+	Poll      *Poll    `json:"poll,omitempty"`
+	Status    string   `json:"status"`              // GraphQL enum name, e.g. DRAFT
+	PublishAt string   `json:"publishAt,omitempty"` // Set for scheduled posts
+	Pinned    bool     `json:"pinned"`
+	ReplyToID string   `json:"replyToId,omitempty"`
+
+	Visibility string `json:"visibility"` // GraphQL enum name, e.g. FOLLOWERS
+
+	// Reactions, and whether the viewer liked or reposted the post
+	LikeCount   int  `json:"likeCount"`
+	ReplyCount  int  `json:"replyCount"`
+	RepostCount int  `json:"repostCount"`
+	Liked       bool `json:"liked"`
+	Reposted    bool `json:"reposted"`
+
+	LinkPreviews []LinkPreview `json:"linkPreviews"`
+}
+
+// Entity represents a structured span of post content
+type Entity struct {
+	Type      string `json:"type"` // GraphQL enum name, e.g. IMAGE_LINK
+	Text      string `json:"text"`
+	Value     string `json:"value"`
+	Start     int    `json:"start"`
+	End       int    `json:"end"`
+	ByteStart int    `json:"byteStart"`
+	ByteEnd   int    `json:"byteEnd"`
 }
 
 // DeleteResponse represents the response to a delete operation
@@ -33,116 +64,298 @@ type DeleteResponse struct {
 	Message string `json:"message,omitempty"`
 }
 
+// Poll represents a poll attached to a post, as seen by one viewer
+type Poll struct {
+	Options        []PollOption `json:"options"`
+	ExpiresAt      string       `json:"expiresAt"`
+	Closed         bool         `json:"closed"`
+	ResultsVisible bool         `json:"resultsVisible"` // Votes are zero when false
+	TotalVotes     int          `json:"totalVotes"`
+	ViewerVote     int          `json:"viewerVote"` // -1 if the viewer hasn't voted
+}
+
+// PollOption represents one choice in a poll
+type PollOption struct {
+	Text  string `json:"text"`
+	Votes int    `json:"votes"`
+}
+
+// PollInput describes a poll to attach to a new post
+type PollInput struct {
+	Options   []string `json:"options"`
+	ExpiresAt string   `json:"expiresAt"` // RFC3339
+}
+
+// LinkPreview represents the unfurled metadata of a linked page
+type LinkPreview struct {
+	URL         string `json:"url"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	ImageURL    string `json:"imageUrl"`
+	SiteName    string `json:"siteName"`
+	AuthorName  string `json:"authorName"`
+	Type        string `json:"type"`
+	FetchedAt   string `json:"fetchedAt"`
+}
+
+// Media represents an uploaded attachment
+type Media struct {
+	ID           string `json:"id"`
+	URL          string `json:"url"`
+	ThumbnailURL string `json:"thumbnailUrl"`
+	ContentType  string `json:"contentType"`
+	Width        int    `json:"width"`
+	Height       int    `json:"height"`
+	AltText      string `json:"altText"`
+}
+
 // NewService creates a new GraphQL service
-func NewService(db *model.Database, postServiceAddr string) *Service {
+func NewService(postServiceAddr string, mediaService *media.Service) *Service {
 	// Create a client to connect to the post service
 	client := postservice.CreateClient(postServiceAddr)
 
 	return &Service{
-		db:         db,
 		postClient: client,
+		media:      mediaService,
 	}
 }
 
-// GetTimeline retrieves timeline posts for a user
-func (s *Service) GetTimeline(ctx context.Context, userID string) ([]*Post, error) {
-	// Get the user
-	user := s.db.GetUserByID(userID)
-	if user == nil {
-		return nil, nil
-	}
-
-	// Prepare to fetch posts for all followed users
-	var wg sync.WaitGroup
-	var mu sync.Mutex
-	allPosts := make([]*Post, 0)
-
-	// For each followed user, fetch their posts
-	for _, followedUserID := range user.Follows {
-		wg.Add(1)
-		go func(followedID string) {
-			defer wg.Done()
-
-			// Call the post service to get posts for this user
-			resp, err := s.postClient.ListPostsByUser(ctx, &post.ListPostsRequest{UserId: followedID})
-			if err != nil {
-				log.Printf("Error fetching posts for user %s: %v", followedID, err)
-				return
-			}
-
-			// Convert proto posts to our Post type
-			posts := make([]*Post, 0, len(resp.Posts))
-			for _, p := range resp.Posts {
-				// Convert Unix timestamp to RFC3339 format
-				t := time.Unix(p.CreatedAt, 0)
-
-				// Extract image URLs from content
-				modelPost := &model.Post{
-					Content: p.Content,
-				}
-
-				posts = append(posts, &Post{
-					ID:        p.Id,
-					UserID:    p.UserId,
-					Content:   p.Content,
-					CreatedAt: t.Format(time.RFC3339),
-					ImageURLs: modelPost.GetImageURLsFromContent(),
-				})
-			}
-
-			// Lock and update the allPosts slice
-			mu.Lock()
-			allPosts = append(allPosts, posts...)
-			mu.Unlock()
-		}(followedUserID)
-	}
-
-	// Wait for all goroutines to complete
-	wg.Wait()
-
-	// Sort posts by creation time (newest first)
-	sort.Slice(allPosts, func(i, j int) bool {
-		timeI, _ := time.Parse(time.RFC3339, allPosts[i].CreatedAt)
-		timeJ, _ := time.Parse(time.RFC3339, allPosts[j].CreatedAt)
-		return timeI.After(timeJ)
-	})
+// postFromProto converts a proto post to our Post type
+func postFromProto(p *post.Post) *Post {
+	// Convert Unix timestamp to RFC3339 format
+	t := time.Unix(p.CreatedAt, 0)
+
+	entities := make([]Entity, 0, len(p.Entities))
+	for _, e := range p.Entities {
+		entities = append(entities, Entity{
+			Type:      strings.TrimPrefix(e.Type.String(), "ENTITY_TYPE_"),
+			Text:      e.Text,
+			Value:     e.Value,
+			Start:     int(e.Start),
+			End:       int(e.End),
+			ByteStart: int(e.ByteStart),
+			ByteEnd:   int(e.ByteEnd),
+		})
+	}
+
+	previews := make([]LinkPreview, 0, len(p.LinkPreviews))
+	for _, lp := range p.LinkPreviews {
+		previews = append(previews, LinkPreview{
+			URL:         lp.Url,
+			Title:       lp.Title,
+			Description: lp.Description,
+			ImageURL:    lp.ImageUrl,
+			SiteName:    lp.SiteName,
+			AuthorName:  lp.AuthorName,
+			Type:        lp.Type,
+			FetchedAt:   time.Unix(lp.FetchedAt, 0).Format(time.RFC3339),
+		})
+	}
 
-	// Return at most 20 posts
-	if len(allPosts) > 20 {
-		return allPosts[:20], nil
+	attachments := make([]Media, 0, len(p.Media))
+	for _, m := range p.Media {
+		attachments = append(attachments, Media{
+			ID:           m.Id,
+			URL:          m.Url,
+			ThumbnailURL: m.ThumbnailUrl,
+			ContentType:  m.ContentType,
+			Width:        int(m.Width),
+			Height:       int(m.Height),
+			AltText:      m.AltText,
+		})
 	}
-	return allPosts, nil
+
+	var poll *Poll
+	if p.Poll != nil {
+		poll = &Poll{
+			ExpiresAt:      time.Unix(p.Poll.ExpiresAt, 0).Format(time.RFC3339),
+			Closed:         p.Poll.Closed,
+			ResultsVisible: p.Poll.ResultsVisible,
+			TotalVotes:     int(p.Poll.TotalVotes),
+			ViewerVote:     int(p.Poll.ViewerVote),
+		}
+		for _, o := range p.Poll.Options {
+			poll.Options = append(poll.Options, PollOption{Text: o.Text, Votes: int(o.Votes)})
+		}
+	}
+
+	var publishAt string
+	if p.PublishAt != 0 {
+		publishAt = time.Unix(p.PublishAt, 0).Format(time.RFC3339)
+	}
+
+	return &Post{
+		ID:           p.Id,
+		UserID:       p.UserId,
+		Content:      p.Content,
+		CreatedAt:    t.Format(time.RFC3339),
+		Entities:     entities,
+		Media:        attachments,
+		Poll:         poll,
+		Status:       strings.TrimPrefix(p.Status.String(), "POST_STATUS_"),
+		PublishAt:    publishAt,
+		Pinned:       p.Pinned,
+		ReplyToID:    p.ReplyToId,
+		Visibility:   strings.TrimPrefix(p.Visibility.String(), "VISIBILITY_"),
+		LikeCount:    int(p.LikeCount),
+		ReplyCount:   int(p.ReplyCount),
+		RepostCount:  int(p.RepostCount),
+		Liked:        p.Liked,
+		Reposted:     p.Reposted,
+		LinkPreviews: previews,
+	}
+}
+
+// Timeline modes, as named by the GraphQL TimelineMode enum
+const (
+	TimelineLatest = "LATEST"
+	TimelineForYou = "FOR_YOU"
+)
+
+// GetTimeline retrieves a page of timeline posts for a user: the posts of
+// the accounts they follow, newest first, starting after the after cursor,
+// minus muted accounts and posts matching their mute filters. In the
+// FOR_YOU mode the posts are ranked instead, and include the accounts
+// those follow; ranked timelines are a single page.
+func (s *Service) GetTimeline(ctx context.Context, userID string, first int, after string, mode string) (*PostConnection, error) {
+	if mode == TimelineForYou {
+		if after != "" {
+			return nil, fmt.Errorf("%s timelines are not paged", TimelineForYou)
+		}
+		posts, err := s.ForYouTimeline(ctx, userID, first)
+		if err != nil {
+			return nil, err
+		}
+		return &PostConnection{Posts: posts}, nil
+	}
+
+	// Get the user and who they follow
+	viewer, err := s.postClient.GetUser(ctx, &user.GetUserRequest{Id: userID})
+	if err != nil {
+		log.Printf("Error fetching user %s: %v", userID, err)
+		return &PostConnection{Posts: []*Post{}}, nil
+	}
+
+	// Muted users stay followed but are left out of the timeline
+	authorIDs, err := s.withoutMuted(ctx, userID, viewer.Follows)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.mergeTimeline(ctx, userID, authorIDs, first, after)
 }
 
-// CreatePost creates a new post
-func (s *Service) CreatePost(ctx context.Context, userID string, content string) (*Post, error) {
+// UploadMedia stores an uploaded file so it can be attached to a post
+func (s *Service) UploadMedia(ctx context.Context, userID string, file io.Reader, altText string) (*Media, error) {
+	if _, err := s.postClient.GetUser(ctx, &user.GetUserRequest{Id: userID}); err != nil {
+		return nil, err
+	}
+
+	m, err := s.media.Upload(userID, file, altText)
+	if err != nil {
+		log.Printf("Error uploading media: %v", err)
+		return nil, err
+	}
+
+	return &Media{
+		ID:           m.ID,
+		URL:          m.URL,
+		ThumbnailURL: m.ThumbnailURL,
+		ContentType:  m.ContentType,
+		Width:        m.Width,
+		Height:       m.Height,
+		AltText:      m.AltText,
+	}, nil
+}
+
+// CreatePost creates a new post, replying to the post replyToID if set. An
+// empty visibility makes it public.
+func (s *Service) CreatePost(ctx context.Context, userID string, content string, mediaIDs []string, poll *PollInput, visibility string, replyToID string) (*Post, error) {
+	req, err := s.newCreatePostRequest(userID, content, mediaIDs, poll, visibility, replyToID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Call the post service to create a post
-	resp, err := s.postClient.CreatePost(ctx, &post.CreatePostRequest{
-		UserId:  userID,
-		Content: content,
-	})
+	resp, err := s.postClient.CreatePost(ctx, req)
 	if err != nil {
 		log.Printf("Error creating post: %v", err)
 		return nil, err
 	}
 
-	// Convert proto post to our Post type
-	t := time.Unix(resp.CreatedAt, 0)
+	return postFromProto(resp), nil
+}
 
-	// Extract image URLs from content
-	modelPost := &model.Post{
-		Content: resp.Content,
+// newCreatePostRequest resolves attachments and the poll of a new post or
+// draft into a post service request
+func (s *Service) newCreatePostRequest(userID string, content string, mediaIDs []string, poll *PollInput, visibility string, replyToID string) (*post.CreatePostRequest, error) {
+	// Resolve attachments, which must have been uploaded by the author
+	attachments, err := s.media.GetOwned(userID, mediaIDs)
+	if err != nil {
+		return nil, err
 	}
+	pbMedia := make([]*post.Media, 0, len(attachments))
+	seen := make(map[string]bool)
+	for _, m := range attachments {
+		if seen[m.ID] {
+			return nil, fmt.Errorf("media %s is attached more than once", m.ID)
+		}
+		seen[m.ID] = true
 
-	return &Post{
-		ID:        resp.Id,
-		UserID:    resp.UserId,
-		Content:   resp.Content,
-		CreatedAt: t.Format(time.RFC3339),
-		ImageURLs: modelPost.GetImageURLsFromContent(),
+		pbMedia = append(pbMedia, &post.Media{
+			Id:           m.ID,
+			Url:          m.URL,
+			ThumbnailUrl: m.ThumbnailURL,
+			ContentType:  m.ContentType,
+			Width:        int32(m.Width),
+			Height:       int32(m.Height),
+			AltText:      m.AltText,
+		})
+	}
+
+	var pbPoll *post.PollInput
+	if poll != nil {
+		expiresAt, err := time.Parse(time.RFC3339, poll.ExpiresAt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid poll expiry: %w", err)
+		}
+		pbPoll = &post.PollInput{Options: poll.Options, ExpiresAt: expiresAt.Unix()}
+	}
+
+	var pbVisibility post.Visibility
+	if visibility != "" {
+		v, ok := post.Visibility_value["VISIBILITY_"+visibility]
+		if !ok {
+			return nil, fmt.Errorf("unknown visibility %q", visibility)
+		}
+		pbVisibility = post.Visibility(v)
+	}
+
+	return &post.CreatePostRequest{
+		UserId:     userID,
+		Content:    content,
+		Media:      pbMedia,
+		Poll:       pbPoll,
+		Visibility: pbVisibility,
+		ReplyToId:  replyToID,
 	}, nil
 }
 
+// GetPost retrieves a single post as seen by viewerID
+func (s *Service) GetPost(ctx context.Context, id string, viewerID string) (*Post, error) {
+	// Call the post service to get the post
+	resp, err := s.postClient.GetPost(ctx, &post.GetPostRequest{
+		Id:       id,
+		ViewerId: viewerID,
+	})
+	if err != nil {
+		log.Printf("Error getting post: %v", err)
+		return nil, err
+	}
+
+	return postFromProto(resp), nil
+}
+
 // UpdatePost updates an existing post
 func (s *Service) UpdatePost(ctx context.Context, id string, content string) (*Post, error) {
 	// Call the post service to update a post
@@ -155,21 +368,23 @@ func (s *Service) UpdatePost(ctx context.Context, id string, content string) (*P
 		return nil, err
 	}
 
-	// Convert proto post to our Post type
-	t := time.Unix(resp.CreatedAt, 0)
+	return postFromProto(resp), nil
+}
 
-	// Extract image URLs from content
-	modelPost := &model.Post{
-		Content: resp.Content,
+// VotePoll records a user's vote in a post's poll
+func (s *Service) VotePoll(ctx context.Context, userID string, postID string, option int) (*Post, error) {
+	// Call the post service to record the vote
+	resp, err := s.postClient.VotePoll(ctx, &post.VotePollRequest{
+		PostId: postID,
+		UserId: userID,
+		Option: int32(option),
+	})
+	if err != nil {
+		log.Printf("Error voting in poll: %v", err)
+		return nil, err
 	}
 
-	return &Post{
-		ID:        resp.Id,
-		UserID:    resp.UserId,
-		Content:   resp.Content,
-		CreatedAt: t.Format(time.RFC3339),
-		ImageURLs: modelPost.GetImageURLsFromContent(),
-	}, nil
+	return postFromProto(resp), nil
 }
 
 // DeletePost deletes a post
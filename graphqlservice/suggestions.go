@@ -0,0 +1,43 @@
+package graphqlservice
+
+import (
+	"context"
+	"log"
+
+	"github.com/paper-social/feed-service/proto/user"
+)
+
+// SuggestedUser is an account suggested to follow, with the reasons it was
+// suggested
+type SuggestedUser struct {
+	User             *User `json:"user"`
+	FriendsFollowing int   `json:"friendsFollowing"`
+	MutualFollowers  int   `json:"mutualFollowers"`
+	RecentPosts      int   `json:"recentPosts"`
+}
+
+// SuggestedUsers returns up to first accounts for userID to follow, best
+// first
+func (s *Service) SuggestedUsers(ctx context.Context, userID string, first int) ([]*SuggestedUser, error) {
+	// Call the user service for suggestions
+	resp, err := s.postClient.SuggestUsers(ctx, &user.SuggestUsersRequest{
+		UserId: userID,
+		First:  int32(first),
+	})
+	if err != nil {
+		log.Printf("Error fetching suggested users for %s: %v", userID, err)
+		return nil, err
+	}
+
+	suggestions := make([]*SuggestedUser, 0, len(resp.Suggestions))
+	for _, sg := range resp.Suggestions {
+		suggestions = append(suggestions, &SuggestedUser{
+			User:             userFromProto(sg.User),
+			FriendsFollowing: int(sg.FriendsFollowing),
+			MutualFollowers:  int(sg.MutualFollowers),
+			RecentPosts:      int(sg.RecentPosts),
+		})
+	}
+
+	return suggestions, nil
+}
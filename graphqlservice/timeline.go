@@ -0,0 +1,211 @@
+package graphqlservice
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/paper-social/feed-service/model"
+	"github.com/paper-social/feed-service/proto/post"
+	"github.com/paper-social/feed-service/proto/user"
+)
+
+// maxTimelineScan bounds how many posts one page may read past posts that
+// were filtered out. A page that hits it comes back short, with a cursor
+// to carry on from.
+const maxTimelineScan = 1000
+
+// authorFeed pages through one author's posts, newest first
+type authorFeed struct {
+	authorID string
+	buffered []*timelinePost
+	after    string // Cursor of the next page to fetch
+	done     bool   // No more pages
+}
+
+// timelinePost is a post with its creation time parsed for merging
+type timelinePost struct {
+	post      *Post
+	createdAt time.Time
+}
+
+// newerThan orders timeline posts the same way the post service pages them
+func (p *timelinePost) newerThan(other *timelinePost) bool {
+	return model.NewerThan(p.createdAt, p.post.ID, other.createdAt, other.post.ID)
+}
+
+// mergeTimeline is the aggregation pipeline behind timelines. It merges the
+// posts of authorIDs newest first as seen by viewerID, starting after the
+// after cursor, and drops posts matching the viewer's mute filters. Authors
+// are fetched a page at a time, concurrently, and the merge keeps fetching
+// further back until first posts have made it through the filters.
+func (s *Service) mergeTimeline(ctx context.Context, viewerID string, authorIDs []string, first int, after string) (*PostConnection, error) {
+	if after != "" {
+		if c, err := model.DecodeCursor(after); err != nil || c.Pinned {
+			return nil, model.ErrInvalidCursor
+		}
+	}
+	first = model.ClampPageSize(first)
+
+	muted, err := s.muteMatcher(ctx, viewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	feeds := make([]*authorFeed, len(authorIDs))
+	for i, id := range authorIDs {
+		feeds[i] = &authorFeed{authorID: id, after: after}
+	}
+
+	conn := &PostConnection{Posts: make([]*Post, 0, first)}
+	var last *timelinePost
+	for scanned := 0; len(conn.Posts) < first && scanned < maxTimelineScan; scanned++ {
+		s.refillFeeds(ctx, viewerID, feeds, first)
+
+		// Take the newest post at the head of any feed
+		var next *authorFeed
+		for _, f := range feeds {
+			if len(f.buffered) > 0 && (next == nil || f.buffered[0].newerThan(next.buffered[0])) {
+				next = f
+			}
+		}
+		if next == nil {
+			break
+		}
+		last = next.buffered[0]
+		next.buffered = next.buffered[1:]
+
+		if !muted.Matches(last.post.Content, hashtags(last.post)) {
+			conn.Posts = append(conn.Posts, last.post)
+		}
+	}
+
+	for _, f := range feeds {
+		if len(f.buffered) > 0 || !f.done {
+			conn.PageInfo.HasNextPage = true
+			break
+		}
+	}
+	if last != nil {
+		conn.PageInfo.EndCursor = model.Cursor{CreatedAt: last.createdAt, PostID: last.post.ID}.Encode()
+	}
+
+	return conn, nil
+}
+
+// refillFeeds fetches the next page of every feed that has run dry,
+// concurrently. Authors that fail to load are skipped.
+func (s *Service) refillFeeds(ctx context.Context, viewerID string, feeds []*authorFeed, pageSize int) {
+	var wg sync.WaitGroup
+	for _, f := range feeds {
+		if len(f.buffered) > 0 || f.done {
+			continue
+		}
+
+		wg.Add(1)
+		go func(f *authorFeed) {
+			defer wg.Done()
+
+			// Call the post service to get the next page for this author
+			resp, err := s.postClient.ListPostsByUser(ctx, &post.ListPostsRequest{
+				UserId:   f.authorID,
+				ViewerId: viewerID,
+				First:    int32(pageSize),
+				After:    f.after,
+			})
+			if err != nil {
+				log.Printf("Error fetching posts for user %s: %v", f.authorID, err)
+				f.done = true
+				return
+			}
+
+			for _, p := range resp.Posts {
+				f.buffered = append(f.buffered, &timelinePost{
+					post:      postFromProto(p),
+					createdAt: time.Unix(p.CreatedAt, 0),
+				})
+			}
+			f.after = resp.EndCursor
+			f.done = !resp.HasNextPage
+		}(f)
+	}
+	wg.Wait()
+}
+
+// ForYouTimeline retrieves a user's ranked timeline: recent posts from the
+// accounts they follow and the accounts those follow, scored on recency,
+// engagement, the user's affinity with the author and media, with the
+// same mutes and mute filters as the chronological timeline
+func (s *Service) ForYouTimeline(ctx context.Context, userID string, first int) ([]*Post, error) {
+	// Call the post service to rank the timeline
+	resp, err := s.postClient.RankTimeline(ctx, &post.RankTimelineRequest{
+		UserId: userID,
+		First:  int32(first),
+	})
+	if err != nil {
+		log.Printf("Error ranking timeline for user %s: %v", userID, err)
+		return nil, err
+	}
+
+	posts := make([]*Post, 0, len(resp.Posts))
+	for _, p := range resp.Posts {
+		posts = append(posts, postFromProto(p))
+	}
+	return posts, nil
+}
+
+// withoutMuted drops the users viewerID has muted from authorIDs
+func (s *Service) withoutMuted(ctx context.Context, viewerID string, authorIDs []string) ([]string, error) {
+	if viewerID == "" {
+		return authorIDs, nil
+	}
+
+	mutes, err := s.postClient.ListMutedUsers(ctx, &user.ListMutedUsersRequest{UserId: viewerID})
+	if err != nil {
+		log.Printf("Error fetching muted users of %s: %v", viewerID, err)
+		return nil, err
+	}
+	muted := make(map[string]bool, len(mutes.Users))
+	for _, m := range mutes.Users {
+		muted[m.User.Id] = true
+	}
+
+	unmuted := make([]string, 0, len(authorIDs))
+	for _, id := range authorIDs {
+		if !muted[id] {
+			unmuted = append(unmuted, id)
+		}
+	}
+	return unmuted, nil
+}
+
+// muteMatcher loads the viewer's keyword mute filters
+func (s *Service) muteMatcher(ctx context.Context, viewerID string) (*model.MuteMatcher, error) {
+	if viewerID == "" {
+		return model.NewMuteMatcher(nil, time.Now()), nil
+	}
+
+	resp, err := s.postClient.ListMuteFilters(ctx, &user.ListMuteFiltersRequest{UserId: viewerID})
+	if err != nil {
+		log.Printf("Error fetching mute filters of %s: %v", viewerID, err)
+		return nil, err
+	}
+
+	filters := make([]model.MuteFilter, 0, len(resp.Filters))
+	for _, f := range resp.Filters {
+		filters = append(filters, muteFilterToModel(f))
+	}
+	return model.NewMuteMatcher(filters, time.Now()), nil
+}
+
+// hashtags returns the hashtag values of a post, without the #
+func hashtags(p *Post) []string {
+	var tags []string
+	for _, e := range p.Entities {
+		if e.Type == "HASHTAG" {
+			tags = append(tags, e.Value)
+		}
+	}
+	return tags
+}
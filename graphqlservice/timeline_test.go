@@ -0,0 +1,117 @@
+package graphqlservice
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/paper-social/feed-service/linkpreview"
+	"github.com/paper-social/feed-service/model"
+	"github.com/paper-social/feed-service/postservice"
+	"github.com/paper-social/feed-service/proto/post"
+	"github.com/paper-social/feed-service/proto/user"
+	"google.golang.org/grpc"
+)
+
+// memDraftStore keeps drafts in memory
+type memDraftStore struct{}
+
+func (memDraftStore) Load() (postservice.SavedDrafts, error)   { return postservice.SavedDrafts{}, nil }
+func (memDraftStore) Save(saved postservice.SavedDrafts) error { return nil }
+
+// newTestService returns a service over a post service on db
+func newTestService(t *testing.T, db *model.Database) *Service {
+	cfg := linkpreview.DefaultConfig()
+	cfg.Workers = 0
+	previews := linkpreview.NewService(cfg)
+	t.Cleanup(previews.Close)
+
+	backend := grpc.NewServer()
+	post.RegisterPostServiceServer(backend, postservice.NewServer(db, previews, memDraftStore{}))
+	user.RegisterUserServiceServer(backend, postservice.NewUserServer(db))
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go backend.Serve(listener)
+	t.Cleanup(backend.Stop)
+
+	s := NewService(listener.Addr().String(), nil)
+	t.Cleanup(func() { s.postClient.Close() })
+	return s
+}
+
+// contents returns the content of each post
+func contents(posts []*Post) []string {
+	found := make([]string, len(posts))
+	for i, p := range posts {
+		found[i] = p.Content
+	}
+	return found
+}
+
+func TestMergeTimelineFillsFilteredPages(t *testing.T) {
+	ctx := context.Background()
+	db := model.NewDatabase()
+	s := newTestService(t, db)
+
+	// Alice mutes spoilers. Bob's newest posts, several author pages'
+	// worth, are all spoilers, so a page has to be filled from further back.
+	if _, err := db.AddMuteFilter("user1", model.MuteWord, "spoiler", time.Time{}); err != nil {
+		t.Fatal(err)
+	}
+	for i := range 12 {
+		if _, err := db.CreatePost("user2", fmt.Sprintf("Spoiler %d", i), model.PostOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The whole timeline in one page, to compare the small pages with
+	all, err := s.mergeTimeline(ctx, "user1", []string{"user2", "user3", "user4"}, 100, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all.Posts) == 0 || all.PageInfo.HasNextPage {
+		t.Fatalf("whole timeline = %d posts, hasNextPage %v", len(all.Posts), all.PageInfo.HasNextPage)
+	}
+
+	var paged []*Post
+	after := ""
+	for {
+		conn, err := s.GetTimeline(ctx, "user1", 3, after, TimelineLatest)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, p := range conn.Posts {
+			if strings.Contains(p.Content, "Spoiler") {
+				t.Errorf("timeline has muted post %q", p.Content)
+			}
+		}
+		paged = append(paged, conn.Posts...)
+
+		if !conn.PageInfo.HasNextPage {
+			break
+		}
+		// Only the last page may come back short
+		if len(conn.Posts) != 3 {
+			t.Fatalf("page after %q has %d posts, want 3", after, len(conn.Posts))
+		}
+		after = conn.PageInfo.EndCursor
+	}
+
+	if got, want := contents(paged), contents(all.Posts); !slices.Equal(got, want) {
+		t.Errorf("paged timeline = %q, want %q", got, want)
+	}
+
+	if _, err := s.GetTimeline(ctx, "user1", 3, "not a cursor", TimelineLatest); err == nil {
+		t.Error("GetTimeline() with an invalid cursor succeeded")
+	}
+	if _, err := s.GetTimeline(ctx, "user1", 3, all.PageInfo.EndCursor, TimelineForYou); err == nil {
+		t.Error("GetTimeline() paged a FOR_YOU timeline")
+	}
+}
@@ -0,0 +1,205 @@
+package graphqlservice
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/paper-social/feed-service/model"
+	"github.com/paper-social/feed-service/postservice"
+	"github.com/paper-social/feed-service/proto/post"
+	"github.com/paper-social/feed-service/proto/user"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// timelineUpdateBuffer is how many updates a subscriber may fall behind
+	// before its queue is replaced by a RESYNC
+	timelineUpdateBuffer = 64
+
+	// timelineRewatchDelay is how long to wait before reopening a post
+	// stream that failed
+	timelineRewatchDelay = time.Second
+)
+
+// TimelineUpdate is a change to a user's timeline pushed to a subscriber
+type TimelineUpdate struct {
+	Type   string `json:"type"` // GraphQL enum name, e.g. CREATED
+	PostID string `json:"postId,omitempty"`
+	Post   *Post  `json:"post,omitempty"` // Unset for deletes and resyncs
+}
+
+// TimelineUpdates streams new, edited and deleted posts from the users
+// userID follows until ctx is cancelled, when the channel is closed. The
+// followed users, mutes and mute filters are read once, when the
+// subscription starts.
+//
+// Updates are queued per subscriber. When the post service stream drops it
+// is resumed after the last event received. A subscriber that falls too
+// far behind, or whose stream can't be resumed, gets a RESYNC telling it
+// to refetch its timeline instead of the updates it missed.
+func (s *Service) TimelineUpdates(ctx context.Context, userID string) (<-chan *TimelineUpdate, error) {
+	// Call the user service to get who the user follows
+	viewer, err := s.postClient.GetUser(ctx, &user.GetUserRequest{Id: userID})
+	if err != nil {
+		log.Printf("Error fetching user %s: %v", userID, err)
+		return nil, err
+	}
+
+	authorIDs, err := s.withoutMuted(ctx, userID, viewer.Follows)
+	if err != nil {
+		return nil, err
+	}
+	muted, err := s.muteMatcher(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Watch the followed users' posts from now on
+	req := &post.WatchPostsRequest{ViewerId: userID, AuthorIds: authorIDs}
+	stream, err := s.watchPosts(ctx, req)
+	if err != nil {
+		log.Printf("Error watching posts for user %s: %v", userID, err)
+		return nil, err
+	}
+
+	updates := make(chan *TimelineUpdate, timelineUpdateBuffer)
+	go s.pumpTimelineUpdates(ctx, req, stream, muted, updates)
+	return updates, nil
+}
+
+// pumpTimelineUpdates forwards post events to a subscriber's queue,
+// resuming the stream when it fails, until ctx is cancelled
+func (s *Service) pumpTimelineUpdates(ctx context.Context, req *post.WatchPostsRequest, stream grpc.ServerStreamingClient[post.PostEvent], muted *model.MuteMatcher, updates chan *TimelineUpdate) {
+	defer close(updates)
+
+	for {
+		event, err := stream.Recv()
+		if err == nil {
+			// Remember where to resume if the stream drops
+			req.LogId, req.AfterSequence = event.LogId, event.Sequence
+
+			if u := timelineUpdateFromEvent(event, muted); u != nil {
+				sendTimelineUpdate(updates, u)
+			}
+			continue
+		}
+		if ctx.Err() != nil {
+			return
+		}
+
+		log.Printf("Timeline updates for user %s interrupted: %v", req.ViewerId, err)
+
+		// The post service no longer has the events after the last one
+		// received, so start afresh and have the subscriber refetch
+		resync := status.Code(err) == codes.OutOfRange
+		if resync {
+			req.LogId, req.AfterSequence = "", 0
+		}
+
+		if stream, err = s.rewatchPosts(ctx, req); err != nil {
+			return
+		}
+		// Sent once the new stream is in place, so a refetch can't miss
+		// posts published in between
+		if resync {
+			sendTimelineUpdate(updates, &TimelineUpdate{Type: "RESYNC"})
+		}
+	}
+}
+
+// watchPosts opens a post stream and waits for the post service to start
+// it, after which no event is missed. The stream's starting position is
+// recorded in req, to resume from if it drops before the first event.
+func (s *Service) watchPosts(ctx context.Context, req *post.WatchPostsRequest) (grpc.ServerStreamingClient[post.PostEvent], error) {
+	// Call the post service to watch the posts
+	stream, err := s.postClient.WatchPosts(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	header, err := stream.Header()
+	if err != nil {
+		return nil, err
+	}
+
+	// Errors starting the stream come without headers and are left for
+	// Recv to report
+	logID, sequence := header.Get(postservice.LogIDHeader), header.Get(postservice.SequenceHeader)
+	if len(logID) == 1 && len(sequence) == 1 {
+		if after, err := strconv.ParseInt(sequence[0], 10, 64); err == nil {
+			req.LogId, req.AfterSequence = logID[0], after
+		}
+	}
+	return stream, nil
+}
+
+// rewatchPosts reopens a post stream, retrying until it succeeds or ctx is
+// cancelled
+func (s *Service) rewatchPosts(ctx context.Context, req *post.WatchPostsRequest) (grpc.ServerStreamingClient[post.PostEvent], error) {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(timelineRewatchDelay):
+		}
+
+		stream, err := s.watchPosts(ctx, req)
+		if err == nil {
+			return stream, nil
+		}
+		log.Printf("Error watching posts for user %s: %v", req.ViewerId, err)
+	}
+}
+
+// timelineUpdateFromEvent converts a post event to a timeline update,
+// applying the subscriber's mute filters. A new post that matches a filter
+// is dropped, and an edit that makes a post match one is sent as a delete
+// so the post leaves the subscriber's timeline.
+func timelineUpdateFromEvent(event *post.PostEvent, muted *model.MuteMatcher) *TimelineUpdate {
+	u := &TimelineUpdate{PostID: event.PostId}
+	if event.Post != nil {
+		u.Post = postFromProto(event.Post)
+	}
+
+	switch event.Type {
+	case post.PostEventType_POST_EVENT_TYPE_CREATED:
+		u.Type = "CREATED"
+	case post.PostEventType_POST_EVENT_TYPE_UPDATED:
+		u.Type = "UPDATED"
+	default:
+		u.Type = "DELETED"
+	}
+
+	if u.Post != nil && muted.Matches(u.Post.Content, hashtags(u.Post)) {
+		if u.Type == "CREATED" {
+			return nil
+		}
+		u.Type = "DELETED"
+		u.Post = nil
+	}
+	return u
+}
+
+// sendTimelineUpdate queues an update without blocking. When the queue is
+// full the subscriber has fallen too far behind, so the queued updates are
+// dropped for a single RESYNC.
+func sendTimelineUpdate(updates chan *TimelineUpdate, u *TimelineUpdate) {
+	select {
+	case updates <- u:
+		return
+	default:
+	}
+
+	for drained := false; !drained; {
+		select {
+		case <-updates:
+		default:
+			drained = true
+		}
+	}
+	// Only the pump sends, so the emptied queue has room
+	updates <- &TimelineUpdate{Type: "RESYNC"}
+}
@@ -0,0 +1,145 @@
+package graphqlservice
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/paper-social/feed-service/proto/user"
+)
+
+// User represents an account in the GraphQL schema
+type User struct {
+	ID             string `json:"id"`
+	Username       string `json:"username"`
+	Protected      bool   `json:"protected"`
+	DisplayName    string `json:"displayName"`
+	Bio            string `json:"bio"`
+	AvatarURL      string `json:"avatarUrl"`
+	Location       string `json:"location"`
+	Website        string `json:"website"`
+	CreatedAt      string `json:"createdAt"` // RFC3339 join date
+	FollowerCount  int    `json:"followerCount"`
+	FollowingCount int    `json:"followingCount"`
+	PostCount      int    `json:"postCount"`
+}
+
+// FollowResult is the outcome of following a user
+type FollowResult struct {
+	Status string `json:"status"` // GraphQL enum name, e.g. REQUESTED
+	User   *User  `json:"user"`
+}
+
+// userFromProto converts a proto user to our User type
+func userFromProto(u *user.User) *User {
+	return &User{
+		ID:             u.Id,
+		Username:       u.Username,
+		Protected:      u.Protected,
+		DisplayName:    u.DisplayName,
+		Bio:            u.Bio,
+		AvatarURL:      u.AvatarUrl,
+		Location:       u.Location,
+		Website:        u.Website,
+		CreatedAt:      time.Unix(u.CreatedAt, 0).Format(time.RFC3339),
+		FollowerCount:  int(u.FollowerCount),
+		FollowingCount: int(u.FollowingCount),
+		PostCount:      int(u.PostCount),
+	}
+}
+
+// FollowUser follows a user, or requests to follow a protected account
+func (s *Service) FollowUser(ctx context.Context, userID string, followeeID string) (*FollowResult, error) {
+	// Call the user service to follow
+	resp, err := s.postClient.FollowUser(ctx, &user.FollowUserRequest{
+		FollowerId: userID,
+		FolloweeId: followeeID,
+	})
+	if err != nil {
+		log.Printf("Error following user: %v", err)
+		return nil, err
+	}
+
+	return &FollowResult{
+		Status: strings.TrimPrefix(resp.Status.String(), "FOLLOW_STATUS_"),
+		User:   userFromProto(resp.Followee),
+	}, nil
+}
+
+// UnfollowUser unfollows a user, withdrawing any pending follow request
+func (s *Service) UnfollowUser(ctx context.Context, userID string, followeeID string) (*User, error) {
+	// Call the user service to unfollow
+	resp, err := s.postClient.UnfollowUser(ctx, &user.FollowUserRequest{
+		FollowerId: userID,
+		FolloweeId: followeeID,
+	})
+	if err != nil {
+		log.Printf("Error unfollowing user: %v", err)
+		return nil, err
+	}
+
+	return userFromProto(resp), nil
+}
+
+// SetAccountProtected marks a user's account protected or public
+func (s *Service) SetAccountProtected(ctx context.Context, userID string, protected bool) (*User, error) {
+	// Call the user service to update the account
+	resp, err := s.postClient.SetProtected(ctx, &user.SetProtectedRequest{
+		UserId:    userID,
+		Protected: protected,
+	})
+	if err != nil {
+		log.Printf("Error updating account: %v", err)
+		return nil, err
+	}
+
+	return userFromProto(resp), nil
+}
+
+// ApproveFollowRequest lets requesterID follow userID
+func (s *Service) ApproveFollowRequest(ctx context.Context, userID string, requesterID string) (*User, error) {
+	// Call the user service to approve the request
+	resp, err := s.postClient.ApproveFollowRequest(ctx, &user.FollowRequestDecision{
+		UserId:      userID,
+		RequesterId: requesterID,
+	})
+	if err != nil {
+		log.Printf("Error approving follow request: %v", err)
+		return nil, err
+	}
+
+	return userFromProto(resp), nil
+}
+
+// RejectFollowRequest declines requesterID's request to follow userID
+func (s *Service) RejectFollowRequest(ctx context.Context, userID string, requesterID string) (*User, error) {
+	// Call the user service to reject the request
+	resp, err := s.postClient.RejectFollowRequest(ctx, &user.FollowRequestDecision{
+		UserId:      userID,
+		RequesterId: requesterID,
+	})
+	if err != nil {
+		log.Printf("Error rejecting follow request: %v", err)
+		return nil, err
+	}
+
+	return userFromProto(resp), nil
+}
+
+// PendingFollowRequests lists the users waiting for userID to approve them
+func (s *Service) PendingFollowRequests(ctx context.Context, userID string) ([]*User, error) {
+	// Call the user service to list the requests
+	resp, err := s.postClient.ListFollowRequests(ctx, &user.ListFollowRequestsRequest{UserId: userID})
+	if err != nil {
+		log.Printf("Error listing follow requests: %v", err)
+		return nil, err
+	}
+
+	users := make([]*User, 0, len(resp.Users))
+	for _, u := range resp.Users {
+		users = append(users, userFromProto(u))
+	}
+
+	return users, nil
+}
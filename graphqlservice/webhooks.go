@@ -0,0 +1,212 @@
+package graphqlservice
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/paper-social/feed-service/proto/user"
+)
+
+// Webhook is an endpoint sent events about its owner's posts and follows
+type Webhook struct {
+	ID                  string   `json:"id"`
+	URL                 string   `json:"url"`
+	Secret              string   `json:"secret,omitempty"` // Only set when the webhook is created
+	Events              []string `json:"events"`           // GraphQL enum names, e.g. POST_CREATED
+	Active              bool     `json:"active"`
+	DisabledReason      string   `json:"disabledReason,omitempty"`
+	ConsecutiveFailures int      `json:"consecutiveFailures"`
+	CreatedAt           string   `json:"createdAt"`
+}
+
+// WebhookDelivery is one attempt to deliver an event to a webhook
+type WebhookDelivery struct {
+	ID          string `json:"id"`
+	EventID     string `json:"eventId"`
+	Event       string `json:"event"`
+	Attempt     int    `json:"attempt"`
+	StatusCode  int    `json:"statusCode,omitempty"` // Zero if no response was received
+	Error       string `json:"error,omitempty"`
+	DurationMs  int    `json:"durationMs"`
+	DeliveredAt string `json:"deliveredAt"`
+}
+
+// DeadLetter is an event a webhook didn't take after all retries
+type DeadLetter struct {
+	ID        string `json:"id"`
+	WebhookID string `json:"webhookId"`
+	EventID   string `json:"eventId"`
+	Event     string `json:"event"`
+	Payload   string `json:"payload"`
+	Attempts  int    `json:"attempts"`
+	LastError string `json:"lastError"`
+	FailedAt  string `json:"failedAt"`
+}
+
+// webhookEventName returns the GraphQL enum name of a proto webhook event
+func webhookEventName(e user.WebhookEvent) string {
+	return strings.TrimPrefix(e.String(), "WEBHOOK_EVENT_")
+}
+
+// webhookFromProto converts a proto webhook to our Webhook type
+func webhookFromProto(w *user.Webhook) *Webhook {
+	webhook := &Webhook{
+		ID:                  w.Id,
+		URL:                 w.Url,
+		Secret:              w.Secret,
+		Events:              make([]string, 0, len(w.Events)),
+		Active:              w.Active,
+		DisabledReason:      w.DisabledReason,
+		ConsecutiveFailures: int(w.ConsecutiveFailures),
+		CreatedAt:           time.Unix(w.CreatedAt, 0).Format(time.RFC3339),
+	}
+	for _, e := range w.Events {
+		webhook.Events = append(webhook.Events, webhookEventName(e))
+	}
+	return webhook
+}
+
+// deadLetterFromProto converts a proto dead letter to our DeadLetter type
+func deadLetterFromProto(dl *user.DeadLetter) *DeadLetter {
+	return &DeadLetter{
+		ID:        dl.Id,
+		WebhookID: dl.WebhookId,
+		EventID:   dl.EventId,
+		Event:     webhookEventName(dl.Event),
+		Payload:   dl.Payload,
+		Attempts:  int(dl.Attempts),
+		LastError: dl.LastError,
+		FailedAt:  time.Unix(dl.FailedAt, 0).Format(time.RFC3339),
+	}
+}
+
+// CreateWebhook registers a webhook for userID. events are GraphQL enum
+// names.
+func (s *Service) CreateWebhook(ctx context.Context, userID string, url string, events []string) (*Webhook, error) {
+	pbEvents := make([]user.WebhookEvent, 0, len(events))
+	for _, e := range events {
+		pbEvents = append(pbEvents, user.WebhookEvent(user.WebhookEvent_value["WEBHOOK_EVENT_"+e]))
+	}
+
+	// Call the user service to create the webhook
+	resp, err := s.postClient.CreateWebhook(ctx, &user.CreateWebhookRequest{
+		UserId: userID,
+		Url:    url,
+		Events: pbEvents,
+	})
+	if err != nil {
+		log.Printf("Error creating webhook: %v", err)
+		return nil, err
+	}
+
+	return webhookFromProto(resp), nil
+}
+
+// DeleteWebhook deletes one of userID's webhooks
+func (s *Service) DeleteWebhook(ctx context.Context, userID string, webhookID string) (*Webhook, error) {
+	// Call the user service to delete the webhook
+	resp, err := s.postClient.DeleteWebhook(ctx, &user.WebhookRequest{UserId: userID, WebhookId: webhookID})
+	if err != nil {
+		log.Printf("Error deleting webhook: %v", err)
+		return nil, err
+	}
+
+	return webhookFromProto(resp), nil
+}
+
+// SetWebhookActive enables or disables one of userID's webhooks
+func (s *Service) SetWebhookActive(ctx context.Context, userID string, webhookID string, active bool) (*Webhook, error) {
+	// Call the user service to update the webhook
+	resp, err := s.postClient.SetWebhookActive(ctx, &user.SetWebhookActiveRequest{
+		UserId:    userID,
+		WebhookId: webhookID,
+		Active:    active,
+	})
+	if err != nil {
+		log.Printf("Error updating webhook: %v", err)
+		return nil, err
+	}
+
+	return webhookFromProto(resp), nil
+}
+
+// Webhooks returns userID's webhooks, oldest first
+func (s *Service) Webhooks(ctx context.Context, userID string) ([]*Webhook, error) {
+	// Call the user service to list the webhooks
+	resp, err := s.postClient.ListWebhooks(ctx, &user.ListWebhooksRequest{UserId: userID})
+	if err != nil {
+		log.Printf("Error listing webhooks: %v", err)
+		return nil, err
+	}
+
+	webhooks := make([]*Webhook, 0, len(resp.Webhooks))
+	for _, w := range resp.Webhooks {
+		webhooks = append(webhooks, webhookFromProto(w))
+	}
+	return webhooks, nil
+}
+
+// WebhookDeliveries returns up to first delivery attempts of one of
+// userID's webhooks, newest first
+func (s *Service) WebhookDeliveries(ctx context.Context, userID string, webhookID string, first int) ([]*WebhookDelivery, error) {
+	// Call the user service to list the deliveries
+	resp, err := s.postClient.ListWebhookDeliveries(ctx, &user.ListWebhookDeliveriesRequest{
+		UserId:    userID,
+		WebhookId: webhookID,
+		First:     int32(first),
+	})
+	if err != nil {
+		log.Printf("Error listing webhook deliveries: %v", err)
+		return nil, err
+	}
+
+	deliveries := make([]*WebhookDelivery, 0, len(resp.Deliveries))
+	for _, d := range resp.Deliveries {
+		deliveries = append(deliveries, &WebhookDelivery{
+			ID:          d.Id,
+			EventID:     d.EventId,
+			Event:       webhookEventName(d.Event),
+			Attempt:     int(d.Attempt),
+			StatusCode:  int(d.StatusCode),
+			Error:       d.Error,
+			DurationMs:  int(d.DurationMs),
+			DeliveredAt: time.Unix(d.DeliveredAt, 0).Format(time.RFC3339),
+		})
+	}
+	return deliveries, nil
+}
+
+// DeadLetters returns the dead letters of one of userID's webhooks, newest
+// first
+func (s *Service) DeadLetters(ctx context.Context, userID string, webhookID string) ([]*DeadLetter, error) {
+	// Call the user service to list the dead letters
+	resp, err := s.postClient.ListDeadLetters(ctx, &user.WebhookRequest{UserId: userID, WebhookId: webhookID})
+	if err != nil {
+		log.Printf("Error listing dead letters: %v", err)
+		return nil, err
+	}
+
+	deadLetters := make([]*DeadLetter, 0, len(resp.DeadLetters))
+	for _, dl := range resp.DeadLetters {
+		deadLetters = append(deadLetters, deadLetterFromProto(dl))
+	}
+	return deadLetters, nil
+}
+
+// RedeliverDeadLetter queues one of userID's dead letters for delivery
+// again
+func (s *Service) RedeliverDeadLetter(ctx context.Context, userID string, deadLetterID string) (*DeadLetter, error) {
+	// Call the user service to redeliver the dead letter
+	resp, err := s.postClient.RedeliverDeadLetter(ctx, &user.RedeliverDeadLetterRequest{
+		UserId:       userID,
+		DeadLetterId: deadLetterID,
+	})
+	if err != nil {
+		log.Printf("Error redelivering dead letter: %v", err)
+		return nil, err
+	}
+
+	return deadLetterFromProto(resp), nil
+}
@@ -0,0 +1,95 @@
+package linkpreview
+
+import (
+	"sync"
+	"time"
+)
+
+// cacheEntry holds a fetched preview, or the error of a failed fetch
+type cacheEntry struct {
+	preview *Preview
+	err     error
+	expires time.Time
+}
+
+// Cache stores previews by URL with a TTL. Failed fetches are cached too
+// (negative caching) so a broken link isn't refetched on every post.
+type Cache struct {
+	mu          sync.Mutex
+	entries     map[string]cacheEntry
+	ttl         time.Duration
+	negativeTTL time.Duration
+	maxEntries  int
+	now         func() time.Time
+}
+
+// NewCache creates a preview cache
+func NewCache(ttl, negativeTTL time.Duration, maxEntries int) *Cache {
+	return &Cache{
+		entries:     make(map[string]cacheEntry),
+		ttl:         ttl,
+		negativeTTL: negativeTTL,
+		maxEntries:  maxEntries,
+		now:         time.Now,
+	}
+}
+
+// Get returns the cached result for a URL. found is false when the URL has
+// no unexpired entry; otherwise either the preview or the cached fetch
+// error is returned.
+func (c *Cache) Get(url string) (preview *Preview, found bool, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[url]
+	if !ok {
+		return nil, false, nil
+	}
+	if c.now().After(entry.expires) {
+		delete(c.entries, url)
+		return nil, false, nil
+	}
+	return entry.preview, true, entry.err
+}
+
+// Set stores a fetched preview
+func (c *Cache) Set(url string, preview *Preview) {
+	c.put(url, cacheEntry{preview: preview, expires: c.now().Add(c.ttl)})
+}
+
+// SetError stores a failed fetch for the negative TTL
+func (c *Cache) SetError(url string, err error) {
+	c.put(url, cacheEntry{err: err, expires: c.now().Add(c.negativeTTL)})
+}
+
+func (c *Cache) put(url string, entry cacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.entries) >= c.maxEntries {
+		c.evict()
+	}
+	c.entries[url] = entry
+}
+
+// evict drops expired entries, then the entries closest to expiry until
+// there is room for one more. Must be called with mu held.
+func (c *Cache) evict() {
+	now := c.now()
+	for url, entry := range c.entries {
+		if now.After(entry.expires) {
+			delete(c.entries, url)
+		}
+	}
+
+	for len(c.entries) >= c.maxEntries {
+		var oldestURL string
+		var oldest time.Time
+		for url, entry := range c.entries {
+			if oldestURL == "" || entry.expires.Before(oldest) {
+				oldestURL, oldest = url, entry.expires
+			}
+		}
+		delete(c.entries, oldestURL)
+	}
+}
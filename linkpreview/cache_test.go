@@ -0,0 +1,72 @@
+package linkpreview
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// newTestCache returns a cache on a clock that only moves when advanced
+func newTestCache(ttl, negativeTTL time.Duration, maxEntries int) (*Cache, func(time.Duration)) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewCache(ttl, negativeTTL, maxEntries)
+	c.now = func() time.Time { return now }
+	return c, func(d time.Duration) { now = now.Add(d) }
+}
+
+func TestCacheTTL(t *testing.T) {
+	c, advance := newTestCache(time.Hour, time.Minute, 10)
+	fetchErr := errors.New("connection refused")
+
+	c.Set("https://ok.example/", &Preview{Title: "OK"})
+	c.SetError("https://broken.example/", fetchErr)
+
+	tests := []struct {
+		after      time.Duration // Since the entries were set
+		url        string
+		wantFound  bool
+		wantErr    error
+		wantExists bool // Whether a preview is returned
+	}{
+		{0, "https://ok.example/", true, nil, true},
+		{0, "https://broken.example/", true, fetchErr, false},
+		{0, "https://unknown.example/", false, nil, false},
+		{time.Minute, "https://broken.example/", true, fetchErr, false},
+		{time.Minute + time.Second, "https://broken.example/", false, nil, false},
+		{time.Minute + time.Second, "https://ok.example/", true, nil, true},
+		{time.Hour, "https://ok.example/", true, nil, true},
+		{time.Hour + time.Second, "https://ok.example/", false, nil, false},
+	}
+
+	var elapsed time.Duration
+	for _, tt := range tests {
+		advance(tt.after - elapsed)
+		elapsed = tt.after
+
+		preview, found, err := c.Get(tt.url)
+		if found != tt.wantFound || err != tt.wantErr || (preview != nil) != tt.wantExists {
+			t.Errorf("after %s, Get(%s) = %v, %v, %v; want found %v, err %v, preview %v",
+				tt.after, tt.url, preview, found, err, tt.wantFound, tt.wantErr, tt.wantExists)
+		}
+	}
+}
+
+func TestCacheEviction(t *testing.T) {
+	c, advance := newTestCache(time.Hour, time.Minute, 3)
+
+	for i := range 3 {
+		c.Set(fmt.Sprintf("https://%d.example/", i), &Preview{})
+		advance(time.Second)
+	}
+	c.Set("https://new.example/", &Preview{})
+
+	if _, found, _ := c.Get("https://0.example/"); found {
+		t.Error("entry closest to expiry was kept")
+	}
+	for _, url := range []string{"https://1.example/", "https://2.example/", "https://new.example/"} {
+		if _, found, _ := c.Get(url); !found {
+			t.Errorf("%s was evicted", url)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package linkpreview
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// pageMetadata holds the raw metadata found in an HTML document head
+type pageMetadata struct {
+	meta     map[string]string // Lowercased property/name -> content, first wins
+	title    string
+	oEmbed   string // Discovered JSON oEmbed endpoint
+	hasTitle bool
+}
+
+// parseHTML scans an HTML document for <meta>, <title> and oEmbed
+// discovery <link> tags, stopping at the start of the body
+func parseHTML(body []byte) *pageMetadata {
+	page := &pageMetadata{meta: make(map[string]string)}
+	z := html.NewTokenizer(bytes.NewReader(body))
+
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			return page
+		case html.EndTagToken:
+			if name, _ := z.TagName(); atom.Lookup(name) == atom.Head {
+				return page
+			}
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, hasAttr := z.TagName()
+			switch atom.Lookup(name) {
+			case atom.Body:
+				return page
+			case atom.Title:
+				if !page.hasTitle && z.Next() == html.TextToken {
+					page.title = strings.TrimSpace(string(z.Text()))
+					page.hasTitle = true
+				}
+			case atom.Meta:
+				attrs := readAttrs(z, hasAttr)
+				key := attrs["property"]
+				if key == "" {
+					key = attrs["name"]
+				}
+				key = strings.ToLower(key)
+				if _, seen := page.meta[key]; key != "" && !seen {
+					page.meta[key] = strings.TrimSpace(attrs["content"])
+				}
+			case atom.Link:
+				attrs := readAttrs(z, hasAttr)
+				if page.oEmbed == "" &&
+					strings.EqualFold(attrs["rel"], "alternate") &&
+					strings.EqualFold(attrs["type"], "application/json+oembed") {
+					page.oEmbed = attrs["href"]
+				}
+			}
+		}
+	}
+}
+
+func readAttrs(z *html.Tokenizer, hasAttr bool) map[string]string {
+	attrs := make(map[string]string)
+	for hasAttr {
+		var key, val []byte
+		key, val, hasAttr = z.TagAttr()
+		attrs[strings.ToLower(string(key))] = string(val)
+	}
+	return attrs
+}
+
+// first returns the first non-empty metadata value among keys
+func (p *pageMetadata) first(keys ...string) string {
+	for _, key := range keys {
+		if v := p.meta[key]; v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// oEmbedResponse is the subset of the oEmbed response format we use
+type oEmbedResponse struct {
+	Type         string `json:"type"`
+	Title        string `json:"title"`
+	AuthorName   string `json:"author_name"`
+	ProviderName string `json:"provider_name"`
+	ThumbnailURL string `json:"thumbnail_url"`
+}
+
+func parseOEmbed(body []byte) (*oEmbedResponse, error) {
+	var resp oEmbedResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// buildPreview combines Open Graph, Twitter Card and plain HTML metadata,
+// in that order of preference
+func buildPreview(pageURL *url.URL, page *pageMetadata) *Preview {
+	preview := &Preview{
+		URL:         pageURL.String(),
+		Title:       page.first("og:title", "twitter:title"),
+		Description: page.first("og:description", "twitter:description", "description"),
+		ImageURL:    resolveURL(pageURL, page.first("og:image:secure_url", "og:image", "og:image:url", "twitter:image", "twitter:image:src")),
+		SiteName:    page.first("og:site_name", "twitter:site"),
+		Type:        page.first("og:type"),
+	}
+	if canonical := resolveURL(pageURL, page.first("og:url")); canonical != "" {
+		preview.URL = canonical
+	}
+	if preview.Title == "" {
+		preview.Title = page.title
+	}
+	if preview.SiteName == "" {
+		preview.SiteName = pageURL.Hostname()
+	}
+	return preview
+}
+
+// mergeOEmbed fills fields the page itself didn't provide
+func mergeOEmbed(preview *Preview, pageURL *url.URL, oembed *oEmbedResponse) {
+	if preview.Title == "" {
+		preview.Title = oembed.Title
+	}
+	if preview.ImageURL == "" {
+		preview.ImageURL = resolveURL(pageURL, oembed.ThumbnailURL)
+	}
+	if oembed.ProviderName != "" && preview.SiteName == pageURL.Hostname() {
+		preview.SiteName = oembed.ProviderName
+	}
+	if preview.Type == "" {
+		preview.Type = oembed.Type
+	}
+	preview.AuthorName = oembed.AuthorName
+}
+
+// resolveURL resolves ref against base, returning "" for anything that
+// isn't an absolute http(s) URL afterwards
+func resolveURL(base *url.URL, ref string) string {
+	if ref == "" {
+		return ""
+	}
+	u, err := base.Parse(ref)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return ""
+	}
+	return u.String()
+}
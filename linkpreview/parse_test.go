@@ -0,0 +1,132 @@
+package linkpreview
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestBuildPreview(t *testing.T) {
+	pageURL, _ := url.Parse("https://example.com/articles/1")
+
+	tests := []struct {
+		name string
+		html string
+		want Preview
+	}{
+		{
+			name: "open graph",
+			html: `<html><head>
+				<title>Page title</title>
+				<meta property="og:title" content="OG title">
+				<meta property="og:description" content="OG description">
+				<meta property="og:image" content="/images/cover.png">
+				<meta property="og:site_name" content="Example">
+				<meta property="og:type" content="article">
+				<meta property="og:url" content="https://example.com/a/1">
+				<meta name="twitter:title" content="Twitter title">
+			</head></html>`,
+			want: Preview{
+				URL:         "https://example.com/a/1",
+				Title:       "OG title",
+				Description: "OG description",
+				ImageURL:    "https://example.com/images/cover.png",
+				SiteName:    "Example",
+				Type:        "article",
+			},
+		},
+		{
+			name: "twitter card",
+			html: `<head>
+				<title>Page title</title>
+				<meta name="twitter:title" content="Twitter title">
+				<meta name="twitter:description" content="Twitter description">
+				<meta name="twitter:image" content="https://cdn.example.com/card.jpg">
+				<meta name="twitter:site" content="@example">
+			</head>`,
+			want: Preview{
+				URL:         "https://example.com/articles/1",
+				Title:       "Twitter title",
+				Description: "Twitter description",
+				ImageURL:    "https://cdn.example.com/card.jpg",
+				SiteName:    "@example",
+			},
+		},
+		{
+			name: "plain html",
+			html: `<head>
+				<title> Page title </title>
+				<meta name="Description" content="Plain description">
+			</head>`,
+			want: Preview{
+				URL:         "https://example.com/articles/1",
+				Title:       "Page title",
+				Description: "Plain description",
+				SiteName:    "example.com",
+			},
+		},
+		{
+			name: "body is not scanned",
+			html: `<head><title>Head</title></head>
+				<body><meta property="og:title" content="Body"></body>`,
+			want: Preview{
+				URL:      "https://example.com/articles/1",
+				Title:    "Head",
+				SiteName: "example.com",
+			},
+		},
+		{
+			name: "first value wins and bad images are dropped",
+			html: `<head>
+				<meta property="og:title" content="First">
+				<meta property="og:title" content="Second">
+				<meta property="og:image" content="javascript:alert(1)">
+			</head>`,
+			want: Preview{
+				URL:      "https://example.com/articles/1",
+				Title:    "First",
+				SiteName: "example.com",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildPreview(pageURL, parseHTML([]byte(tt.html)))
+			if *got != tt.want {
+				t.Errorf("buildPreview() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeOEmbed(t *testing.T) {
+	pageURL, _ := url.Parse("https://video.example.com/watch")
+	page := parseHTML([]byte(`<head>
+		<title>Fallback</title>
+		<link rel="alternate" type="application/json+oembed" href="/oembed?u=1">
+	</head>`))
+	if page.oEmbed != "/oembed?u=1" {
+		t.Fatalf("oEmbed endpoint = %q, want /oembed?u=1", page.oEmbed)
+	}
+
+	preview := buildPreview(pageURL, page)
+	mergeOEmbed(preview, pageURL, &oEmbedResponse{
+		Type:         "video",
+		Title:        "oEmbed title",
+		AuthorName:   "Jane",
+		ProviderName: "VideoSite",
+		ThumbnailURL: "/thumb.jpg",
+	})
+
+	want := Preview{
+		URL:        "https://video.example.com/watch",
+		Title:      "Fallback",
+		ImageURL:   "https://video.example.com/thumb.jpg",
+		SiteName:   "VideoSite",
+		AuthorName: "Jane",
+		Type:       "video",
+	}
+	if *preview != want {
+		t.Errorf("preview = %+v, want %+v", *preview, want)
+	}
+}
@@ -0,0 +1,185 @@
+// Package linkpreview unfurls links in posts into Open Graph style previews.
+// Pages are fetched asynchronously by a small worker pool and results are
+// cached, so reading a post never waits on a remote site.
+package linkpreview
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+)
+
+// Preview is the unfurled metadata of a linked page
+type Preview struct {
+	URL         string    `json:"url"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	ImageURL    string    `json:"imageUrl"`
+	SiteName    string    `json:"siteName"`
+	AuthorName  string    `json:"authorName"`
+	Type        string    `json:"type"`
+	FetchedAt   time.Time `json:"fetchedAt"`
+}
+
+// Config controls fetching, caching and concurrency
+type Config struct {
+	Timeout      time.Duration // Per request, including redirects
+	MaxBodyBytes int64         // Bytes of each response that are read
+	MaxRedirects int
+	UserAgent    string
+
+	CacheTTL         time.Duration // How long successful previews are kept
+	NegativeCacheTTL time.Duration // How long failed fetches are remembered
+	MaxCacheEntries  int
+
+	Workers   int // Concurrent fetches
+	QueueSize int // Pending URLs before new ones are dropped
+
+	// AllowPrivateNetworks disables SSRF protection. Only for local testing.
+	AllowPrivateNetworks bool
+}
+
+// DefaultConfig returns the configuration used by the post service
+func DefaultConfig() Config {
+	return Config{
+		Timeout:          5 * time.Second,
+		MaxBodyBytes:     512 * 1024,
+		MaxRedirects:     5,
+		UserAgent:        "PaperSocialBot/1.0 (+https://paper.social)",
+		CacheTTL:         24 * time.Hour,
+		NegativeCacheTTL: 10 * time.Minute,
+		MaxCacheEntries:  10000,
+		Workers:          4,
+		QueueSize:        256,
+	}
+}
+
+// errNotHTML is cached for links to non-HTML resources
+var errNotHTML = errors.New("not an HTML page")
+
+// Service unfurls links in the background and serves cached previews
+type Service struct {
+	cache   *Cache
+	fetcher *Fetcher
+	timeout time.Duration
+
+	queue   chan string
+	mu      sync.Mutex
+	pending map[string]bool // URLs queued or being fetched
+	closed  bool
+
+	wg        sync.WaitGroup
+	closeOnce sync.Once
+}
+
+// NewService creates a preview service and starts its workers
+func NewService(cfg Config) *Service {
+	s := &Service{
+		cache:   NewCache(cfg.CacheTTL, cfg.NegativeCacheTTL, cfg.MaxCacheEntries),
+		fetcher: NewFetcher(cfg),
+		timeout: cfg.Timeout,
+		queue:   make(chan string, cfg.QueueSize),
+		pending: make(map[string]bool),
+	}
+
+	for i := 0; i < cfg.Workers; i++ {
+		s.wg.Add(1)
+		go s.worker()
+	}
+
+	return s
+}
+
+// Close stops accepting URLs and waits for in-flight fetches to finish
+func (s *Service) Close() {
+	s.closeOnce.Do(func() {
+		s.mu.Lock()
+		close(s.queue)
+		s.closed = true
+		s.mu.Unlock()
+	})
+	s.wg.Wait()
+}
+
+// Enqueue schedules URLs to be unfurled. URLs that are already cached or
+// pending are skipped, and URLs are dropped if the queue is full.
+func (s *Service) Enqueue(urls ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return
+	}
+
+	for _, url := range urls {
+		if s.pending[url] {
+			continue
+		}
+		if _, found, _ := s.cache.Get(url); found {
+			continue
+		}
+
+		select {
+		case s.queue <- url:
+			s.pending[url] = true
+		default:
+			log.Printf("Link preview queue full, dropping %s", url)
+		}
+	}
+}
+
+// Get returns the cached preview for a URL, or nil if it hasn't been
+// fetched yet or the fetch failed
+func (s *Service) Get(url string) *Preview {
+	preview, _, _ := s.cache.Get(url)
+	return preview
+}
+
+func (s *Service) worker() {
+	defer s.wg.Done()
+
+	for url := range s.queue {
+		ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+		preview, err := s.Unfurl(ctx, url)
+		cancel()
+
+		if err != nil {
+			log.Printf("Error unfurling %s: %v", url, err)
+			s.cache.SetError(url, err)
+		} else {
+			s.cache.Set(url, preview)
+		}
+
+		s.mu.Lock()
+		delete(s.pending, url)
+		s.mu.Unlock()
+	}
+}
+
+// Unfurl fetches a page and builds its preview, bypassing the cache
+func (s *Service) Unfurl(ctx context.Context, url string) (*Preview, error) {
+	pageURL, mediaType, body, err := s.fetcher.Fetch(ctx, url, "text/html,application/xhtml+xml")
+	if err != nil {
+		return nil, err
+	}
+	if mediaType != "text/html" && mediaType != "application/xhtml+xml" {
+		return nil, errNotHTML
+	}
+
+	page := parseHTML(body)
+	preview := buildPreview(pageURL, page)
+
+	// oEmbed is best effort, the page metadata alone is a usable preview
+	if endpoint := resolveURL(pageURL, page.oEmbed); endpoint != "" {
+		if _, _, oembedBody, err := s.fetcher.Fetch(ctx, endpoint, "application/json"); err != nil {
+			log.Printf("Error fetching oEmbed for %s: %v", url, err)
+		} else if oembed, err := parseOEmbed(oembedBody); err == nil {
+			mergeOEmbed(preview, pageURL, oembed)
+		}
+	}
+
+	preview.FetchedAt = time.Now()
+	return preview, nil
+}
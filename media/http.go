@@ -0,0 +1,42 @@
+package media
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Handler serves stored media at /{id} and thumbnails at /{id}/thumbnail.
+// Mount it with http.StripPrefix.
+func (s *Service) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		id, variant, _ := strings.Cut(strings.Trim(r.URL.Path, "/"), "/")
+		m, err := s.Get(id)
+		if err != nil || (variant != "" && variant != "thumbnail") {
+			http.NotFound(w, r)
+			return
+		}
+
+		key, contentType := m.blobKey, m.ContentType
+		if variant == "thumbnail" {
+			key, contentType = m.thumbKey, m.thumbType
+		}
+
+		blob, err := s.store.Open(key)
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		defer blob.Close()
+
+		// Uploads never change, so they can be cached indefinitely
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		http.ServeContent(w, r, "", m.CreatedAt, blob)
+	})
+}
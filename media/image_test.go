@@ -0,0 +1,148 @@
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+// testImage returns a w x h image, left half red and right half blue
+func testImage(w, h int) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := range h {
+		for x := range w {
+			c := color.NRGBA{R: 255, A: 255}
+			if x >= w/2 {
+				c = color.NRGBA{B: 255, A: 255}
+			}
+			img.SetNRGBA(x, y, c)
+		}
+	}
+	return img
+}
+
+// encodePNG encodes a test image as PNG
+func encodePNG(t *testing.T, w, h int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, testImage(w, h)); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// encodeJPEG encodes a test image as JPEG
+func encodeJPEG(t *testing.T, w, h int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, testImage(w, h), nil); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// pngHeaderOnly returns a PNG that claims to be w x h but has no pixel
+// data, as a decompression bomb's header would
+func pngHeaderOnly(w, h uint32) []byte {
+	ihdr := make([]byte, 13)
+	binary.BigEndian.PutUint32(ihdr[0:], w)
+	binary.BigEndian.PutUint32(ihdr[4:], h)
+	ihdr[8], ihdr[9] = 8, 2 // 8-bit RGB
+
+	var buf bytes.Buffer
+	buf.WriteString("\x89PNG\r\n\x1a\n")
+	binary.Write(&buf, binary.BigEndian, uint32(len(ihdr)))
+	chunk := append([]byte("IHDR"), ihdr...)
+	buf.Write(chunk)
+	binary.Write(&buf, binary.BigEndian, crc32.ChecksumIEEE(chunk))
+	return buf.Bytes()
+}
+
+func TestProcessImage(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.ThumbnailSize = 32
+
+	var gifBuf bytes.Buffer
+	if err := gif.Encode(&gifBuf, testImage(64, 16), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name          string
+		data          []byte
+		contentType   string
+		width, height int
+		thumbW        int
+		thumbH        int
+		thumbType     string
+		err           error
+	}{
+		{name: "wide PNG", data: encodePNG(t, 128, 64), contentType: "image/png", width: 128, height: 64, thumbW: 32, thumbH: 16, thumbType: "image/png"},
+		{name: "tall JPEG", data: encodeJPEG(t, 40, 100), contentType: "image/jpeg", width: 40, height: 100, thumbW: 12, thumbH: 32, thumbType: "image/jpeg"},
+		{name: "GIF", data: gifBuf.Bytes(), contentType: "image/gif", width: 64, height: 16, thumbW: 32, thumbH: 8, thumbType: "image/png"},
+		{name: "small image kept as is", data: encodePNG(t, 20, 10), contentType: "image/png", width: 20, height: 10, thumbW: 20, thumbH: 10, thumbType: "image/png"},
+		{name: "at the pixel limit", data: encodePNG(t, 200, 200), contentType: "image/png", width: 200, height: 200, thumbW: 32, thumbH: 32, thumbType: "image/png"},
+		{name: "over the pixel limit", data: encodePNG(t, 200, 201), contentType: "image/png", err: ErrImageTooLarge},
+		{name: "decompression bomb header", data: pngHeaderOnly(100_000, 100_000), contentType: "image/png", err: ErrImageTooLarge},
+		{name: "zero width", data: pngHeaderOnly(0, 10), contentType: "image/png", err: ErrInvalidImage},
+		{name: "truncated", data: encodePNG(t, 64, 64)[:100], contentType: "image/png", err: ErrInvalidImage},
+		{name: "unsupported type", data: []byte("BM"), contentType: "image/bmp", err: ErrUnsupportedType},
+	}
+	cfg.MaxPixels = 200 * 200
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := processImage(tt.data, tt.contentType, cfg)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("processImage() error = %v, want %v", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if info.width != tt.width || info.height != tt.height {
+				t.Errorf("dimensions = %dx%d, want %dx%d", info.width, info.height, tt.width, tt.height)
+			}
+			if info.thumbType != tt.thumbType {
+				t.Errorf("thumbnail type = %s, want %s", info.thumbType, tt.thumbType)
+			}
+
+			thumb, format, err := image.DecodeConfig(bytes.NewReader(info.thumbnail))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if "image/"+format != tt.thumbType || thumb.Width != tt.thumbW || thumb.Height != tt.thumbH {
+				t.Errorf("thumbnail = %s %dx%d, want %s %dx%d", format, thumb.Width, thumb.Height, tt.thumbType, tt.thumbW, tt.thumbH)
+			}
+		})
+	}
+}
+
+func TestThumbnailAveragesPixels(t *testing.T) {
+	// Each thumbnail pixel covers a 4x4 block of one colour, except across
+	// the middle, where red and blue blocks meet
+	thumb := thumbnail(testImage(12, 4), 3)
+	if b := thumb.Bounds(); b.Dx() != 3 || b.Dy() != 1 {
+		t.Fatalf("thumbnail is %dx%d, want 3x1", b.Dx(), b.Dy())
+	}
+
+	want := []color.NRGBA{
+		{R: 255, A: 255},
+		{R: 127, B: 127, A: 255},
+		{B: 255, A: 255},
+	}
+	for x, w := range want {
+		got := color.NRGBAModel.Convert(thumb.At(x, 0)).(color.NRGBA)
+		if got != w {
+			t.Errorf("pixel %d = %v, want %v", x, got, w)
+		}
+	}
+}
@@ -0,0 +1,244 @@
+// Package media handles uploaded post attachments: size limits,
+// content-type sniffing, image dimension extraction, thumbnails and blob
+// storage. The bytes and the metadata of each upload live in a BlobStore,
+// so uploads survive restarts; metadata is cached in memory once read.
+package media
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	ErrNotFound        = errors.New("media not found")
+	ErrTooLarge        = errors.New("media exceeds the maximum upload size")
+	ErrUnsupportedType = errors.New("unsupported media type")
+	ErrInvalidImage    = errors.New("invalid image")
+	ErrImageTooLarge   = errors.New("image dimensions are too large")
+	ErrAltTextTooLong  = errors.New("alt text is too long")
+	ErrNotOwner        = errors.New("media belongs to another user")
+)
+
+// Media is an uploaded attachment
+type Media struct {
+	ID           string    `json:"id"`
+	OwnerID      string    `json:"ownerId"`
+	ContentType  string    `json:"contentType"`
+	Size         int64     `json:"size"`
+	Width        int       `json:"width"`
+	Height       int       `json:"height"`
+	AltText      string    `json:"altText"`
+	URL          string    `json:"url"`
+	ThumbnailURL string    `json:"thumbnailUrl"`
+	CreatedAt    time.Time `json:"createdAt"`
+
+	blobKey   string
+	thumbKey  string
+	thumbType string
+}
+
+// storedMedia is the metadata of an upload as saved next to its blobs
+type storedMedia struct {
+	Media
+	BlobKey   string `json:"blobKey"`
+	ThumbKey  string `json:"thumbKey"`
+	ThumbType string `json:"thumbType"`
+}
+
+// metadataKey returns the blob key an upload's metadata is saved under
+func metadataKey(id string) string {
+	return id + ".json"
+}
+
+// Config controls upload limits and the public URLs of stored media
+type Config struct {
+	BaseURL        string // Public URL prefix, e.g. http://localhost:8080/media
+	MaxUploadBytes int64
+	MaxPixels      int // Width x height limit checked before decoding
+	ThumbnailSize  int // Thumbnails fit within this many pixels square
+	MaxAltTextLen  int
+}
+
+// DefaultConfig returns the configuration used by the GraphQL service
+func DefaultConfig() Config {
+	return Config{
+		BaseURL:        "http://localhost:8080/media",
+		MaxUploadBytes: 5 << 20,
+		MaxPixels:      40_000_000,
+		ThumbnailSize:  320,
+		MaxAltTextLen:  1000,
+	}
+}
+
+// Service stores uploads and tracks their metadata
+type Service struct {
+	cfg   Config
+	store BlobStore
+
+	mu    sync.RWMutex
+	items map[string]*Media // Uploads whose metadata has been read
+}
+
+// NewService creates a media service backed by store
+func NewService(store BlobStore, cfg Config) *Service {
+	return &Service{
+		cfg:   cfg,
+		store: store,
+		items: make(map[string]*Media),
+	}
+}
+
+// Upload validates and stores an upload for ownerID
+func (s *Service) Upload(ownerID string, r io.Reader, altText string) (*Media, error) {
+	if len([]rune(altText)) > s.cfg.MaxAltTextLen {
+		return nil, ErrAltTextTooLong
+	}
+
+	// Read one byte past the limit to tell "exactly at" from "over"
+	data, err := io.ReadAll(io.LimitReader(r, s.cfg.MaxUploadBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > s.cfg.MaxUploadBytes {
+		return nil, ErrTooLarge
+	}
+
+	// Trust the bytes, not the client supplied filename or content type
+	contentType := http.DetectContentType(data)
+	if i := strings.IndexByte(contentType, ';'); i >= 0 {
+		contentType = contentType[:i]
+	}
+
+	info, err := processImage(data, contentType, s.cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := newID()
+	if err != nil {
+		return nil, err
+	}
+
+	m := &Media{
+		ID:           id,
+		OwnerID:      ownerID,
+		ContentType:  contentType,
+		Size:         int64(len(data)),
+		Width:        info.width,
+		Height:       info.height,
+		AltText:      strings.TrimSpace(altText),
+		URL:          fmt.Sprintf("%s/%s", s.cfg.BaseURL, id),
+		ThumbnailURL: fmt.Sprintf("%s/%s/thumbnail", s.cfg.BaseURL, id),
+		CreatedAt:    time.Now(),
+		blobKey:      id,
+		thumbKey:     id + "_thumb",
+		thumbType:    info.thumbType,
+	}
+
+	if err := s.store.Put(m.blobKey, bytes.NewReader(data)); err != nil {
+		return nil, err
+	}
+	if err := s.store.Put(m.thumbKey, bytes.NewReader(info.thumbnail)); err != nil {
+		s.store.Delete(m.blobKey)
+		return nil, err
+	}
+
+	// The metadata goes last, so an upload is only found once its blobs
+	// are all there
+	metadata, err := json.Marshal(&storedMedia{Media: *m, BlobKey: m.blobKey, ThumbKey: m.thumbKey, ThumbType: m.thumbType})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.store.Put(metadataKey(id), bytes.NewReader(metadata)); err != nil {
+		s.store.Delete(m.blobKey)
+		s.store.Delete(m.thumbKey)
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.items[id] = m
+	s.mu.Unlock()
+
+	result := *m
+	return &result, nil
+}
+
+// Get returns the metadata of an upload, reading it from the store if it
+// was uploaded before the service started
+func (s *Service) Get(id string) (*Media, error) {
+	s.mu.RLock()
+	m, ok := s.items[id]
+	s.mu.RUnlock()
+	if !ok {
+		var err error
+		if m, err = s.load(id); err != nil {
+			return nil, err
+		}
+	}
+
+	result := *m
+	return &result, nil
+}
+
+// load reads the saved metadata of an upload and caches it
+func (s *Service) load(id string) (*Media, error) {
+	blob, err := s.store.Open(metadataKey(id))
+	if err != nil {
+		// Unknown and malformed IDs alike
+		return nil, ErrNotFound
+	}
+	defer blob.Close()
+
+	var stored storedMedia
+	if err := json.NewDecoder(blob).Decode(&stored); err != nil {
+		return nil, fmt.Errorf("reading metadata of media %s: %w", id, err)
+	}
+	if stored.ID != id {
+		return nil, ErrNotFound
+	}
+	m := &stored.Media
+	m.blobKey, m.thumbKey, m.thumbType = stored.BlobKey, stored.ThumbKey, stored.ThumbType
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if cached, ok := s.items[id]; ok {
+		return cached, nil
+	}
+	s.items[id] = m
+	return m, nil
+}
+
+// GetOwned returns uploads by ID, checking they all belong to ownerID
+func (s *Service) GetOwned(ownerID string, ids []string) ([]*Media, error) {
+	items := make([]*Media, 0, len(ids))
+	for _, id := range ids {
+		m, err := s.Get(id)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", id, err)
+		}
+		if m.OwnerID != ownerID {
+			return nil, fmt.Errorf("%s: %w", id, ErrNotOwner)
+		}
+		items = append(items, m)
+	}
+	return items, nil
+}
+
+// newID returns a random media ID. IDs are random rather than sequential
+// so they never collide with uploads saved before a restart.
+func newID() (string, error) {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "media_" + hex.EncodeToString(b), nil
+}
@@ -0,0 +1,172 @@
+package media
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestService(t *testing.T, dir string, cfg Config) *Service {
+	t.Helper()
+	store, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewService(store, cfg)
+}
+
+func TestUpload(t *testing.T) {
+	png := encodePNG(t, 64, 32)
+	cfg := DefaultConfig()
+	cfg.MaxUploadBytes = int64(len(png))
+	cfg.MaxAltTextLen = 5
+
+	tests := []struct {
+		name        string
+		data        []byte
+		altText     string
+		contentType string
+		err         error
+	}{
+		{name: "PNG exactly at the limit", data: png, altText: " cat ", contentType: "image/png"},
+		{name: "one byte over the limit", data: append(bytes.Clone(png), 0), err: ErrTooLarge},
+		{name: "text", data: []byte("just some text"), err: ErrUnsupportedType},
+		{name: "HTML", data: []byte("<html><script>alert(1)</script></html>"), err: ErrUnsupportedType},
+		{name: "unsupported image format", data: []byte("BM\x00\x00\x00\x00\x00\x00\x00\x00"), err: ErrUnsupportedType},
+		{name: "sniffed as GIF but corrupt", data: []byte("GIF89a\x01\x00\x01\x00\x00\x00\x00"), err: ErrInvalidImage},
+		{name: "empty", data: nil, err: ErrUnsupportedType},
+		{name: "alt text too long", data: png, altText: "a cat!", err: ErrAltTextTooLong},
+		{name: "alt text limit counts characters", data: png, altText: "ねこねこね", contentType: "image/png"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestService(t, t.TempDir(), cfg)
+			m, err := s.Upload("user1", bytes.NewReader(tt.data), tt.altText)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("Upload() error = %v, want %v", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if m.ContentType != tt.contentType || m.Size != int64(len(tt.data)) || m.AltText != strings.TrimSpace(tt.altText) {
+				t.Errorf("Upload() = %+v, want a %d byte %s", m, len(tt.data), tt.contentType)
+			}
+			if m.URL != cfg.BaseURL+"/"+m.ID || m.ThumbnailURL != cfg.BaseURL+"/"+m.ID+"/thumbnail" {
+				t.Errorf("URLs = %s and %s", m.URL, m.ThumbnailURL)
+			}
+		})
+	}
+}
+
+func TestGetOwned(t *testing.T) {
+	s := newTestService(t, t.TempDir(), DefaultConfig())
+	mine, err := s.Upload("user1", bytes.NewReader(encodePNG(t, 8, 8)), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	theirs, err := s.Upload("user2", bytes.NewReader(encodePNG(t, 8, 8)), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if items, err := s.GetOwned("user1", []string{mine.ID}); err != nil || len(items) != 1 || items[0].ID != mine.ID {
+		t.Errorf("GetOwned(own upload) = %v, %v", items, err)
+	}
+	if _, err := s.GetOwned("user1", []string{mine.ID, theirs.ID}); !errors.Is(err, ErrNotOwner) {
+		t.Errorf("GetOwned(another user's upload) error = %v, want %v", err, ErrNotOwner)
+	}
+	if _, err := s.GetOwned("user1", []string{"missing"}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetOwned(unknown upload) error = %v, want %v", err, ErrNotFound)
+	}
+}
+
+// get fetches a path from a media handler
+func get(t *testing.T, h http.Handler, path string) *http.Response {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	return rec.Result()
+}
+
+func TestUploadsSurviveRestart(t *testing.T) {
+	dir := t.TempDir()
+	cfg := DefaultConfig()
+	cfg.ThumbnailSize = 16
+	data := encodeJPEG(t, 64, 32)
+
+	uploaded, err := newTestService(t, dir, cfg).Upload("user1", bytes.NewReader(data), "A red and blue flag")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A new service over the same directory knows the upload
+	s := newTestService(t, dir, cfg)
+	m, err := s.Get(uploaded.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Times come back from JSON without a monotonic reading, so compare
+	// CreatedAt on its own
+	got, want := *m, *uploaded
+	if !got.CreatedAt.Equal(want.CreatedAt) {
+		t.Errorf("CreatedAt = %v, want %v", got.CreatedAt, want.CreatedAt)
+	}
+	got.CreatedAt, want.CreatedAt = time.Time{}, time.Time{}
+	if got != want {
+		t.Errorf("Get() = %+v, want %+v", got, want)
+	}
+
+	h := s.Handler()
+	resp := get(t, h, "/"+m.ID)
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK || !bytes.Equal(body, data) {
+		t.Fatalf("GET /%s = %d with %d bytes, want the %d uploaded bytes", m.ID, resp.StatusCode, len(body), len(data))
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "image/jpeg" {
+		t.Errorf("Content-Type = %s, want image/jpeg", ct)
+	}
+	if resp.Header.Get("X-Content-Type-Options") != "nosniff" {
+		t.Error("upload is served without X-Content-Type-Options: nosniff")
+	}
+
+	resp = get(t, h, "/"+m.ID+"/thumbnail")
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "image/jpeg" {
+		t.Fatalf("GET thumbnail = %d %s, want a JPEG", resp.StatusCode, resp.Header.Get("Content-Type"))
+	}
+}
+
+func TestHandlerNotFound(t *testing.T) {
+	s := newTestService(t, t.TempDir(), DefaultConfig())
+	m, err := s.Upload("user1", bytes.NewReader(encodePNG(t, 8, 8)), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	h := s.Handler()
+	for _, path := range []string{
+		"/",
+		"/missing",
+		"/" + m.ID + "_thumb",
+		"/" + m.ID + ".json",
+		"/" + m.ID + "/original",
+		"/..%2F" + m.ID,
+	} {
+		if resp := get(t, h, path); resp.StatusCode != http.StatusNotFound {
+			t.Errorf("GET %s = %d, want 404", path, resp.StatusCode)
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/"+m.ID, nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("POST = %d, want 405", rec.Code)
+	}
+}
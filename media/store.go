@@ -0,0 +1,86 @@
+package media
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+)
+
+// BlobStore persists the raw bytes of uploaded media
+type BlobStore interface {
+	// Put writes a blob under key, replacing any existing blob
+	Put(key string, r io.Reader) error
+	// Open returns a reader for the blob stored under key
+	Open(key string) (io.ReadSeekCloser, error)
+	// Delete removes the blob stored under key
+	Delete(key string) error
+}
+
+// validKey restricts blob keys to a flat namespace so they can't escape the
+// storage directory
+var validKey = regexp.MustCompile(`^[A-Za-z0-9_-]+(\.[A-Za-z0-9]+)?$`)
+
+// FileStore stores blobs as files in a local directory
+type FileStore struct {
+	dir string
+}
+
+// NewFileStore creates a file store rooted at dir, creating it if needed
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &FileStore{dir: dir}, nil
+}
+
+func (fs *FileStore) path(key string) (string, error) {
+	if !validKey.MatchString(key) {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+	return filepath.Join(fs.dir, key), nil
+}
+
+// Put writes the blob to a temporary file and renames it into place, so
+// readers never see a partially written blob
+func (fs *FileStore) Put(key string, r io.Reader) error {
+	path, err := fs.path(key)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(fs.dir, ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+// Open opens the blob file for reading
+func (fs *FileStore) Open(key string) (io.ReadSeekCloser, error) {
+	path, err := fs.path(key)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(path)
+}
+
+// Delete removes the blob file
+func (fs *FileStore) Delete(key string) error {
+	path, err := fs.path(key)
+	if err != nil {
+		return err
+	}
+	return os.Remove(path)
+}
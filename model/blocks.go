@@ -0,0 +1,180 @@
+package model
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+var (
+	ErrBlockSelf   = errors.New("users cannot block themselves")
+	ErrMuteSelf    = errors.New("users cannot mute themselves")
+	ErrBlocked     = errors.New("one of these users has blocked the other")
+	ErrMuteExpired = errors.New("mute expiry must be in the future")
+)
+
+// MutedUser is a muted account and when the mute ends. A zero ExpiresAt
+// mutes indefinitely.
+type MutedUser struct {
+	User      *User
+	ExpiresAt time.Time
+}
+
+// BlockUser blocks targetID for userID. Follows, follow requests and list
+// memberships between the two are removed in both directions, and neither
+// sees the other's posts until the block is lifted.
+func (db *Database) BlockUser(userID, targetID string) (*User, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	user, target, err := db.userPair(userID, targetID)
+	if err != nil {
+		return nil, err
+	}
+	if userID == targetID {
+		return nil, ErrBlockSelf
+	}
+
+	if !containsID(db.Blocks[userID], targetID) {
+		db.Blocks[userID] = append(db.Blocks[userID], targetID)
+		db.record(Event{Type: EventUserBlocked, ActorID: userID, SubjectID: targetID})
+	}
+
+	db.removeFollow(user, targetID)
+	target = db.removeFollow(target, userID)
+	db.removeFollowRequest(userID, targetID)
+	db.removeFollowRequest(targetID, userID)
+	db.removeFromLists(userID, targetID)
+	db.removeFromLists(targetID, userID)
+
+	return target, nil
+}
+
+// UnblockUser lifts userID's block of targetID. Follows removed by the
+// block are not restored.
+func (db *Database) UnblockUser(userID, targetID string) (*User, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	_, target, err := db.userPair(userID, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !containsID(db.Blocks[userID], targetID) {
+		return target, nil
+	}
+	if blocks := removeID(db.Blocks[userID], targetID); len(blocks) > 0 {
+		db.Blocks[userID] = blocks
+	} else {
+		delete(db.Blocks, userID)
+	}
+	db.record(Event{Type: EventUserUnblocked, ActorID: userID, SubjectID: targetID})
+	return target, nil
+}
+
+// MuteUser hides targetID's posts from userID's timeline until expiresAt,
+// or indefinitely if expiresAt is zero. Muting again replaces the expiry.
+func (db *Database) MuteUser(userID, targetID string, expiresAt time.Time) (*MutedUser, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	_, target, err := db.userPair(userID, targetID)
+	if err != nil {
+		return nil, err
+	}
+	if userID == targetID {
+		return nil, ErrMuteSelf
+	}
+	if !expiresAt.IsZero() && !expiresAt.After(time.Now()) {
+		return nil, ErrMuteExpired
+	}
+
+	if db.Mutes[userID] == nil {
+		db.Mutes[userID] = make(map[string]time.Time)
+	}
+	db.Mutes[userID][targetID] = expiresAt
+
+	return &MutedUser{User: target, ExpiresAt: expiresAt}, nil
+}
+
+// UnmuteUser lifts userID's mute of targetID
+func (db *Database) UnmuteUser(userID, targetID string) (*User, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	_, target, err := db.userPair(userID, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(db.Mutes[userID], targetID)
+	if len(db.Mutes[userID]) == 0 {
+		delete(db.Mutes, userID)
+	}
+	return target, nil
+}
+
+// GetBlockedUsers returns the users userID has blocked, oldest block first
+func (db *Database) GetBlockedUsers(userID string) []*User {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	users := make([]*User, 0, len(db.Blocks[userID]))
+	for _, id := range db.Blocks[userID] {
+		if u, exists := db.Users[id]; exists {
+			users = append(users, u)
+		}
+	}
+	return users
+}
+
+// GetMutedUsers returns the users userID has muted as of now, ordered by
+// username. Expired mutes are left out.
+func (db *Database) GetMutedUsers(userID string, now time.Time) []MutedUser {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	var muted []MutedUser
+	for id, expiresAt := range db.Mutes[userID] {
+		u, exists := db.Users[id]
+		if !exists || !muteActive(expiresAt, now) {
+			continue
+		}
+		muted = append(muted, MutedUser{User: u, ExpiresAt: expiresAt})
+	}
+	sortMutedUsers(muted)
+	return muted
+}
+
+// IsMuted reports whether userID has an active mute on targetID
+func (db *Database) IsMuted(userID, targetID string, now time.Time) bool {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	expiresAt, exists := db.Mutes[userID][targetID]
+	return exists && muteActive(expiresAt, now)
+}
+
+// IsBlocked reports whether either user has blocked the other
+func (db *Database) IsBlocked(userID, otherID string) bool {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	return db.blockedEitherWay(userID, otherID)
+}
+
+// blockedEitherWay must be called with mu held
+func (db *Database) blockedEitherWay(userID, otherID string) bool {
+	return containsID(db.Blocks[userID], otherID) || containsID(db.Blocks[otherID], userID)
+}
+
+func muteActive(expiresAt, now time.Time) bool {
+	return expiresAt.IsZero() || now.Before(expiresAt)
+}
+
+func sortMutedUsers(muted []MutedUser) {
+	sort.Slice(muted, func(i, j int) bool {
+		return muted[i].User.Username < muted[j].User.Username
+	})
+}
@@ -0,0 +1,218 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PostStatus is the publication state of a post
+type PostStatus string
+
+const (
+	PostPublished PostStatus = "published"
+	PostDraft     PostStatus = "draft"     // Saved, only visible to its author
+	PostScheduled PostStatus = "scheduled" // Published automatically at PublishAt
+)
+
+var (
+	ErrNotUnpublished = errors.New("post is already published")
+	ErrNotScheduled   = errors.New("post is not scheduled")
+	ErrPublishAtPast  = errors.New("publish time must be in the future")
+)
+
+// GetUnpublishedPosts returns a user's drafts and scheduled posts, most
+// recently created first
+func (db *Database) GetUnpublishedPosts(userID string) []*Post {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	var posts []*Post
+	for _, p := range db.Unpublished {
+		if p.UserID == userID {
+			posts = append(posts, p)
+		}
+	}
+	sortNewestFirst(posts)
+	return posts
+}
+
+// AllUnpublishedPosts returns every draft and scheduled post, along with
+// the number the next post ID will be made from. Both are read together so
+// a saved copy never lets an ID be issued twice.
+func (db *Database) AllUnpublishedPosts() ([]*Post, int) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	posts := make([]*Post, 0, len(db.Unpublished))
+	for _, p := range db.Unpublished {
+		posts = append(posts, p)
+	}
+	sortNewestFirst(posts)
+	return posts, db.NextPostID
+}
+
+// NextPostNumber returns the number the next post ID will be made from
+func (db *Database) NextPostNumber() int {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	return db.NextPostID
+}
+
+// RestoreUnpublishedPosts loads drafts and scheduled posts saved by a
+// previous run, keeping their IDs. Posts by unknown users are skipped.
+// New post IDs continue from nextPostID, as saved with them, so posts
+// published before the restart keep IDs of their own.
+func (db *Database) RestoreUnpublishedPosts(posts []*Post, nextPostID int) int {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	db.NextPostID = max(db.NextPostID, nextPostID)
+
+	restored := 0
+	for _, p := range posts {
+		if _, exists := db.Users[p.UserID]; !exists {
+			continue
+		}
+		if p.Status != PostDraft && p.Status != PostScheduled {
+			continue
+		}
+		db.Unpublished[p.ID] = p
+		restored++
+
+		// Keep new IDs from colliding with restored ones
+		if n, err := strconv.Atoi(strings.TrimPrefix(p.ID, "post")); err == nil && n >= db.NextPostID {
+			db.NextPostID = n + 1
+		}
+	}
+	return restored
+}
+
+// SchedulePost sets a draft or scheduled post to publish at publishAt
+func (db *Database) SchedulePost(postID string, publishAt time.Time) (*Post, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	post, err := db.getUnpublished(postID)
+	if err != nil {
+		return nil, err
+	}
+	if !publishAt.After(time.Now()) {
+		return nil, ErrPublishAtPast
+	}
+	if post.Poll != nil {
+		// Polls run from the moment the post is published
+		if err := post.Poll.validate(publishAt); err != nil {
+			return nil, err
+		}
+	}
+
+	scheduled := *post
+	scheduled.Status = PostScheduled
+	scheduled.PublishAt = publishAt
+	db.Unpublished[postID] = &scheduled
+
+	return &scheduled, nil
+}
+
+// CancelScheduledPost turns a scheduled post back into a draft
+func (db *Database) CancelScheduledPost(postID string) (*Post, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	post, err := db.getUnpublished(postID)
+	if err != nil {
+		return nil, err
+	}
+	if post.Status != PostScheduled {
+		return nil, ErrNotScheduled
+	}
+
+	draft := *post
+	draft.Status = PostDraft
+	draft.PublishAt = time.Time{}
+	db.Unpublished[postID] = &draft
+
+	return &draft, nil
+}
+
+// PublishPost publishes a draft or scheduled post immediately
+func (db *Database) PublishPost(postID string) (*Post, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	post, err := db.getUnpublished(postID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if post.Poll != nil {
+		if err := post.Poll.validate(now); err != nil {
+			return nil, err
+		}
+	}
+
+	return db.publish(post, now), nil
+}
+
+// PublishDuePosts publishes every scheduled post whose time has come. The
+// status check and the move happen under one lock, so each post is
+// published exactly once however many callers race.
+func (db *Database) PublishDuePosts(now time.Time) []*Post {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	var due []*Post
+	for _, p := range db.Unpublished {
+		if p.Status == PostScheduled && !p.PublishAt.After(now) {
+			due = append(due, p)
+		}
+	}
+
+	published := make([]*Post, 0, len(due))
+	for _, p := range due {
+		published = append(published, db.publish(p, now))
+	}
+	return published
+}
+
+// getUnpublished finds a draft or scheduled post. Must be called with mu
+// held.
+func (db *Database) getUnpublished(postID string) (*Post, error) {
+	if post, exists := db.Unpublished[postID]; exists {
+		return post, nil
+	}
+	if _, exists := db.PostsByID[postID]; exists {
+		return nil, ErrNotUnpublished
+	}
+	return nil, fmt.Errorf("post with ID %s not found", postID)
+}
+
+// publish moves an unpublished post into the published indexes, dated now.
+// Must be called with mu held for writing.
+func (db *Database) publish(post *Post, now time.Time) *Post {
+	published := *post
+	published.Status = PostPublished
+	published.CreatedAt = now
+	published.PublishAt = time.Time{}
+
+	delete(db.Unpublished, post.ID)
+	db.Posts[post.UserID] = append(db.Posts[post.UserID], &published)
+	db.PostsByID[post.ID] = &published
+	db.record(Event{Type: EventPostCreated, ActorID: post.UserID, PostID: post.ID, Post: &published, OccurredAt: now})
+	db.addReply(&published)
+
+	return &published
+}
+
+// sortNewestFirst orders posts newest first, the order cursors page through
+func sortNewestFirst(posts []*Post) {
+	sort.Slice(posts, func(i, j int) bool {
+		return NewerThan(posts[i].CreatedAt, posts[i].ID, posts[j].CreatedAt, posts[j].ID)
+	})
+}
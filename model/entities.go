@@ -0,0 +1,247 @@
+package model
+
+import (
+	"net/url"
+	"path"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// EntityType identifies the kind of structured entity found in post content
+type EntityType string
+
+const (
+	EntityLink      EntityType = "link"
+	EntityImageLink EntityType = "image_link"
+	EntityMention   EntityType = "mention"
+	EntityHashtag   EntityType = "hashtag"
+	EntityCashtag   EntityType = "cashtag"
+)
+
+// Entity is a typed span of post content. Start/End are UTF-16 code unit
+// offsets (what JavaScript clients index by), ByteStart/ByteEnd index the
+// UTF-8 content string directly.
+type Entity struct {
+	Type      EntityType `json:"type"`
+	Text      string     `json:"text"`  // Raw text as it appears in the content
+	Value     string     `json:"value"` // Normalized value: URL, username, tag or symbol
+	Start     int        `json:"start"`
+	End       int        `json:"end"`
+	ByteStart int        `json:"byteStart"`
+	ByteEnd   int        `json:"byteEnd"`
+}
+
+// imageExtensions lists the URL path extensions treated as images
+var imageExtensions = map[string]bool{
+	".jpg":  true,
+	".jpeg": true,
+	".png":  true,
+	".gif":  true,
+	".webp": true,
+}
+
+const (
+	maxMentionLength = 30
+	maxCashtagLength = 6
+)
+
+// ParseEntities tokenizes post content into links, image links, mentions,
+// hashtags and cashtags in a single left-to-right pass
+func ParseEntities(content string) []Entity {
+	var entities []Entity
+
+	prev := rune(-1) // Rune before the current position, -1 at the start
+	for i := 0; i < len(content); {
+		r, size := utf8.DecodeRuneInString(content[i:])
+
+		var entity *Entity
+		if !isWordRune(prev) {
+			switch {
+			case r == 'h' || r == 'H':
+				entity = scanURL(content, i)
+			case r == '@':
+				entity = scanMention(content, i)
+			case r == '#' || r == '＃':
+				entity = scanHashtag(content, i, size)
+			case r == '$':
+				entity = scanCashtag(content, i)
+			}
+		}
+
+		if entity != nil {
+			entities = append(entities, *entity)
+			prev, _ = utf8.DecodeLastRuneInString(content[:entity.ByteEnd])
+			i = entity.ByteEnd
+			continue
+		}
+
+		prev = r
+		i += size
+	}
+
+	setUTF16Offsets(content, entities)
+	return entities
+}
+
+// isWordRune reports whether r can be part of a word, which stops an entity
+// from starting immediately after it (e.g. the @ in an email address)
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// scanURL matches an http(s) URL starting at offset start
+func scanURL(content string, start int) *Entity {
+	rest := content[start:]
+	lower := strings.ToLower(rest[:min(len(rest), len("https://"))])
+	if !strings.HasPrefix(lower, "http://") && !strings.HasPrefix(lower, "https://") {
+		return nil
+	}
+
+	end := start
+	for end < len(content) {
+		r, size := utf8.DecodeRuneInString(content[end:])
+		if unicode.IsSpace(r) || r == '<' || r == '>' || r == '"' {
+			break
+		}
+		end += size
+	}
+	end = start + len(trimURLPunctuation(content[start:end]))
+
+	raw := content[start:end]
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return nil
+	}
+
+	entityType := EntityLink
+	if imageExtensions[strings.ToLower(path.Ext(u.Path))] {
+		entityType = EntityImageLink
+	}
+
+	return &Entity{Type: entityType, Text: raw, Value: raw, ByteStart: start, ByteEnd: end}
+}
+
+// trimURLPunctuation strips sentence punctuation and unbalanced closing
+// brackets that commonly trail a URL in prose
+func trimURLPunctuation(raw string) string {
+	for len(raw) > 0 {
+		last, size := utf8.DecodeLastRuneInString(raw)
+		switch last {
+		case '.', ',', ';', ':', '!', '?', '\'', '*':
+		case ')':
+			if strings.Count(raw, "(") >= strings.Count(raw, ")") {
+				return raw
+			}
+		case ']':
+			if strings.Count(raw, "[") >= strings.Count(raw, "]") {
+				return raw
+			}
+		default:
+			return raw
+		}
+		raw = raw[:len(raw)-size]
+	}
+	return raw
+}
+
+// scanMention matches @username where usernames are ASCII letters, digits
+// and underscores
+func scanMention(content string, start int) *Entity {
+	end := start + 1
+	for end < len(content) && end-start-1 < maxMentionLength && isUsernameByte(content[end]) {
+		end++
+	}
+	if end == start+1 || (end < len(content) && isUsernameByte(content[end])) {
+		return nil
+	}
+
+	return &Entity{
+		Type:      EntityMention,
+		Text:      content[start:end],
+		Value:     content[start+1 : end],
+		ByteStart: start,
+		ByteEnd:   end,
+	}
+}
+
+// scanHashtag matches #tag where the tag contains at least one letter
+func scanHashtag(content string, start, markerSize int) *Entity {
+	end := start + markerSize
+	hasLetter := false
+	for end < len(content) {
+		r, size := utf8.DecodeRuneInString(content[end:])
+		if !isWordRune(r) && !unicode.Is(unicode.Mn, r) {
+			break
+		}
+		if unicode.IsLetter(r) {
+			hasLetter = true
+		}
+		end += size
+	}
+	if !hasLetter {
+		return nil
+	}
+
+	return &Entity{
+		Type:      EntityHashtag,
+		Text:      content[start:end],
+		Value:     content[start+markerSize : end],
+		ByteStart: start,
+		ByteEnd:   end,
+	}
+}
+
+// scanCashtag matches $SYMBOL with one to six ASCII letters
+func scanCashtag(content string, start int) *Entity {
+	end := start + 1
+	for end < len(content) && isASCIILetter(content[end]) {
+		end++
+	}
+	length := end - start - 1
+	if length == 0 || length > maxCashtagLength {
+		return nil
+	}
+	if end < len(content) {
+		if r, _ := utf8.DecodeRuneInString(content[end:]); isWordRune(r) {
+			return nil
+		}
+	}
+
+	return &Entity{
+		Type:      EntityCashtag,
+		Text:      content[start:end],
+		Value:     strings.ToUpper(content[start+1 : end]),
+		ByteStart: start,
+		ByteEnd:   end,
+	}
+}
+
+func isUsernameByte(b byte) bool {
+	return b == '_' || isASCIILetter(b) || (b >= '0' && b <= '9')
+}
+
+func isASCIILetter(b byte) bool {
+	return (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z')
+}
+
+// setUTF16Offsets fills Start/End from the byte offsets of entities, which
+// must be sorted by position and non-overlapping
+func setUTF16Offsets(content string, entities []Entity) {
+	byteOffset, utf16Offset := 0, 0
+	advance := func(to int) {
+		for byteOffset < to {
+			r, size := utf8.DecodeRuneInString(content[byteOffset:])
+			utf16Offset += utf16.RuneLen(r)
+			byteOffset += size
+		}
+	}
+
+	for i := range entities {
+		advance(entities[i].ByteStart)
+		entities[i].Start = utf16Offset
+		advance(entities[i].ByteEnd)
+		entities[i].End = utf16Offset
+	}
+}
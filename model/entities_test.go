@@ -0,0 +1,137 @@
+package model
+
+import (
+	"testing"
+	"unicode/utf16"
+)
+
+func TestParseEntities(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []Entity // Type, Text, Value, Start and End are compared
+	}{
+		{
+			name:    "plain ASCII",
+			content: "Hi @alice, see https://example.com #go $aapl",
+			want: []Entity{
+				{Type: EntityMention, Text: "@alice", Value: "alice", Start: 3, End: 9},
+				{Type: EntityLink, Text: "https://example.com", Value: "https://example.com", Start: 15, End: 34},
+				{Type: EntityHashtag, Text: "#go", Value: "go", Start: 35, End: 38},
+				{Type: EntityCashtag, Text: "$aapl", Value: "AAPL", Start: 39, End: 44},
+			},
+		},
+		{
+			name:    "surrogate pair before a mention",
+			content: "😀 @alice",
+			want:    []Entity{{Type: EntityMention, Text: "@alice", Value: "alice", Start: 3, End: 9}},
+		},
+		{
+			name:    "astral letter before a cashtag",
+			content: "𝐀 $aapl",
+			want:    []Entity{{Type: EntityCashtag, Text: "$aapl", Value: "AAPL", Start: 3, End: 8}},
+		},
+		{
+			name:    "ZWJ sequence before a hashtag",
+			content: "👩‍💻 #golang",
+			want:    []Entity{{Type: EntityHashtag, Text: "#golang", Value: "golang", Start: 6, End: 13}},
+		},
+		{
+			name:    "flag before a mention",
+			content: "🇯🇵 @bob",
+			want:    []Entity{{Type: EntityMention, Text: "@bob", Value: "bob", Start: 5, End: 9}},
+		},
+		{
+			name:    "emoji right before and after a mention",
+			content: "😀@alice😀",
+			want:    []Entity{{Type: EntityMention, Text: "@alice", Value: "alice", Start: 2, End: 8}},
+		},
+		{
+			name:    "emoji right after a hashtag",
+			content: "#tag😀 #two",
+			want: []Entity{
+				{Type: EntityHashtag, Text: "#tag", Value: "tag", Start: 0, End: 4},
+				{Type: EntityHashtag, Text: "#two", Value: "two", Start: 7, End: 11},
+			},
+		},
+		{
+			name:    "combining mark in a hashtag",
+			content: "🎉 #café!",
+			want:    []Entity{{Type: EntityHashtag, Text: "#café", Value: "café", Start: 3, End: 9}},
+		},
+		{
+			name:    "fullwidth hash",
+			content: "＃日本語",
+			want:    []Entity{{Type: EntityHashtag, Text: "＃日本語", Value: "日本語", Start: 0, End: 4}},
+		},
+		{
+			name:    "URL after emoji, trailing period",
+			content: "👍https://example.com/a.",
+			want:    []Entity{{Type: EntityLink, Text: "https://example.com/a", Value: "https://example.com/a", Start: 2, End: 23}},
+		},
+		{
+			name:    "URL with trailing punctuation",
+			content: "Read https://example.com/post?id=1!?, then reply",
+			want:    []Entity{{Type: EntityLink, Text: "https://example.com/post?id=1", Value: "https://example.com/post?id=1", Start: 5, End: 34}},
+		},
+		{
+			name:    "URL with balanced parentheses inside unbalanced ones",
+			content: "(see https://en.wikipedia.org/wiki/Go_(language)).",
+			want: []Entity{{
+				Type:  EntityLink,
+				Text:  "https://en.wikipedia.org/wiki/Go_(language)",
+				Value: "https://en.wikipedia.org/wiki/Go_(language)",
+				Start: 5,
+				End:   48,
+			}},
+		},
+		{
+			name:    "URL ending in an emoji",
+			content: "https://example.com/😀 ok",
+			want:    []Entity{{Type: EntityLink, Text: "https://example.com/😀", Value: "https://example.com/😀", Start: 0, End: 22}},
+		},
+		{
+			name:    "image link",
+			content: "😀 https://example.com/cat.PNG?size=large",
+			want: []Entity{{
+				Type:  EntityImageLink,
+				Text:  "https://example.com/cat.PNG?size=large",
+				Value: "https://example.com/cat.PNG?size=large",
+				Start: 3,
+				End:   41,
+			}},
+		},
+		{name: "email address", content: "mail bob@example.com"},
+		{name: "digits only hashtag", content: "#123"},
+		{name: "cashtag too long", content: "$ABCDEFG"},
+		{name: "mention too long", content: "@abcdefghijklmnopqrstuvwxyz12345"},
+		{name: "scheme without host", content: "http:// nothing"},
+		{name: "hash inside a word", content: "C#sharp"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseEntities(tt.content)
+			if len(got) != len(tt.want) {
+				t.Fatalf("ParseEntities(%q) = %+v, want %+v", tt.content, got, tt.want)
+			}
+
+			units := utf16.Encode([]rune(tt.content))
+			for i, e := range got {
+				w := tt.want[i]
+				if e.Type != w.Type || e.Text != w.Text || e.Value != w.Value || e.Start != w.Start || e.End != w.End {
+					t.Errorf("entity %d = %+v, want %+v", i, e, w)
+				}
+
+				// Both pairs of offsets cut out the entity's text
+				if s := tt.content[e.ByteStart:e.ByteEnd]; s != e.Text {
+					t.Errorf("entity %d: bytes [%d:%d] = %q, want %q", i, e.ByteStart, e.ByteEnd, s, e.Text)
+				}
+				if e.End > len(units) {
+					t.Errorf("entity %d: End %d is past the %d UTF-16 units", i, e.End, len(units))
+				} else if s := string(utf16.Decode(units[e.Start:e.End])); s != e.Text {
+					t.Errorf("entity %d: UTF-16 [%d:%d] = %q, want %q", i, e.Start, e.End, s, e.Text)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package model
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// actorKeyBits is the size of the RSA keys users sign federated requests
+// with, as other servers expect
+const actorKeyBits = 2048
+
+var (
+	ErrRemoteFollowerNotFound = errors.New("remote follower not found")
+	ErrProtectedNotFederated  = errors.New("protected accounts can't be followed from other servers")
+)
+
+// RemoteFollower is an account on another server following a local user
+// over ActivityPub. Remote followers count towards the user's followers
+// and are sent the user's public posts.
+type RemoteFollower struct {
+	ActorID     string // The remote actor's IRI
+	Inbox       string
+	SharedInbox string // Shared by the actor's server, if it has one
+	FollowID    string // The IRI of the Follow activity, echoed back when accepting it
+	FollowedAt  time.Time
+}
+
+// DeliveryInbox returns the inbox to send the follower activities to,
+// preferring its server's shared inbox so each server gets one copy
+func (f RemoteFollower) DeliveryInbox() string {
+	if f.SharedInbox != "" {
+		return f.SharedInbox
+	}
+	return f.Inbox
+}
+
+// AddRemoteFollower makes a remote actor follow userID, or refreshes its
+// inboxes and Follow if it already does. The follow is recorded either way,
+// since a server following again has lost track of the acceptance.
+func (db *Database) AddRemoteFollower(userID string, follower RemoteFollower) (*RemoteFollower, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	user, exists := db.Users[userID]
+	if !exists {
+		return nil, fmt.Errorf("user with ID %s not found", userID)
+	}
+	if user.Protected {
+		return nil, ErrProtectedNotFederated
+	}
+
+	followers := db.RemoteFollowers[userID]
+	i := db.remoteFollowerIndex(userID, follower.ActorID)
+	if i >= 0 {
+		follower.FollowedAt = followers[i].FollowedAt
+		followers = append(followers[:i:i], followers[i+1:]...)
+	} else {
+		follower.FollowedAt = time.Now()
+	}
+	db.RemoteFollowers[userID] = append(followers, follower)
+	db.record(Event{Type: EventRemoteFollowed, SubjectID: userID, RemoteActorID: follower.ActorID})
+
+	return &follower, nil
+}
+
+// RemoveRemoteFollower stops a remote actor following userID
+func (db *Database) RemoveRemoteFollower(userID string, actorID string) (*RemoteFollower, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	i := db.remoteFollowerIndex(userID, actorID)
+	if i < 0 {
+		return nil, ErrRemoteFollowerNotFound
+	}
+
+	followers := db.RemoteFollowers[userID]
+	follower := followers[i]
+	if len(followers) == 1 {
+		delete(db.RemoteFollowers, userID)
+	} else {
+		db.RemoteFollowers[userID] = append(followers[:i:i], followers[i+1:]...)
+	}
+	db.record(Event{Type: EventRemoteUnfollowed, SubjectID: userID, RemoteActorID: actorID})
+
+	return &follower, nil
+}
+
+// GetRemoteFollower returns one of userID's remote followers, or nil
+func (db *Database) GetRemoteFollower(userID string, actorID string) *RemoteFollower {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	i := db.remoteFollowerIndex(userID, actorID)
+	if i < 0 {
+		return nil
+	}
+	follower := db.RemoteFollowers[userID][i]
+	return &follower
+}
+
+// GetRemoteFollowers returns userID's remote followers, oldest first
+func (db *Database) GetRemoteFollowers(userID string) []RemoteFollower {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	return append([]RemoteFollower(nil), db.RemoteFollowers[userID]...)
+}
+
+// remoteFollowerIndex returns the position of a remote follower of userID,
+// or -1. Must be called with mu held.
+func (db *Database) remoteFollowerIndex(userID string, actorID string) int {
+	for i, f := range db.RemoteFollowers[userID] {
+		if f.ActorID == actorID {
+			return i
+		}
+	}
+	return -1
+}
+
+// ActorKey returns the key userID signs federated requests with, creating
+// it on first use
+func (db *Database) ActorKey(userID string) (*rsa.PrivateKey, error) {
+	db.mu.RLock()
+	key, exists := db.ActorKeys[userID]
+	_, userExists := db.Users[userID]
+	db.mu.RUnlock()
+	if exists {
+		return key, nil
+	}
+	if !userExists {
+		return nil, fmt.Errorf("user with ID %s not found", userID)
+	}
+
+	// Generating a key is slow, so it is done without holding the lock;
+	// if another caller got there first, theirs is kept
+	key, err := rsa.GenerateKey(rand.Reader, actorKeyBits)
+	if err != nil {
+		return nil, err
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if existing, exists := db.ActorKeys[userID]; exists {
+		return existing, nil
+	}
+	db.ActorKeys[userID] = key
+	return key, nil
+}
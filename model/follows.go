@@ -0,0 +1,213 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+)
+
+// FollowStatus is the outcome of asking to follow a user
+type FollowStatus string
+
+const (
+	FollowFollowing FollowStatus = "following"
+	FollowRequested FollowStatus = "requested" // Waiting for a protected account to approve
+)
+
+var (
+	ErrFollowSelf      = errors.New("users cannot follow themselves")
+	ErrNoFollowRequest = errors.New("no pending follow request from this user")
+)
+
+// FollowUser makes followerID follow followeeID. Following a protected
+// account only files a request, which the account owner has to approve.
+func (db *Database) FollowUser(followerID, followeeID string) (FollowStatus, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	follower, followee, err := db.userPair(followerID, followeeID)
+	if err != nil {
+		return "", err
+	}
+	if followerID == followeeID {
+		return "", ErrFollowSelf
+	}
+	if db.blockedEitherWay(followerID, followeeID) {
+		return "", ErrBlocked
+	}
+	if follows(follower, followeeID) {
+		return FollowFollowing, nil
+	}
+
+	if followee.Protected {
+		requests := db.FollowRequests[followeeID]
+		if !containsID(requests, followerID) {
+			db.FollowRequests[followeeID] = append(requests, followerID)
+			db.record(Event{Type: EventFollowRequested, ActorID: followerID, SubjectID: followeeID})
+		}
+		return FollowRequested, nil
+	}
+
+	db.addFollow(follower, followeeID)
+	return FollowFollowing, nil
+}
+
+// UnfollowUser stops followerID following followeeID, withdrawing any
+// pending follow request
+func (db *Database) UnfollowUser(followerID, followeeID string) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	follower, _, err := db.userPair(followerID, followeeID)
+	if err != nil {
+		return err
+	}
+
+	db.removeFollow(follower, followeeID)
+	db.removeFollowRequest(followeeID, followerID)
+	return nil
+}
+
+// SetProtected marks a user's account protected or public. Making an
+// account public approves every pending follow request.
+func (db *Database) SetProtected(userID string, protected bool) (*User, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	user, exists := db.Users[userID]
+	if !exists {
+		return nil, fmt.Errorf("user with ID %s not found", userID)
+	}
+
+	updated := *user
+	updated.Protected = protected
+	db.Users[userID] = &updated
+
+	if !protected {
+		for _, requesterID := range db.FollowRequests[userID] {
+			if requester, exists := db.Users[requesterID]; exists {
+				db.addFollow(requester, userID)
+			}
+		}
+		delete(db.FollowRequests, userID)
+	}
+
+	return &updated, nil
+}
+
+// ApproveFollowRequest accepts requesterID's request to follow userID
+func (db *Database) ApproveFollowRequest(userID, requesterID string) (*User, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	_, requester, err := db.userPair(userID, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	if !db.removeFollowRequest(userID, requesterID) {
+		return nil, ErrNoFollowRequest
+	}
+
+	return db.addFollow(requester, userID), nil
+}
+
+// RejectFollowRequest declines requesterID's request to follow userID
+func (db *Database) RejectFollowRequest(userID, requesterID string) (*User, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	_, requester, err := db.userPair(userID, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	if !db.removeFollowRequest(userID, requesterID) {
+		return nil, ErrNoFollowRequest
+	}
+
+	return requester, nil
+}
+
+// GetFollowRequests returns the users waiting for userID to approve their
+// follow requests, oldest request first
+func (db *Database) GetFollowRequests(userID string) []*User {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	users := make([]*User, 0, len(db.FollowRequests[userID]))
+	for _, id := range db.FollowRequests[userID] {
+		if u, exists := db.Users[id]; exists {
+			users = append(users, u)
+		}
+	}
+	return users
+}
+
+// userPair looks up two users by ID. Must be called with mu held.
+func (db *Database) userPair(firstID, secondID string) (*User, *User, error) {
+	first, exists := db.Users[firstID]
+	if !exists {
+		return nil, nil, fmt.Errorf("user with ID %s not found", firstID)
+	}
+	second, exists := db.Users[secondID]
+	if !exists {
+		return nil, nil, fmt.Errorf("user with ID %s not found", secondID)
+	}
+	return first, second, nil
+}
+
+// addFollow swaps in a copy of user that follows followeeID. Must be
+// called with mu held for writing.
+func (db *Database) addFollow(user *User, followeeID string) *User {
+	if follows(user, followeeID) {
+		return user
+	}
+
+	updated := *user
+	updated.Follows = append(append([]string(nil), user.Follows...), followeeID)
+	db.Users[user.ID] = &updated
+	db.FollowerCounts[followeeID]++
+	db.record(Event{Type: EventUserFollowed, ActorID: user.ID, SubjectID: followeeID})
+	return &updated
+}
+
+// removeFollow swaps in a copy of user that no longer follows followeeID.
+// Must be called with mu held for writing.
+func (db *Database) removeFollow(user *User, followeeID string) *User {
+	if !follows(user, followeeID) {
+		return user
+	}
+
+	updated := *user
+	updated.Follows = removeID(user.Follows, followeeID)
+	db.Users[user.ID] = &updated
+	db.FollowerCounts[followeeID]--
+	if db.FollowerCounts[followeeID] <= 0 {
+		delete(db.FollowerCounts, followeeID)
+	}
+	db.record(Event{Type: EventUserUnfollowed, ActorID: user.ID, SubjectID: followeeID})
+	return &updated
+}
+
+// removeFollowRequest reports whether there was a request to remove. Must
+// be called with mu held for writing.
+func (db *Database) removeFollowRequest(userID, requesterID string) bool {
+	requests := db.FollowRequests[userID]
+	if !containsID(requests, requesterID) {
+		return false
+	}
+
+	if remaining := removeID(requests, requesterID); len(remaining) > 0 {
+		db.FollowRequests[userID] = remaining
+	} else {
+		delete(db.FollowRequests, userID)
+	}
+	return true
+}
+
+func containsID(ids []string, id string) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,242 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	MaxListsPerUser    = 100
+	MaxListMembers     = 500
+	MaxListName        = 50
+	MaxListDescription = 160
+)
+
+var (
+	ErrListNotFound  = errors.New("list not found")
+	ErrTooManyLists  = fmt.Errorf("a user can have at most %d lists", MaxListsPerUser)
+	ErrListFull      = fmt.Errorf("a list can have at most %d members", MaxListMembers)
+	ErrNotListMember = errors.New("user is not a member of this list")
+)
+
+// List is a named set of accounts curated by its owner. Members don't
+// have to be followed, and aren't told they were added. Private lists are
+// only seen by their owner.
+type List struct {
+	ID          string    `json:"id"`
+	OwnerID     string    `json:"ownerId"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Private     bool      `json:"private"`
+	MemberIDs   []string  `json:"memberIds"` // Oldest addition first
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// validate normalizes the name and description and checks their lengths
+func (l *List) validate() error {
+	l.Name = strings.TrimSpace(l.Name)
+	l.Description = strings.TrimSpace(l.Description)
+
+	if l.Name == "" {
+		return errors.New("list name is empty")
+	}
+	if len(l.Name) > MaxListName {
+		return fmt.Errorf("list name is longer than %d bytes", MaxListName)
+	}
+	if len(l.Description) > MaxListDescription {
+		return fmt.Errorf("list description is longer than %d bytes", MaxListDescription)
+	}
+	return nil
+}
+
+// CreateList creates an empty list owned by ownerID
+func (db *Database) CreateList(ownerID, name, description string, private bool) (*List, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if _, exists := db.Users[ownerID]; !exists {
+		return nil, fmt.Errorf("user with ID %s not found", ownerID)
+	}
+	if len(db.UserLists[ownerID]) >= MaxListsPerUser {
+		return nil, ErrTooManyLists
+	}
+
+	list := &List{
+		OwnerID:     ownerID,
+		Name:        name,
+		Description: description,
+		Private:     private,
+		CreatedAt:   time.Now(),
+	}
+	if err := list.validate(); err != nil {
+		return nil, err
+	}
+
+	list.ID = fmt.Sprintf("list%d", db.NextListID)
+	db.NextListID++
+	db.Lists[list.ID] = list
+	db.UserLists[ownerID] = append(db.UserLists[ownerID], list.ID)
+
+	return list, nil
+}
+
+// UpdateList replaces the name, description and privacy of one of
+// ownerID's lists
+func (db *Database) UpdateList(ownerID, listID, name, description string, private bool) (*List, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	list, err := db.ownedList(ownerID, listID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := *list
+	updated.Name = name
+	updated.Description = description
+	updated.Private = private
+	if err := updated.validate(); err != nil {
+		return nil, err
+	}
+
+	db.Lists[listID] = &updated
+	return &updated, nil
+}
+
+// DeleteList deletes one of ownerID's lists
+func (db *Database) DeleteList(ownerID, listID string) (*List, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	list, err := db.ownedList(ownerID, listID)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(db.Lists, listID)
+	if remaining := removeID(db.UserLists[ownerID], listID); len(remaining) > 0 {
+		db.UserLists[ownerID] = remaining
+	} else {
+		delete(db.UserLists, ownerID)
+	}
+
+	return list, nil
+}
+
+// AddListMember adds memberID to one of ownerID's lists. Users who have
+// blocked the owner, or been blocked by them, can't be added.
+func (db *Database) AddListMember(ownerID, listID, memberID string) (*List, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	list, err := db.ownedList(ownerID, listID)
+	if err != nil {
+		return nil, err
+	}
+	if _, exists := db.Users[memberID]; !exists {
+		return nil, fmt.Errorf("user with ID %s not found", memberID)
+	}
+	if db.blockedEitherWay(ownerID, memberID) {
+		return nil, ErrBlocked
+	}
+	if containsID(list.MemberIDs, memberID) {
+		return list, nil
+	}
+	if len(list.MemberIDs) >= MaxListMembers {
+		return nil, ErrListFull
+	}
+
+	updated := *list
+	updated.MemberIDs = append(append([]string(nil), list.MemberIDs...), memberID)
+	db.Lists[listID] = &updated
+	db.record(Event{Type: EventListMemberAdded, ActorID: ownerID, SubjectID: memberID, ListID: listID})
+	return &updated, nil
+}
+
+// RemoveListMember removes memberID from one of ownerID's lists
+func (db *Database) RemoveListMember(ownerID, listID, memberID string) (*List, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	list, err := db.ownedList(ownerID, listID)
+	if err != nil {
+		return nil, err
+	}
+	if !containsID(list.MemberIDs, memberID) {
+		return nil, ErrNotListMember
+	}
+
+	return db.removeListMember(list, memberID), nil
+}
+
+// GetList returns a list as seen by viewerID. Private lists, and lists of
+// owners blocking or blocked by the viewer, are reported as not found.
+func (db *Database) GetList(listID, viewerID string) (*List, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	list, exists := db.Lists[listID]
+	if !exists || !db.canViewList(viewerID, list) {
+		return nil, ErrListNotFound
+	}
+	return list, nil
+}
+
+// GetListsByUser returns the lists of ownerID that viewerID can see,
+// oldest first
+func (db *Database) GetListsByUser(ownerID, viewerID string) []*List {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	lists := make([]*List, 0, len(db.UserLists[ownerID]))
+	for _, id := range db.UserLists[ownerID] {
+		if list, exists := db.Lists[id]; exists && db.canViewList(viewerID, list) {
+			lists = append(lists, list)
+		}
+	}
+	return lists
+}
+
+// canViewList must be called with mu held
+func (db *Database) canViewList(viewerID string, list *List) bool {
+	if viewerID != "" && viewerID == list.OwnerID {
+		return true
+	}
+	return !list.Private && !db.blockedEitherWay(viewerID, list.OwnerID)
+}
+
+// ownedList looks up a list that ownerID may modify. Lists owned by
+// someone else are reported as not found. Must be called with mu held.
+func (db *Database) ownedList(ownerID, listID string) (*List, error) {
+	list, exists := db.Lists[listID]
+	if !exists || list.OwnerID != ownerID {
+		return nil, ErrListNotFound
+	}
+	return list, nil
+}
+
+// removeListMember swaps in a copy of list without memberID. Must be called
+// with mu held for writing.
+func (db *Database) removeListMember(list *List, memberID string) *List {
+	if !containsID(list.MemberIDs, memberID) {
+		return list
+	}
+
+	updated := *list
+	updated.MemberIDs = removeID(list.MemberIDs, memberID)
+	db.Lists[list.ID] = &updated
+	db.record(Event{Type: EventListMemberRemoved, ActorID: list.OwnerID, SubjectID: memberID, ListID: list.ID})
+	return &updated
+}
+
+// removeFromLists takes memberID off every list owned by ownerID. Must be
+// called with mu held for writing.
+func (db *Database) removeFromLists(ownerID, memberID string) {
+	for _, id := range db.UserLists[ownerID] {
+		if list, exists := db.Lists[id]; exists {
+			db.removeListMember(list, memberID)
+		}
+	}
+}
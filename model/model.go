@@ -1,16 +1,25 @@
 package model
 
 import (
+	"crypto/rsa"
 	"fmt"
-	"regexp"
+	"strings"
+	"sync"
 	"time"
 )
 
 // User represents a user in the system
 type User struct {
-	ID       string   `json:"id"`
-	Username string   `json:"username"`
-	Follows  []string `json:"follows"` // IDs of users this user follows
+	ID        string    `json:"id"`
+	Username  string    `json:"username"` // Unique, ignoring case
+	Follows   []string  `json:"follows"`  // IDs of users this user follows
+	CreatedAt time.Time `json:"createdAt"`
+
+	Profile
+
+	// Protected accounts approve their followers, and only followers see
+	// their posts
+	Protected bool `json:"protected"`
 }
 
 // Post represents a post in the system
@@ -19,53 +28,204 @@ type Post struct {
 	UserID    string    `json:"userId"`
 	Content   string    `json:"content"`
 	CreatedAt time.Time `json:"createdAt"`
+	Entities  []Entity  `json:"entities"` // Parsed from Content whenever it is written
+	Media     []Media   `json:"media"`
+	Poll      *Poll     `json:"poll,omitempty"`
+	ReplyToID string    `json:"replyToId,omitempty"` // Post this one replies to
+
+	Visibility Visibility `json:"visibility"`
+
+	Status    PostStatus `json:"status"`
+	PublishAt time.Time  `json:"publishAt,omitempty"` // Set while Status is PostScheduled
+}
+
+// PostOptions holds the optional parts of a new post
+type PostOptions struct {
+	Media []Media
+	Poll  *Poll
+
+	// ReplyToID is a post the author can see
+	ReplyToID string
+
+	// Visibility defaults to VisibilityPublic
+	Visibility Visibility
+
+	// Status defaults to PostPublished. Scheduled posts need PublishAt.
+	Status    PostStatus
+	PublishAt time.Time
+}
+
+// Media is an uploaded attachment on a post. The bytes are stored by the
+// media service, posts only keep what clients need to render them.
+type Media struct {
+	ID           string `json:"id"`
+	URL          string `json:"url"`
+	ThumbnailURL string `json:"thumbnailUrl"`
+	ContentType  string `json:"contentType"`
+	Width        int    `json:"width"`
+	Height       int    `json:"height"`
+	AltText      string `json:"altText"`
+}
+
+// MaxMediaPerPost is the number of attachments a post can carry
+const MaxMediaPerPost = 4
+
+// SetContent replaces the post content and re-parses its entities
+func (p *Post) SetContent(content string) {
+	p.Content = content
+	p.Entities = ParseEntities(content)
 }
 
-// GetImageURLsFromContent extracts image URLs from post content
-func (p *Post) GetImageURLsFromContent() []string {
-	// Regular expression to match image URLs
-	imageRegex := regexp.MustCompile(`https?:\/\/\S+\.(jpg|jpeg|png|gif|webp)(\?\S+)?`)
-	return imageRegex.FindAllString(p.Content, -1)
+// LinkURLs returns the URLs of non-image links in the post content
+func (p *Post) LinkURLs() []string {
+	var urls []string
+	for _, e := range p.Entities {
+		if e.Type == EntityLink {
+			urls = append(urls, e.Value)
+		}
+	}
+	return urls
 }
 
 // ContainsImages checks if the post content contains images
 func (p *Post) ContainsImages() bool {
-	return len(p.GetImageURLsFromContent()) > 0
+	for _, e := range p.Entities {
+		if e.Type == EntityImageLink {
+			return true
+		}
+	}
+	return false
 }
 
-// Database is an in-memory database simulation
+// Database is an in-memory database simulation. Methods are safe for
+// concurrent use; users and posts are replaced rather than modified in
+// place, so a returned *User or *Post is a stable snapshot.
 type Database struct {
-	Users      map[string]*User
-	Posts      map[string][]*Post // Posts indexed by user ID
-	PostsByID  map[string]*Post   // Posts indexed by ID for faster lookups
-	NextPostID int                // Used to generate unique post IDs
+	mu sync.RWMutex
+
+	Users       map[string]*User
+	Posts       map[string][]*Post        // Published posts indexed by user ID
+	PostsByID   map[string]*Post          // Published posts indexed by ID for faster lookups
+	Unpublished map[string]*Post          // Drafts and scheduled posts indexed by ID
+	PollVotes   map[string]map[string]int // Post ID -> user ID -> option index
+	Likes       map[string][]string       // Post ID -> IDs of users who liked it, oldest first
+	Reposts     map[string][]string       // Post ID -> IDs of users who reposted it, oldest first
+	Replies     map[string][]string       // Post ID -> IDs of published replies, oldest first
+	Pins        map[string][]string       // User ID -> pinned post IDs, most recent first
+
+	FollowRequests map[string][]string             // User ID -> IDs of users asking to follow, oldest first
+	Blocks         map[string][]string             // User ID -> IDs of blocked users, oldest first
+	Mutes          map[string]map[string]time.Time // User ID -> muted user ID -> expiry, zero for never
+	MuteFilters    map[string][]MuteFilter         // User ID -> keyword mute filters, oldest first
+
+	Lists     map[string]*List    // Lists indexed by ID
+	UserLists map[string][]string // Owner ID -> list IDs, oldest first
+
+	Usernames      map[string]string // Lowercased username -> user ID
+	FollowerCounts map[string]int    // User ID -> number of followers
+	names          *nameTrie         // Usernames and display names, for prefix search
+
+	Notifications   map[string][]*Notification // Recipient ID -> notifications, least recently changed first
+	NotifiedThrough int64                      // Sequence of the last event notifications were added for
+
+	Webhooks          map[string]*Webhook          // Webhooks indexed by ID
+	UserWebhooks      map[string][]string          // Owner ID -> webhook IDs, oldest first
+	WebhookDeliveries map[string][]WebhookDelivery // Webhook ID -> delivery attempts, oldest first
+	DeadLetters       map[string][]*DeadLetter     // Webhook ID -> undeliverable events, oldest first
+
+	RemoteFollowers map[string][]RemoteFollower // User ID -> followers on other servers, oldest first
+	ActorKeys       map[string]*rsa.PrivateKey  // User ID -> key signing the user's federated requests
+
+	Outbox          []Event                  // Domain events not yet handled by every consumer, oldest first
+	ConsumerOffsets map[string]int64         // Consumer name -> sequence of the last event it handled
+	ParkedEvents    map[string][]ParkedEvent // Consumer name -> events it gave up on, oldest first
+	outboxChanged   chan struct{}            // Closed and replaced when an event is recorded
+
+	NextEventSequence        int64 // Sequence of the next recorded event
+	NextNotificationID       int   // Used to generate unique notification IDs
+	NextNotificationSequence int64 // Sequence of the next notification change
+	NextListID               int   // Used to generate unique list IDs
+	NextMuteFilterID         int   // Used to generate unique mute filter IDs
+	NextPostID               int   // Used to generate unique post IDs
+	NextUserID               int   // Used to generate unique user IDs
+	NextWebhookID            int   // Used to generate unique webhook IDs
+	NextWebhookDeliveryID    int   // Used to generate unique delivery IDs
+	NextDeadLetterID         int   // Used to generate unique dead letter IDs
 }
 
 // NewDatabase creates a new in-memory database with mock data
 func NewDatabase() *Database {
 	db := &Database{
-		Users:      make(map[string]*User),
-		Posts:      make(map[string][]*Post),
-		PostsByID:  make(map[string]*Post),
-		NextPostID: 11, // Start after our initial posts
+		Users:       make(map[string]*User),
+		Posts:       make(map[string][]*Post),
+		PostsByID:   make(map[string]*Post),
+		Unpublished: make(map[string]*Post),
+		PollVotes:   make(map[string]map[string]int),
+		Likes:       make(map[string][]string),
+		Reposts:     make(map[string][]string),
+		Replies:     make(map[string][]string),
+		Pins:        make(map[string][]string),
+
+		FollowRequests: make(map[string][]string),
+		Blocks:         make(map[string][]string),
+		Mutes:          make(map[string]map[string]time.Time),
+		MuteFilters:    make(map[string][]MuteFilter),
+
+		Lists:     make(map[string]*List),
+		UserLists: make(map[string][]string),
+
+		Usernames:      make(map[string]string),
+		FollowerCounts: make(map[string]int),
+		names:          newNameTrie(),
+
+		Notifications: make(map[string][]*Notification),
+
+		Webhooks:          make(map[string]*Webhook),
+		UserWebhooks:      make(map[string][]string),
+		WebhookDeliveries: make(map[string][]WebhookDelivery),
+		DeadLetters:       make(map[string][]*DeadLetter),
+
+		RemoteFollowers: make(map[string][]RemoteFollower),
+		ActorKeys:       make(map[string]*rsa.PrivateKey),
+
+		ConsumerOffsets: make(map[string]int64),
+		ParkedEvents:    make(map[string][]ParkedEvent),
+		outboxChanged:   make(chan struct{}),
+
+		NextEventSequence:        1,
+		NextNotificationID:       1,
+		NextNotificationSequence: 1,
+		NextListID:               1,
+		NextMuteFilterID:         1,
+		NextPostID:               11, // Start after our initial posts
+		NextUserID:               6,  // Start after our initial users
+		NextWebhookID:            1,
+		NextWebhookDeliveryID:    1,
+		NextDeadLetterID:         1,
 	}
 
 	// Create mock users
+	now := time.Now()
+	day := 24 * time.Hour
 	users := []User{
-		{ID: "user1", Username: "alice", Follows: []string{"user2", "user3", "user4"}},
-		{ID: "user2", Username: "bob", Follows: []string{"user1", "user5"}},
-		{ID: "user3", Username: "charlie", Follows: []string{"user1", "user2"}},
-		{ID: "user4", Username: "dave", Follows: []string{"user1", "user5"}},
-		{ID: "user5", Username: "eve", Follows: []string{"user1", "user3"}},
+		{ID: "user1", Username: "alice", Follows: []string{"user2", "user3", "user4"}, CreatedAt: now.Add(-90 * day), Profile: Profile{DisplayName: "Alice"}},
+		{ID: "user2", Username: "bob", Follows: []string{"user1", "user5"}, CreatedAt: now.Add(-60 * day), Profile: Profile{DisplayName: "Bob"}},
+		{ID: "user3", Username: "charlie", Follows: []string{"user1", "user2"}, CreatedAt: now.Add(-45 * day), Profile: Profile{DisplayName: "Charlie"}},
+		{ID: "user4", Username: "dave", Follows: []string{"user1", "user5"}, CreatedAt: now.Add(-30 * day), Profile: Profile{DisplayName: "Dave"}},
+		{ID: "user5", Username: "eve", Follows: []string{"user1", "user3"}, CreatedAt: now.Add(-7 * day), Profile: Profile{DisplayName: "Eve"}},
 	}
 
 	for _, u := range users {
 		userCopy := u
 		db.Users[u.ID] = &userCopy
+		db.Usernames[strings.ToLower(u.Username)] = u.ID
+		db.names.add(&userCopy)
+		for _, id := range u.Follows {
+			db.FollowerCounts[id]++
+		}
 	}
 
 	// Create mock posts
-	now := time.Now()
 	posts := []Post{
 		{ID: "post1", UserID: "user1", Content: "Hello, world!", CreatedAt: now.Add(-1 * time.Hour)},
 		{ID: "post2", UserID: "user1", Content: "GraphQL is awesome", CreatedAt: now.Add(-2 * time.Hour)},
@@ -81,6 +241,9 @@ func NewDatabase() *Database {
 
 	for _, p := range posts {
 		postCopy := p
+		postCopy.Entities = ParseEntities(postCopy.Content)
+		postCopy.Status = PostPublished
+		postCopy.Visibility = VisibilityPublic
 		db.Posts[p.UserID] = append(db.Posts[p.UserID], &postCopy)
 		db.PostsByID[p.ID] = &postCopy
 	}
@@ -90,31 +253,132 @@ func NewDatabase() *Database {
 
 // GetUserByID retrieves a user by ID
 func (db *Database) GetUserByID(id string) *User {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
 	return db.Users[id]
 }
 
-// GetPostsByUserID retrieves posts for a specific user
-func (db *Database) GetPostsByUserID(userID string) []*Post {
-	return db.Posts[userID]
+// GetPostsByUserID retrieves the posts of a specific user that viewerID
+// is allowed to see
+func (db *Database) GetPostsByUserID(userID, viewerID string) []*Post {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	// Filtering copies, so callers don't see later deletions shift the slice
+	return db.visiblePosts(viewerID, db.Posts[userID])
+}
+
+// GetPostByID retrieves a single post as seen by viewerID. Posts the
+// viewer isn't allowed to see are reported as not found.
+func (db *Database) GetPostByID(postID, viewerID string) (*Post, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	post, exists := db.PostsByID[postID]
+	if !exists || !db.canView(viewerID, post) {
+		return nil, fmt.Errorf("post with ID %s not found", postID)
+	}
+	return post, nil
+}
+
+// GetPostsByIDs retrieves several posts at once as seen by viewerID.
+// Unknown posts and posts the viewer isn't allowed to see are skipped.
+func (db *Database) GetPostsByIDs(postIDs []string, viewerID string) []*Post {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	posts := make([]*Post, 0, len(postIDs))
+	for _, id := range postIDs {
+		if p, exists := db.PostsByID[id]; exists && db.canView(viewerID, p) {
+			posts = append(posts, p)
+		}
+	}
+	return posts
+}
+
+// AllPublishedPosts returns every published post, whoever may see it,
+// newest first
+func (db *Database) AllPublishedPosts() []*Post {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	posts := make([]*Post, 0, len(db.PostsByID))
+	for _, p := range db.PostsByID {
+		posts = append(posts, p)
+	}
+	sortNewestFirst(posts)
+	return posts
 }
 
 // CreatePost creates a new post for a user and returns it
-func (db *Database) CreatePost(userID string, content string) (*Post, error) {
+func (db *Database) CreatePost(userID string, content string, opts PostOptions) (*Post, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
 	// Check if user exists
 	if _, exists := db.Users[userID]; !exists {
 		return nil, fmt.Errorf("user with ID %s not found", userID)
 	}
 
+	if len(opts.Media) > MaxMediaPerPost {
+		return nil, fmt.Errorf("a post can have at most %d media attachments", MaxMediaPerPost)
+	}
+
+	visibility, err := validVisibility(opts.Visibility)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	status := opts.Status
+	if status == "" {
+		status = PostPublished
+	}
+	if status == PostScheduled && !opts.PublishAt.After(now) {
+		return nil, ErrPublishAtPast
+	}
+	if opts.Poll != nil {
+		// A scheduled poll runs from when it is published
+		pollStart := now
+		if status == PostScheduled {
+			pollStart = opts.PublishAt
+		}
+		if err := opts.Poll.validate(pollStart); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.ReplyToID != "" {
+		if _, err := db.reactionTarget(userID, opts.ReplyToID); err != nil {
+			return nil, err
+		}
+	}
+
 	// Generate unique post ID
 	postID := fmt.Sprintf("post%d", db.NextPostID)
 	db.NextPostID++
 
 	// Create the post
 	post := &Post{
-		ID:        postID,
-		UserID:    userID,
-		Content:   content,
-		CreatedAt: time.Now(),
+		ID:         postID,
+		UserID:     userID,
+		CreatedAt:  now,
+		Media:      opts.Media,
+		Poll:       opts.Poll,
+		ReplyToID:  opts.ReplyToID,
+		Visibility: visibility,
+		Status:     status,
+	}
+	post.SetContent(content)
+
+	// Drafts and scheduled posts are kept apart until they are published
+	if status != PostPublished {
+		if status == PostScheduled {
+			post.PublishAt = opts.PublishAt
+		}
+		db.Unpublished[postID] = post
+		return post, nil
 	}
 
 	// Add to user's posts
@@ -122,30 +386,67 @@ func (db *Database) CreatePost(userID string, content string) (*Post, error) {
 
 	// Add to posts by ID map
 	db.PostsByID[postID] = post
+	db.record(Event{Type: EventPostCreated, ActorID: userID, PostID: postID, Post: post})
+	db.addReply(post)
 
 	return post, nil
 }
 
 // UpdatePost updates an existing post
 func (db *Database) UpdatePost(postID string, content string) (*Post, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	// Drafts and scheduled posts can be edited until they are published
+	if draft, exists := db.Unpublished[postID]; exists {
+		updated := *draft
+		updated.SetContent(content)
+		db.Unpublished[postID] = &updated
+		return &updated, nil
+	}
+
 	// Check if post exists
 	post, exists := db.PostsByID[postID]
 	if !exists {
 		return nil, fmt.Errorf("post with ID %s not found", postID)
 	}
 
-	// Update the content
-	post.Content = content
+	// Update the content on a copy and swap it in
+	updated := *post
+	updated.SetContent(content)
+	db.replacePost(&updated)
+	db.record(Event{Type: EventPostUpdated, ActorID: updated.UserID, PostID: postID, Post: &updated})
 
-	return post, nil
+	return &updated, nil
 }
 
-// DeletePost deletes a post
-func (db *Database) DeletePost(postID string) (bool, error) {
+// replacePost swaps in a new version of an existing post. Must be called
+// with mu held for writing.
+func (db *Database) replacePost(post *Post) {
+	userPosts := db.Posts[post.UserID]
+	for i, p := range userPosts {
+		if p.ID == post.ID {
+			userPosts[i] = post
+			break
+		}
+	}
+	db.PostsByID[post.ID] = post
+}
+
+// DeletePost deletes a post, returning the post as it was
+func (db *Database) DeletePost(postID string) (*Post, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if draft, exists := db.Unpublished[postID]; exists {
+		delete(db.Unpublished, postID)
+		return draft, nil
+	}
+
 	// Check if post exists
 	post, exists := db.PostsByID[postID]
 	if !exists {
-		return false, fmt.Errorf("post with ID %s not found", postID)
+		return nil, fmt.Errorf("post with ID %s not found", postID)
 	}
 
 	// Get the user's posts
@@ -163,5 +464,13 @@ func (db *Database) DeletePost(postID string) (bool, error) {
 	// Remove from the post ID map
 	delete(db.PostsByID, postID)
 
-	return true, nil
+	// Remove any poll votes, likes, reposts and replies
+	delete(db.PollVotes, postID)
+	db.removeReactions(post)
+
+	// Unpin it from the author's profile
+	db.unpin(post)
+	db.record(Event{Type: EventPostDeleted, ActorID: post.UserID, PostID: postID, Post: post})
+
+	return post, nil
 }
@@ -0,0 +1,221 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// MuteFilterKind is how a mute filter's pattern is matched against posts
+type MuteFilterKind string
+
+const (
+	MuteWord    MuteFilterKind = "word"    // A whole word anywhere in the content
+	MutePhrase  MuteFilterKind = "phrase"  // Whole words in sequence, any whitespace between them
+	MuteHashtag MuteFilterKind = "hashtag" // A hashtag entity, with or without the leading #
+	MuteRegex   MuteFilterKind = "regex"   // A regular expression over the content
+)
+
+const (
+	MaxMuteFilters       = 100
+	MaxMuteFilterPattern = 200
+)
+
+var (
+	ErrTooManyMuteFilters = fmt.Errorf("a user can have at most %d mute filters", MaxMuteFilters)
+	ErrMuteFilterNotFound = errors.New("mute filter not found")
+)
+
+// MuteFilter hides posts matching its pattern from a user's timelines.
+// Matching is case-insensitive. A zero ExpiresAt never expires.
+type MuteFilter struct {
+	ID        string         `json:"id"`
+	UserID    string         `json:"userId"`
+	Kind      MuteFilterKind `json:"kind"`
+	Pattern   string         `json:"pattern"`
+	CreatedAt time.Time      `json:"createdAt"`
+	ExpiresAt time.Time      `json:"expiresAt,omitempty"`
+}
+
+// Active reports whether the filter still applies at now
+func (f *MuteFilter) Active(now time.Time) bool {
+	return f.ExpiresAt.IsZero() || now.Before(f.ExpiresAt)
+}
+
+// compile turns the filter into a case-insensitive regular expression.
+// Word and phrase patterns must be bounded by non-word characters, so
+// "go" doesn't match "good".
+func (f *MuteFilter) compile() (*regexp.Regexp, error) {
+	const boundaryStart, boundaryEnd = `(?:^|[^\p{L}\p{N}_])`, `(?:$|[^\p{L}\p{N}_])`
+
+	switch f.Kind {
+	case MuteWord:
+		return regexp.Compile(`(?i)` + boundaryStart + regexp.QuoteMeta(f.Pattern) + boundaryEnd)
+	case MutePhrase:
+		words := strings.Fields(f.Pattern)
+		for i, w := range words {
+			words[i] = regexp.QuoteMeta(w)
+		}
+		return regexp.Compile(`(?i)` + boundaryStart + strings.Join(words, `\s+`) + boundaryEnd)
+	case MuteHashtag:
+		return regexp.Compile(`(?i)^` + regexp.QuoteMeta(f.Pattern) + `$`)
+	case MuteRegex:
+		return regexp.Compile(`(?i)` + f.Pattern)
+	}
+	return nil, fmt.Errorf("unknown mute filter kind %q", f.Kind)
+}
+
+// validate normalizes the pattern and checks that it compiles
+func (f *MuteFilter) validate(now time.Time) error {
+	f.Pattern = strings.TrimSpace(f.Pattern)
+	if f.Kind == MuteHashtag {
+		f.Pattern = strings.TrimPrefix(f.Pattern, "#")
+	}
+	if f.Kind == MuteWord && strings.ContainsFunc(f.Pattern, unicode.IsSpace) {
+		return errors.New("a word filter cannot contain spaces, use a phrase filter")
+	}
+
+	if f.Pattern == "" {
+		return errors.New("mute filter pattern is empty")
+	}
+	if len(f.Pattern) > MaxMuteFilterPattern {
+		return fmt.Errorf("mute filter pattern is longer than %d bytes", MaxMuteFilterPattern)
+	}
+	if !f.ExpiresAt.IsZero() && !f.ExpiresAt.After(now) {
+		return ErrMuteExpired
+	}
+
+	if _, err := f.compile(); err != nil {
+		return fmt.Errorf("invalid mute filter: %w", err)
+	}
+	return nil
+}
+
+// MuteMatcher checks posts against a set of compiled mute filters
+type MuteMatcher struct {
+	content  []*regexp.Regexp
+	hashtags []*regexp.Regexp
+}
+
+// NewMuteMatcher compiles the filters active at now. Filters that fail to
+// compile are skipped, they were validated when they were added.
+func NewMuteMatcher(filters []MuteFilter, now time.Time) *MuteMatcher {
+	m := &MuteMatcher{}
+	for i := range filters {
+		f := &filters[i]
+		if !f.Active(now) {
+			continue
+		}
+		re, err := f.compile()
+		if err != nil {
+			continue
+		}
+		if f.Kind == MuteHashtag {
+			m.hashtags = append(m.hashtags, re)
+		} else {
+			m.content = append(m.content, re)
+		}
+	}
+	return m
+}
+
+// Empty reports whether the matcher has no filters
+func (m *MuteMatcher) Empty() bool {
+	return len(m.content) == 0 && len(m.hashtags) == 0
+}
+
+// Matches reports whether a post with the given content and hashtag
+// values (without the #) is muted
+func (m *MuteMatcher) Matches(content string, hashtags []string) bool {
+	for _, re := range m.content {
+		if re.MatchString(content) {
+			return true
+		}
+	}
+	for _, tag := range hashtags {
+		for _, re := range m.hashtags {
+			if re.MatchString(tag) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// AddMuteFilter stores a new mute filter for a user
+func (db *Database) AddMuteFilter(userID string, kind MuteFilterKind, pattern string, expiresAt time.Time) (*MuteFilter, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if _, exists := db.Users[userID]; !exists {
+		return nil, fmt.Errorf("user with ID %s not found", userID)
+	}
+
+	now := time.Now()
+	filter := MuteFilter{
+		UserID:    userID,
+		Kind:      kind,
+		Pattern:   pattern,
+		CreatedAt: now,
+		ExpiresAt: expiresAt,
+	}
+	if err := filter.validate(now); err != nil {
+		return nil, err
+	}
+
+	// Expired filters don't count towards the limit
+	filters := activeMuteFilters(db.MuteFilters[userID], now)
+	if len(filters) >= MaxMuteFilters {
+		return nil, ErrTooManyMuteFilters
+	}
+
+	filter.ID = fmt.Sprintf("filter%d", db.NextMuteFilterID)
+	db.NextMuteFilterID++
+	db.MuteFilters[userID] = append(filters, filter)
+
+	return &filter, nil
+}
+
+// RemoveMuteFilter deletes one of a user's mute filters
+func (db *Database) RemoveMuteFilter(userID, filterID string) (*MuteFilter, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	filters := db.MuteFilters[userID]
+	for i, f := range filters {
+		if f.ID != filterID {
+			continue
+		}
+
+		remaining := append(append([]MuteFilter(nil), filters[:i]...), filters[i+1:]...)
+		if len(remaining) > 0 {
+			db.MuteFilters[userID] = remaining
+		} else {
+			delete(db.MuteFilters, userID)
+		}
+		return &f, nil
+	}
+	return nil, ErrMuteFilterNotFound
+}
+
+// GetMuteFilters returns a user's filters active at now, oldest first
+func (db *Database) GetMuteFilters(userID string, now time.Time) []MuteFilter {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	return activeMuteFilters(db.MuteFilters[userID], now)
+}
+
+// activeMuteFilters returns a copy of filters without the expired ones
+func activeMuteFilters(filters []MuteFilter, now time.Time) []MuteFilter {
+	active := make([]MuteFilter, 0, len(filters))
+	for _, f := range filters {
+		if f.Active(now) {
+			active = append(active, f)
+		}
+	}
+	return active
+}
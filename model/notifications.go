@@ -0,0 +1,285 @@
+package model
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// MaxNotifications is how many notifications are kept per user; the
+	// oldest are dropped
+	MaxNotifications = 500
+
+	// notificationGroupWindow is how long after its latest activity an
+	// unread notification keeps gathering more of the same
+	notificationGroupWindow = 24 * time.Hour
+)
+
+// NotificationKind is the kind of activity a notification is about
+type NotificationKind string
+
+const (
+	NotifyFollow        NotificationKind = "FOLLOW"
+	NotifyFollowRequest NotificationKind = "FOLLOW_REQUEST"
+	NotifyMention       NotificationKind = "MENTION"
+	NotifyPollVote      NotificationKind = "POLL_VOTE"
+	NotifyLike          NotificationKind = "LIKE"
+	NotifyReply         NotificationKind = "REPLY"
+	NotifyRepost        NotificationKind = "REPOST"
+)
+
+// Notification tells a user about activity involving them. Activity of
+// the same kind on the same post, or on the account for follows, is
+// grouped into one unread notification: "alice and 3 others followed
+// you".
+type Notification struct {
+	ID        string
+	UserID    string // Recipient
+	Kind      NotificationKind
+	ActorIDs  []string // Most recent first
+	PostID    string   // Empty for activity on the account
+	CreatedAt time.Time
+	UpdatedAt time.Time // Latest activity
+	Read      bool
+	Sequence  int64 // Bumped on every change; notifications are listed by it, newest first
+}
+
+// NotificationActivity is one piece of activity to notify a user about
+type NotificationActivity struct {
+	UserID  string // Recipient
+	Kind    NotificationKind
+	ActorID string
+	PostID  string
+	At      time.Time
+}
+
+// NotificationPage is one page of a user's notifications
+type NotificationPage struct {
+	Notifications []*Notification
+	EndCursor     string // Empty if the page is empty
+	HasNextPage   bool
+}
+
+// AddNotifications records the activity produced by one domain event,
+// grouping it into the recipients' notifications, and returns the
+// notifications created or changed. Events at or before the last one
+// added are skipped, so an event delivered twice notifies once.
+func (db *Database) AddNotifications(eventSequence int64, activity []NotificationActivity) []*Notification {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if eventSequence <= db.NotifiedThrough {
+		return nil
+	}
+	db.NotifiedThrough = eventSequence
+
+	var changed []*Notification
+	for _, a := range activity {
+		if a.ActorID == a.UserID {
+			continue
+		}
+		if _, exists := db.Users[a.UserID]; !exists {
+			continue
+		}
+		changed = append(changed, db.addNotification(a))
+	}
+	return changed
+}
+
+// addNotification groups activity into a recent unread notification of
+// the same kind and post, or starts a new one. Must be called with mu held
+// for writing.
+func (db *Database) addNotification(a NotificationActivity) *Notification {
+	notifications := db.Notifications[a.UserID]
+
+	// Notifications are ordered by their latest change, so the search can
+	// stop at the first one outside the window
+	for i := len(notifications) - 1; i >= 0; i-- {
+		n := notifications[i]
+		if a.At.Sub(n.UpdatedAt) > notificationGroupWindow {
+			break
+		}
+		if n.Read || n.Kind != a.Kind || n.PostID != a.PostID {
+			continue
+		}
+
+		grouped := *n
+		grouped.ActorIDs = append([]string{a.ActorID}, removeID(n.ActorIDs, a.ActorID)...)
+		grouped.UpdatedAt = a.At
+		grouped.Sequence = db.NextNotificationSequence
+		db.NextNotificationSequence++
+
+		rest := make([]*Notification, 0, len(notifications))
+		rest = append(rest, notifications[:i]...)
+		rest = append(rest, notifications[i+1:]...)
+		db.Notifications[a.UserID] = append(rest, &grouped)
+		return &grouped
+	}
+
+	n := &Notification{
+		ID:        fmt.Sprintf("notification%d", db.NextNotificationID),
+		UserID:    a.UserID,
+		Kind:      a.Kind,
+		ActorIDs:  []string{a.ActorID},
+		PostID:    a.PostID,
+		CreatedAt: a.At,
+		UpdatedAt: a.At,
+		Sequence:  db.NextNotificationSequence,
+	}
+	db.NextNotificationID++
+	db.NextNotificationSequence++
+
+	notifications = append(notifications, n)
+	if len(notifications) > MaxNotifications {
+		notifications = append([]*Notification(nil), notifications[len(notifications)-MaxNotifications:]...)
+	}
+	db.Notifications[a.UserID] = notifications
+	return n
+}
+
+// GetNotifications returns a page of userID's notifications, most
+// recently changed first. An empty after starts at the newest.
+func (db *Database) GetNotifications(userID string, first int, after string) (*NotificationPage, error) {
+	var before int64
+	if after != "" {
+		seq, err := decodeNotificationCursor(after)
+		if err != nil {
+			return nil, err
+		}
+		before = seq
+	}
+	first = ClampPageSize(first)
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	now := time.Now()
+	notifications := db.Notifications[userID]
+	page := &NotificationPage{Notifications: make([]*Notification, 0, first)}
+	for i := len(notifications) - 1; i >= 0; i-- {
+		n := notifications[i]
+		if before != 0 && n.Sequence >= before {
+			continue
+		}
+		if len(page.Notifications) == first {
+			page.HasNextPage = true
+			break
+		}
+		if visible := db.visibleNotification(n, now); visible != nil {
+			page.Notifications = append(page.Notifications, visible)
+			page.EndCursor = encodeNotificationCursor(n.Sequence)
+		}
+	}
+	return page, nil
+}
+
+// VisibleNotification returns a notification as its recipient would see
+// it listed, or nil if it no longer applies
+func (db *Database) VisibleNotification(n *Notification) *Notification {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	return db.visibleNotification(n, time.Now())
+}
+
+// UnreadNotificationCount counts userID's unread notifications
+func (db *Database) UnreadNotificationCount(userID string) int {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	return db.unreadNotificationCount(userID, time.Now())
+}
+
+// MarkNotificationsRead marks the given notifications of userID read, or
+// all of them if ids is empty, and returns how many are left unread
+func (db *Database) MarkNotificationsRead(userID string, ids []string) (int, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if _, exists := db.Users[userID]; !exists {
+		return 0, fmt.Errorf("user with ID %s not found", userID)
+	}
+
+	marked := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		marked[id] = true
+	}
+
+	for i, n := range db.Notifications[userID] {
+		if n.Read || (len(ids) > 0 && !marked[n.ID]) {
+			continue
+		}
+		read := *n
+		read.Read = true
+		db.Notifications[userID][i] = &read
+	}
+
+	return db.unreadNotificationCount(userID, time.Now()), nil
+}
+
+// unreadNotificationCount must be called with mu held
+func (db *Database) unreadNotificationCount(userID string, now time.Time) int {
+	count := 0
+	for _, n := range db.Notifications[userID] {
+		if !n.Read && db.visibleNotification(n, now) != nil {
+			count++
+		}
+	}
+	return count
+}
+
+// visibleNotification returns a notification as its recipient sees it
+// now: actors they have blocked, been blocked by or muted are left out,
+// and notifications about posts they can no longer see, or with no actors
+// left, are dropped (nil). Must be called with mu held.
+func (db *Database) visibleNotification(n *Notification, now time.Time) *Notification {
+	if n.PostID != "" {
+		post, exists := db.PostsByID[n.PostID]
+		if !exists || !db.canView(n.UserID, post) {
+			return nil
+		}
+	}
+
+	actors := make([]string, 0, len(n.ActorIDs))
+	for _, id := range n.ActorIDs {
+		expiresAt, muted := db.Mutes[n.UserID][id]
+		if db.blockedEitherWay(n.UserID, id) || muted && muteActive(expiresAt, now) {
+			continue
+		}
+		actors = append(actors, id)
+	}
+	if len(actors) == 0 {
+		return nil
+	}
+	if len(actors) == len(n.ActorIDs) {
+		return n
+	}
+
+	visible := *n
+	visible.ActorIDs = actors
+	return &visible
+}
+
+// encodeNotificationCursor returns an opaque cursor pointing after the
+// notification with the given sequence
+func encodeNotificationCursor(sequence int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte("notification:" + strconv.FormatInt(sequence, 10)))
+}
+
+// decodeNotificationCursor parses a cursor produced by
+// encodeNotificationCursor
+func decodeNotificationCursor(s string) (int64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return 0, ErrInvalidCursor
+	}
+	kind, rest, _ := strings.Cut(string(raw), ":")
+	seq, err := strconv.ParseInt(rest, 10, 64)
+	if kind != "notification" || err != nil || seq <= 0 {
+		return 0, ErrInvalidCursor
+	}
+	return seq, nil
+}
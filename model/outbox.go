@@ -0,0 +1,178 @@
+package model
+
+import (
+	"time"
+)
+
+// MaxParkedEvents is how many events a consumer gave up on are kept for it
+const MaxParkedEvents = 100
+
+// EventType names a kind of domain event
+type EventType string
+
+const (
+	EventUserCreated    EventType = "UserCreated"
+	EventProfileUpdated EventType = "ProfileUpdated"
+
+	EventPostCreated  EventType = "PostCreated" // Also when a draft or scheduled post is published
+	EventPostUpdated  EventType = "PostUpdated"
+	EventPostDeleted  EventType = "PostDeleted"
+	EventPostPinned   EventType = "PostPinned"
+	EventPostUnpinned EventType = "PostUnpinned"
+	EventPollVoted    EventType = "PollVoted"
+
+	EventPostLiked      EventType = "PostLiked"
+	EventPostUnliked    EventType = "PostUnliked"
+	EventPostReplied    EventType = "PostReplied" // PostID is the post replied to
+	EventPostReposted   EventType = "PostReposted"
+	EventPostUnreposted EventType = "PostUnreposted"
+
+	EventUserFollowed    EventType = "UserFollowed"
+	EventUserUnfollowed  EventType = "UserUnfollowed"
+	EventFollowRequested EventType = "FollowRequested"
+	EventUserBlocked     EventType = "UserBlocked"
+	EventUserUnblocked   EventType = "UserUnblocked"
+
+	EventRemoteFollowed   EventType = "RemoteFollowed" // By an account on another server
+	EventRemoteUnfollowed EventType = "RemoteUnfollowed"
+
+	EventListMemberAdded   EventType = "ListMemberAdded"
+	EventListMemberRemoved EventType = "ListMemberRemoved"
+)
+
+// Event is a domain event: a state change other parts of the system may
+// react to. Only changes others can see are recorded; drafts, mutes and
+// mute filters are private to their owner and produce no events.
+type Event struct {
+	Sequence   int64 // Position in the outbox, increasing by one per event
+	Type       EventType
+	ActorID    string // User who made the change
+	SubjectID  string // User acted on, for follows, blocks and list members, or the author of the post reacted to
+	PostID     string
+	ListID     string
+	Post       *Post // The post after the change, or as it was when deleted
+	OccurredAt time.Time
+
+	RemoteActorID string // ActivityPub IRI of the account on another server, for remote follows
+}
+
+// ParkedEvent is an event a consumer still failed to handle after every
+// retry. It is set aside so the consumer, and the outbox, can move on.
+type ParkedEvent struct {
+	Event     Event
+	Attempts  int
+	LastError string
+	ParkedAt  time.Time
+}
+
+// record adds an event to the outbox. Mutations call it in the same
+// critical section as the change itself, so an event is recorded if and
+// only if its change is made. Must be called with mu held for writing.
+func (db *Database) record(event Event) {
+	event.Sequence = db.NextEventSequence
+	db.NextEventSequence++
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = time.Now()
+	}
+	db.Outbox = append(db.Outbox, event)
+
+	close(db.outboxChanged)
+	db.outboxChanged = make(chan struct{})
+}
+
+// RegisterConsumer adds a consumer of the outbox, returning the sequence
+// of the last event it has acknowledged. A new consumer starts with the
+// events still in the outbox.
+func (db *Database) RegisterConsumer(name string) int64 {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if offset, exists := db.ConsumerOffsets[name]; exists {
+		return offset
+	}
+
+	offset := db.NextEventSequence - 1
+	if len(db.Outbox) > 0 {
+		offset = db.Outbox[0].Sequence - 1
+	}
+	db.ConsumerOffsets[name] = offset
+	return offset
+}
+
+// ReadOutbox returns up to limit events after the given sequence. When
+// there are none it returns a channel that is closed once there are.
+func (db *Database) ReadOutbox(after int64, limit int) ([]Event, <-chan struct{}) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	start := 0
+	if len(db.Outbox) > 0 {
+		start = max(0, int(after-db.Outbox[0].Sequence+1))
+	}
+	end := min(len(db.Outbox), start+limit)
+	if start >= end {
+		return nil, db.outboxChanged
+	}
+
+	events := make([]Event, end-start)
+	copy(events, db.Outbox[start:end])
+	return events, db.outboxChanged
+}
+
+// AckEvents records that a consumer has handled every event up to and
+// including sequence. Events every consumer has handled are dropped.
+func (db *Database) AckEvents(name string, sequence int64) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if sequence <= db.ConsumerOffsets[name] {
+		return
+	}
+	db.ConsumerOffsets[name] = sequence
+
+	handled := sequence
+	for _, offset := range db.ConsumerOffsets {
+		handled = min(handled, offset)
+	}
+	if len(db.Outbox) > 0 && handled >= db.Outbox[0].Sequence {
+		n := int(handled - db.Outbox[0].Sequence + 1)
+		db.Outbox = append([]Event(nil), db.Outbox[n:]...)
+	}
+}
+
+// ConsumerOffset returns the sequence of the last event a consumer has
+// acknowledged
+func (db *Database) ConsumerOffset(name string) int64 {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	return db.ConsumerOffsets[name]
+}
+
+// ParkEvent sets aside an event the named consumer gave up on. The consumer
+// still has to acknowledge it to move past it. Only the last
+// MaxParkedEvents are kept.
+func (db *Database) ParkEvent(name string, event Event, attempts int, err error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	parked := append(db.ParkedEvents[name], ParkedEvent{
+		Event:     event,
+		Attempts:  attempts,
+		LastError: err.Error(),
+		ParkedAt:  time.Now(),
+	})
+	if len(parked) > MaxParkedEvents {
+		parked = append([]ParkedEvent(nil), parked[len(parked)-MaxParkedEvents:]...)
+	}
+	db.ParkedEvents[name] = parked
+}
+
+// GetParkedEvents returns the events the named consumer gave up on, oldest
+// first
+func (db *Database) GetParkedEvents(name string) []ParkedEvent {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	return append([]ParkedEvent(nil), db.ParkedEvents[name]...)
+}
@@ -0,0 +1,152 @@
+package model
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+// ErrInvalidCursor is returned for cursors that weren't produced by us
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor marks a position in a newest-first list of posts. Posts are
+// ordered by their creation time in whole seconds, the precision the gRPC
+// API carries, then by ID, so every service agrees on the order. Pinned
+// cursors point into the pinned section that leads a profile.
+type Cursor struct {
+	Pinned    bool
+	CreatedAt time.Time
+	PostID    string
+}
+
+// PostPage is one page of a cursor-paginated list of posts
+type PostPage struct {
+	Posts       []*Post
+	EndCursor   Cursor // Position after the last post, zero if the page is empty
+	HasNextPage bool
+}
+
+// CursorFor returns the cursor pointing just after post
+func CursorFor(post *Post) Cursor {
+	return Cursor{CreatedAt: post.CreatedAt, PostID: post.ID}
+}
+
+// Encode returns the cursor as an opaque string
+func (c Cursor) Encode() string {
+	var raw string
+	if c.Pinned {
+		raw = "pin:" + c.PostID
+	} else {
+		raw = "post:" + strconv.FormatInt(c.CreatedAt.Unix(), 10) + ":" + c.PostID
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeCursor parses a cursor produced by Cursor.Encode
+func DecodeCursor(s string) (Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	kind, rest, _ := strings.Cut(string(raw), ":")
+	switch kind {
+	case "pin":
+		if rest == "" {
+			return Cursor{}, ErrInvalidCursor
+		}
+		return Cursor{Pinned: true, PostID: rest}, nil
+	case "post":
+		secs, id, ok := strings.Cut(rest, ":")
+		n, err := strconv.ParseInt(secs, 10, 64)
+		if !ok || err != nil || id == "" {
+			return Cursor{}, ErrInvalidCursor
+		}
+		return Cursor{CreatedAt: time.Unix(n, 0), PostID: id}, nil
+	}
+	return Cursor{}, ErrInvalidCursor
+}
+
+// After reports whether post comes after the cursor in newest-first order
+func (c Cursor) After(post *Post) bool {
+	return NewerThan(c.CreatedAt, c.PostID, post.CreatedAt, post.ID)
+}
+
+// NewerThan reports whether the post created at a with ID aID comes before
+// the post created at b with ID bID in newest-first order. Times are
+// compared in whole seconds and ties are broken by ID, so the order is
+// total.
+func NewerThan(a time.Time, aID string, b time.Time, bID string) bool {
+	if a.Unix() != b.Unix() {
+		return a.Unix() > b.Unix()
+	}
+	return aID > bID
+}
+
+// ClampPageSize applies the default and maximum page sizes
+func ClampPageSize(first int) int {
+	if first <= 0 {
+		return DefaultPageSize
+	}
+	return min(first, MaxPageSize)
+}
+
+// PagePostsByUserID returns a page of the posts of a user that viewerID is
+// allowed to see, newest first. Unlike ListUserPosts, pinned posts keep
+// their place in time. An empty after starts at the newest post.
+func (db *Database) PagePostsByUserID(userID, viewerID string, first int, after string) (*PostPage, error) {
+	cursor, err := parseAfter(after)
+	if err != nil {
+		return nil, err
+	}
+	if cursor != nil && cursor.Pinned {
+		return nil, ErrInvalidCursor
+	}
+	first = ClampPageSize(first)
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	posts := db.visiblePosts(viewerID, db.Posts[userID])
+	sortNewestFirst(posts)
+
+	start := 0
+	if cursor != nil {
+		start = len(posts)
+		for i, p := range posts {
+			if cursor.After(p) {
+				start = i
+				break
+			}
+		}
+	}
+	end := min(start+first, len(posts))
+
+	page := &PostPage{
+		Posts:       posts[start:end],
+		HasNextPage: end < len(posts),
+	}
+	if end > start {
+		page.EndCursor = CursorFor(posts[end-1])
+	}
+	return page, nil
+}
+
+// parseAfter decodes an optional after cursor
+func parseAfter(after string) (*Cursor, error) {
+	if after == "" {
+		return nil, nil
+	}
+	c, err := DecodeCursor(after)
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
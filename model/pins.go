@@ -0,0 +1,186 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+)
+
+// MaxPinnedPosts is the number of posts a user can pin to their profile
+const MaxPinnedPosts = 3
+
+var (
+	ErrNotPostAuthor  = errors.New("users can only pin their own posts")
+	ErrTooManyPinned  = fmt.Errorf("a user can pin at most %d posts", MaxPinnedPosts)
+	ErrPostNotPinned  = errors.New("post is not pinned")
+	ErrPinUnpublished = errors.New("only published posts can be pinned")
+)
+
+// PinPost pins one of a user's published posts to their profile. Pinning
+// an already pinned post moves it to the top.
+func (db *Database) PinPost(userID, postID string) (*Post, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	post, exists := db.PostsByID[postID]
+	if !exists {
+		if _, unpublished := db.Unpublished[postID]; unpublished {
+			return nil, ErrPinUnpublished
+		}
+		return nil, fmt.Errorf("post with ID %s not found", postID)
+	}
+	if post.UserID != userID {
+		return nil, ErrNotPostAuthor
+	}
+
+	pins := removeID(db.Pins[userID], postID)
+	if len(pins) >= MaxPinnedPosts {
+		return nil, ErrTooManyPinned
+	}
+	db.Pins[userID] = append([]string{postID}, pins...)
+	db.record(Event{Type: EventPostPinned, ActorID: userID, PostID: postID, Post: post})
+
+	return post, nil
+}
+
+// UnpinPost removes a post from a user's pinned posts
+func (db *Database) UnpinPost(userID, postID string) (*Post, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	post, exists := db.PostsByID[postID]
+	if !exists {
+		return nil, fmt.Errorf("post with ID %s not found", postID)
+	}
+	if post.UserID != userID {
+		return nil, ErrNotPostAuthor
+	}
+	if !db.isPinned(post) {
+		return nil, ErrPostNotPinned
+	}
+
+	db.unpin(post)
+	db.record(Event{Type: EventPostUnpinned, ActorID: userID, PostID: postID, Post: post})
+	return post, nil
+}
+
+// IsPinned reports whether a post is pinned to its author's profile
+func (db *Database) IsPinned(post *Post) bool {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	return db.isPinned(post)
+}
+
+// GetPinnedPosts returns the pinned posts of a user that viewerID is
+// allowed to see, most recently pinned first
+func (db *Database) GetPinnedPosts(userID, viewerID string) []*Post {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	return db.visiblePosts(viewerID, db.pinnedPosts(userID))
+}
+
+// ListUserPosts returns a page of a user's profile as seen by viewerID:
+// pinned posts first, then the rest of their posts newest first. An empty
+// after starts at the top of the profile.
+func (db *Database) ListUserPosts(userID, viewerID string, first int, after string) (*PostPage, error) {
+	cursor, err := parseAfter(after)
+	if err != nil {
+		return nil, err
+	}
+	first = ClampPageSize(first)
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	pinned := db.visiblePosts(viewerID, db.pinnedPosts(userID))
+	pinnedIDs := make(map[string]bool, len(pinned))
+	for _, p := range pinned {
+		pinnedIDs[p.ID] = true
+	}
+
+	rest := make([]*Post, 0, len(db.Posts[userID]))
+	for _, p := range db.visiblePosts(viewerID, db.Posts[userID]) {
+		if !pinnedIDs[p.ID] {
+			rest = append(rest, p)
+		}
+	}
+	sortNewestFirst(rest)
+
+	// Work out where the page starts in pinned followed by rest
+	start := 0
+	switch {
+	case cursor == nil:
+	case cursor.Pinned:
+		// A post unpinned since the cursor was issued resumes at the rest
+		start = len(pinned)
+		for i, p := range pinned {
+			if p.ID == cursor.PostID {
+				start = i + 1
+				break
+			}
+		}
+	default:
+		start = len(pinned) + len(rest)
+		for i, p := range rest {
+			if cursor.After(p) {
+				start = len(pinned) + i
+				break
+			}
+		}
+	}
+
+	total := len(pinned) + len(rest)
+	end := min(start+first, total)
+
+	page := &PostPage{HasNextPage: end < total}
+	for i := start; i < end; i++ {
+		if i < len(pinned) {
+			page.Posts = append(page.Posts, pinned[i])
+			page.EndCursor = Cursor{Pinned: true, PostID: pinned[i].ID}
+		} else {
+			p := rest[i-len(pinned)]
+			page.Posts = append(page.Posts, p)
+			page.EndCursor = CursorFor(p)
+		}
+	}
+
+	return page, nil
+}
+
+// pinnedPosts resolves a user's pins. Must be called with mu held.
+func (db *Database) pinnedPosts(userID string) []*Post {
+	posts := make([]*Post, 0, len(db.Pins[userID]))
+	for _, id := range db.Pins[userID] {
+		if p, exists := db.PostsByID[id]; exists {
+			posts = append(posts, p)
+		}
+	}
+	return posts
+}
+
+// isPinned must be called with mu held
+func (db *Database) isPinned(post *Post) bool {
+	return containsID(db.Pins[post.UserID], post.ID)
+}
+
+// unpin must be called with mu held for writing
+func (db *Database) unpin(post *Post) {
+	pins := removeID(db.Pins[post.UserID], post.ID)
+	if len(pins) == 0 {
+		delete(db.Pins, post.UserID)
+		return
+	}
+	db.Pins[post.UserID] = pins
+}
+
+// removeID returns ids without id, leaving the input untouched
+func removeID(ids []string, id string) []string {
+	out := make([]string, 0, len(ids))
+	for _, v := range ids {
+		if v != id {
+			out = append(out, v)
+		}
+	}
+	return out
+}
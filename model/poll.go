@@ -0,0 +1,130 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	MinPollOptions      = 2
+	MaxPollOptions      = 4
+	MaxPollOptionLength = 25
+	MinPollDuration     = 5 * time.Minute
+	MaxPollDuration     = 7 * 24 * time.Hour
+)
+
+var (
+	ErrNoPoll       = errors.New("post does not have a poll")
+	ErrPollClosed   = errors.New("poll is closed")
+	ErrAlreadyVoted = errors.New("user has already voted in this poll")
+)
+
+// Poll is a set of options attached to a post. Votes are stored separately
+// in Database.PollVotes, one record per user.
+type Poll struct {
+	Options   []string  `json:"options"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// Closed reports whether the poll no longer accepts votes
+func (p *Poll) Closed(now time.Time) bool {
+	return !now.Before(p.ExpiresAt)
+}
+
+// validate checks option count, option text and expiry of a new poll,
+// trimming whitespace from the options
+func (p *Poll) validate(now time.Time) error {
+	if len(p.Options) < MinPollOptions || len(p.Options) > MaxPollOptions {
+		return fmt.Errorf("a poll must have between %d and %d options", MinPollOptions, MaxPollOptions)
+	}
+
+	seen := make(map[string]bool)
+	for i, option := range p.Options {
+		option = strings.TrimSpace(option)
+		if option == "" {
+			return fmt.Errorf("poll option %d is empty", i+1)
+		}
+		if len([]rune(option)) > MaxPollOptionLength {
+			return fmt.Errorf("poll option %d is longer than %d characters", i+1, MaxPollOptionLength)
+		}
+		if seen[strings.ToLower(option)] {
+			return fmt.Errorf("poll option %q is repeated", option)
+		}
+		seen[strings.ToLower(option)] = true
+		p.Options[i] = option
+	}
+
+	duration := p.ExpiresAt.Sub(now)
+	if duration < MinPollDuration || duration > MaxPollDuration {
+		return fmt.Errorf("a poll must close between %s and %s from now", MinPollDuration, MaxPollDuration)
+	}
+
+	return nil
+}
+
+// PollResults is the state of a poll as seen by one viewer
+type PollResults struct {
+	Counts     []int // Votes per option, in option order
+	TotalVotes int
+	ViewerVote int // Option index the viewer voted for, -1 if they haven't
+}
+
+// VotePoll records a user's vote. Each user can vote once per poll.
+func (db *Database) VotePoll(postID, userID string, option int) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	post, exists := db.PostsByID[postID]
+	if !exists || !db.canView(userID, post) {
+		return fmt.Errorf("post with ID %s not found", postID)
+	}
+	if _, exists := db.Users[userID]; !exists {
+		return fmt.Errorf("user with ID %s not found", userID)
+	}
+	if post.Poll == nil {
+		return ErrNoPoll
+	}
+	if post.Poll.Closed(time.Now()) {
+		return ErrPollClosed
+	}
+	if option < 0 || option >= len(post.Poll.Options) {
+		return fmt.Errorf("poll option %d does not exist", option)
+	}
+
+	votes := db.PollVotes[postID]
+	if votes == nil {
+		votes = make(map[string]int)
+		db.PollVotes[postID] = votes
+	}
+	if _, voted := votes[userID]; voted {
+		return ErrAlreadyVoted
+	}
+	votes[userID] = option
+	db.record(Event{Type: EventPollVoted, ActorID: userID, SubjectID: post.UserID, PostID: postID, Post: post})
+
+	return nil
+}
+
+// GetPollResults tallies the votes of a post's poll for a viewer
+func (db *Database) GetPollResults(post *Post, viewerID string) *PollResults {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	results := &PollResults{
+		Counts:     make([]int, len(post.Poll.Options)),
+		ViewerVote: -1,
+	}
+	for userID, option := range db.PollVotes[post.ID] {
+		if option < len(results.Counts) {
+			results.Counts[option]++
+			results.TotalVotes++
+		}
+		if userID == viewerID {
+			results.ViewerVote = option
+		}
+	}
+
+	return results
+}
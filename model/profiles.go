@@ -0,0 +1,230 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	MaxDisplayName = 50
+	MaxBio         = 160
+	MaxLocation    = 30
+	MaxProfileURL  = 200
+)
+
+var (
+	ErrInvalidUsername  = errors.New("usernames are 3 to 15 letters, digits or underscores")
+	ErrUsernameTaken    = errors.New("username is already taken")
+	ErrUsernameReserved = errors.New("username is reserved")
+)
+
+var usernamePattern = regexp.MustCompile(`^[A-Za-z0-9_]{3,15}$`)
+
+// reservedUsernames can't be registered, so they never clash with routes,
+// staff accounts or placeholder values. Compared lowercased.
+var reservedUsernames = map[string]bool{
+	"about": true, "admin": true, "administrator": true, "api": true,
+	"explore": true, "feed": true, "graphql": true, "help": true,
+	"home": true, "login": true, "logout": true, "media": true,
+	"moderator": true, "null": true, "paper": true, "papersocial": true,
+	"root": true, "search": true, "settings": true, "signup": true,
+	"support": true, "system": true, "undefined": true,
+}
+
+// Profile is the public, user-editable part of an account
+type Profile struct {
+	DisplayName string `json:"displayName"`
+	Bio         string `json:"bio"`
+	AvatarURL   string `json:"avatarUrl"`
+	Location    string `json:"location"`
+	Website     string `json:"website"`
+}
+
+// ProfileUpdate changes the fields of a profile that are set, leaving the
+// nil ones alone. An empty string clears a field.
+type ProfileUpdate struct {
+	DisplayName *string
+	Bio         *string
+	AvatarURL   *string
+	Location    *string
+	Website     *string
+}
+
+// apply returns p with the update's fields changed
+func (u ProfileUpdate) apply(p Profile) Profile {
+	set := func(field *string, value *string) {
+		if value != nil {
+			*field = *value
+		}
+	}
+	set(&p.DisplayName, u.DisplayName)
+	set(&p.Bio, u.Bio)
+	set(&p.AvatarURL, u.AvatarURL)
+	set(&p.Location, u.Location)
+	set(&p.Website, u.Website)
+	return p
+}
+
+// validate trims the profile's fields and checks their lengths and URLs.
+// Lengths of text fields are in characters, as users see them.
+func (p *Profile) validate() error {
+	p.DisplayName = strings.TrimSpace(p.DisplayName)
+	p.Bio = strings.TrimSpace(p.Bio)
+	p.AvatarURL = strings.TrimSpace(p.AvatarURL)
+	p.Location = strings.TrimSpace(p.Location)
+	p.Website = strings.TrimSpace(p.Website)
+
+	if utf8.RuneCountInString(p.DisplayName) > MaxDisplayName {
+		return fmt.Errorf("display name is longer than %d characters", MaxDisplayName)
+	}
+	if utf8.RuneCountInString(p.Bio) > MaxBio {
+		return fmt.Errorf("bio is longer than %d characters", MaxBio)
+	}
+	if utf8.RuneCountInString(p.Location) > MaxLocation {
+		return fmt.Errorf("location is longer than %d characters", MaxLocation)
+	}
+	if err := validateProfileURL("avatar URL", p.AvatarURL); err != nil {
+		return err
+	}
+	return validateProfileURL("website", p.Website)
+}
+
+// validateProfileURL accepts an empty value or an absolute http(s) URL
+func validateProfileURL(name, value string) error {
+	if value == "" {
+		return nil
+	}
+	if len(value) > MaxProfileURL {
+		return fmt.Errorf("%s is longer than %d bytes", name, MaxProfileURL)
+	}
+
+	u, err := url.Parse(value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%s must be an http or https URL", name)
+	}
+	return nil
+}
+
+// validUsername checks the format of a new username and that it isn't
+// reserved
+func validUsername(username string) error {
+	if !usernamePattern.MatchString(username) {
+		return ErrInvalidUsername
+	}
+	if reservedUsernames[strings.ToLower(username)] {
+		return ErrUsernameReserved
+	}
+	return nil
+}
+
+// CreateUser signs up a new account. Usernames are unique ignoring case,
+// but keep the case they were registered with.
+func (db *Database) CreateUser(username string, profile Profile) (*User, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	username = strings.TrimPrefix(strings.TrimSpace(username), "@")
+	if err := validUsername(username); err != nil {
+		return nil, err
+	}
+	if _, taken := db.Usernames[strings.ToLower(username)]; taken {
+		return nil, ErrUsernameTaken
+	}
+	if err := profile.validate(); err != nil {
+		return nil, err
+	}
+
+	user := &User{
+		ID:        fmt.Sprintf("user%d", db.NextUserID),
+		Username:  username,
+		CreatedAt: time.Now(),
+		Profile:   profile,
+	}
+	db.NextUserID++
+
+	db.Users[user.ID] = user
+	db.Usernames[strings.ToLower(username)] = user.ID
+	db.names.add(user)
+	db.record(Event{Type: EventUserCreated, ActorID: user.ID})
+
+	return user, nil
+}
+
+// UpdateProfile changes the profile fields set in update
+func (db *Database) UpdateProfile(userID string, update ProfileUpdate) (*User, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	user, exists := db.Users[userID]
+	if !exists {
+		return nil, fmt.Errorf("user with ID %s not found", userID)
+	}
+
+	profile := update.apply(user.Profile)
+	if err := profile.validate(); err != nil {
+		return nil, err
+	}
+
+	updated := *user
+	updated.Profile = profile
+	db.Users[userID] = &updated
+	db.names.remove(user)
+	db.names.add(&updated)
+	db.record(Event{Type: EventProfileUpdated, ActorID: userID})
+
+	return &updated, nil
+}
+
+// GetUserByUsername looks up a user by username, ignoring case and a
+// leading @
+func (db *Database) GetUserByUsername(username string) *User {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	id, exists := db.Usernames[strings.ToLower(strings.TrimPrefix(username, "@"))]
+	if !exists {
+		return nil
+	}
+	return db.Users[id]
+}
+
+// GetUsersByIDs looks up several users at once. Unknown IDs are skipped.
+func (db *Database) GetUsersByIDs(ids []string) []*User {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	users := make([]*User, 0, len(ids))
+	for _, id := range ids {
+		if u, exists := db.Users[id]; exists {
+			users = append(users, u)
+		}
+	}
+	return users
+}
+
+// UserStats are the counts shown on a profile
+type UserStats struct {
+	Followers int
+	Following int
+	Posts     int // Published posts
+}
+
+// GetUserStats returns the profile counts of a user
+func (db *Database) GetUserStats(userID string) UserStats {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	stats := UserStats{
+		Followers: db.FollowerCounts[userID] + len(db.RemoteFollowers[userID]),
+		Posts:     len(db.Posts[userID]),
+	}
+	if u, exists := db.Users[userID]; exists {
+		stats.Following = len(u.Follows)
+	}
+	return stats
+}
@@ -0,0 +1,265 @@
+package model
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+)
+
+// RankingWindow is how far back posts are considered for the ranked
+// timeline. Older posts have decayed to next to nothing anyway.
+const RankingWindow = 72 * time.Hour
+
+// Candidate is a post that may be shown in a ranked timeline, with the
+// signals it is ranked on
+type Candidate struct {
+	Post *Post
+
+	// FollowedBy counts the accounts the viewer follows that follow the
+	// author. Zero when the viewer follows the author themselves; posts
+	// from the second-degree network have at least one.
+	FollowedBy int
+
+	// Engagement counts other users' likes, replies and reposts of the post
+	Engagement int
+
+	// Affinity counts the viewer's past interactions with the author:
+	// likes, replies and reposts of their posts, votes in their polls,
+	// posts mentioning them, and lists they are on
+	Affinity int
+
+	HasMedia bool // Attachments or image links
+}
+
+// Network reports whether the post comes from the second-degree network
+// rather than an account the viewer follows
+func (c *Candidate) Network() bool {
+	return c.FollowedBy > 0
+}
+
+// Ranker scores timeline candidates; higher scores are shown first. Scores
+// must depend only on the candidate and now, so rankings are reproducible.
+type Ranker interface {
+	Score(c *Candidate, now time.Time) float64
+}
+
+// WeightedRanker scores a candidate by how recent it is, decaying
+// exponentially, scaled up by engagement, affinity and media and down for
+// posts from outside the accounts the viewer follows
+type WeightedRanker struct {
+	HalfLife time.Duration // Time for the recency factor to halve
+
+	EngagementWeight float64 // Per doubling of engagement, roughly
+	AffinityWeight   float64 // Per doubling of interactions with the author, roughly
+	MediaWeight      float64
+
+	// NetworkWeight scales posts from the second-degree network, growing
+	// with how many followed accounts follow the author, up to one
+	NetworkWeight float64
+}
+
+// DefaultRanker returns the ranker used for the "For You" timeline
+func DefaultRanker() *WeightedRanker {
+	return &WeightedRanker{
+		HalfLife:         6 * time.Hour,
+		EngagementWeight: 0.5,
+		AffinityWeight:   1,
+		MediaWeight:      0.3,
+		NetworkWeight:    0.5,
+	}
+}
+
+// Score implements Ranker
+func (r *WeightedRanker) Score(c *Candidate, now time.Time) float64 {
+	age := max(now.Sub(c.Post.CreatedAt), 0)
+	recency := math.Exp2(-float64(age) / float64(r.HalfLife))
+
+	boost := 1 + r.EngagementWeight*math.Log1p(float64(c.Engagement)) +
+		r.AffinityWeight*math.Log1p(float64(c.Affinity))
+	if c.HasMedia {
+		boost += r.MediaWeight
+	}
+
+	score := recency * boost
+	if c.Network() {
+		score *= min(1, r.NetworkWeight*(1+math.Log(float64(c.FollowedBy))))
+	}
+	return score
+}
+
+// RankTimeline orders candidates by ranker's scores at now, best first,
+// and returns the posts of up to limit of them. Ties go to the newer post,
+// then the higher ID, so the same candidates and time always give the
+// same order.
+func RankTimeline(candidates []Candidate, ranker Ranker, now time.Time, limit int) []*Post {
+	type scored struct {
+		post  *Post
+		score float64
+	}
+	ranked := make([]scored, len(candidates))
+	for i := range candidates {
+		ranked[i] = scored{post: candidates[i].Post, score: ranker.Score(&candidates[i], now)}
+	}
+
+	slices.SortFunc(ranked, func(a, b scored) int {
+		switch {
+		case a.score > b.score:
+			return -1
+		case a.score < b.score:
+			return 1
+		case NewerThan(a.post.CreatedAt, a.post.ID, b.post.CreatedAt, b.post.ID):
+			return -1
+		case NewerThan(b.post.CreatedAt, b.post.ID, a.post.CreatedAt, a.post.ID):
+			return 1
+		}
+		return 0
+	})
+
+	posts := make([]*Post, 0, min(limit, len(ranked)))
+	for _, r := range ranked[:min(limit, len(ranked))] {
+		posts = append(posts, r.post)
+	}
+	return posts
+}
+
+// TimelineCandidates gathers the posts viewerID might see in a ranked
+// timeline: those published within RankingWindow of now by the accounts
+// they follow and by the accounts those follow. Like the chronological
+// timeline, muted accounts and posts matching the viewer's mute filters
+// are left out, as is anything the viewer can't see.
+func (db *Database) TimelineCandidates(viewerID string, now time.Time) ([]Candidate, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	viewer, exists := db.Users[viewerID]
+	if !exists {
+		return nil, fmt.Errorf("user with ID %s not found", viewerID)
+	}
+
+	// Followed accounts, then the accounts they follow that the viewer
+	// doesn't, counting how many followed accounts lead to each
+	followedBy := make(map[string]int)
+	for _, id := range viewer.Follows {
+		followedBy[id] = 0
+	}
+	for _, friendID := range viewer.Follows {
+		friend, exists := db.Users[friendID]
+		if !exists {
+			continue
+		}
+		for _, id := range friend.Follows {
+			if n, seen := followedBy[id]; id != viewerID && (!seen || n > 0) {
+				followedBy[id]++
+			}
+		}
+	}
+
+	muted := NewMuteMatcher(db.MuteFilters[viewerID], now)
+	affinity := db.interactionsWith(viewer)
+	since := now.Add(-RankingWindow)
+
+	var candidates []Candidate
+	for authorID, n := range followedBy {
+		if expiresAt, isMuted := db.Mutes[viewerID][authorID]; isMuted && muteActive(expiresAt, now) {
+			continue
+		}
+		for _, p := range db.Posts[authorID] {
+			if p.CreatedAt.Before(since) || p.CreatedAt.After(now) || !db.canView(viewerID, p) {
+				continue
+			}
+			if muted.Matches(p.Content, postHashtags(p)) {
+				continue
+			}
+			candidates = append(candidates, Candidate{
+				Post:       p,
+				FollowedBy: n,
+				Engagement: db.engagement(p),
+				Affinity:   affinity[authorID],
+				HasMedia:   len(p.Media) > 0 || p.ContainsImages(),
+			})
+		}
+	}
+	return candidates, nil
+}
+
+// engagement counts other users' likes, replies and reposts of post; the
+// author replying in their own thread doesn't count. Must be called with
+// mu held.
+func (db *Database) engagement(post *Post) int {
+	n := 0
+	for _, userID := range db.Likes[post.ID] {
+		if userID != post.UserID {
+			n++
+		}
+	}
+	for _, replyID := range db.Replies[post.ID] {
+		if reply, exists := db.PostsByID[replyID]; exists && reply.UserID != post.UserID {
+			n++
+		}
+	}
+	for _, userID := range db.Reposts[post.ID] {
+		if userID != post.UserID {
+			n++
+		}
+	}
+	return n
+}
+
+// interactionsWith counts viewer's interactions with each account: likes,
+// reposts and votes in the polls of their posts, published replies to and
+// posts mentioning them, and lists of the viewer's they are on. Must be
+// called with mu held.
+func (db *Database) interactionsWith(viewer *User) map[string]int {
+	counts := make(map[string]int)
+	interacted := func(postID string) {
+		if p, exists := db.PostsByID[postID]; exists && p.UserID != viewer.ID {
+			counts[p.UserID]++
+		}
+	}
+	for postID, votes := range db.PollVotes {
+		if _, voted := votes[viewer.ID]; voted {
+			interacted(postID)
+		}
+	}
+	for _, reactions := range []map[string][]string{db.Likes, db.Reposts} {
+		for postID, userIDs := range reactions {
+			if containsID(userIDs, viewer.ID) {
+				interacted(postID)
+			}
+		}
+	}
+	for _, p := range db.Posts[viewer.ID] {
+		if p.ReplyToID != "" {
+			interacted(p.ReplyToID)
+		}
+		for _, e := range p.Entities {
+			if e.Type != EntityMention {
+				continue
+			}
+			if id, exists := db.Usernames[strings.ToLower(e.Value)]; exists && id != viewer.ID {
+				counts[id]++
+			}
+		}
+	}
+	for _, listID := range db.UserLists[viewer.ID] {
+		if list, exists := db.Lists[listID]; exists {
+			for _, id := range list.MemberIDs {
+				counts[id]++
+			}
+		}
+	}
+	return counts
+}
+
+// postHashtags returns the hashtag values of a post, without the #
+func postHashtags(post *Post) []string {
+	var tags []string
+	for _, e := range post.Entities {
+		if e.Type == EntityHashtag {
+			tags = append(tags, e.Value)
+		}
+	}
+	return tags
+}
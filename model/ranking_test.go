@@ -0,0 +1,290 @@
+package model
+
+import (
+	"maps"
+	"math"
+	"slices"
+	"testing"
+	"time"
+)
+
+// rankingNow is the fixed time rankings are tested at
+var rankingNow = time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+func TestWeightedRankerScore(t *testing.T) {
+	r := DefaultRanker()
+	post := func(age time.Duration) *Post {
+		return &Post{ID: "post1", CreatedAt: rankingNow.Add(-age)}
+	}
+
+	tests := []struct {
+		name string
+		c    Candidate
+		want float64
+	}{
+		{"new", Candidate{Post: post(0)}, 1},
+		{"one half-life old", Candidate{Post: post(r.HalfLife)}, 0.5},
+		{"two half-lives old", Candidate{Post: post(2 * r.HalfLife)}, 0.25},
+		{"future-dated counts as new", Candidate{Post: post(-time.Hour)}, 1},
+		{"media", Candidate{Post: post(0), HasMedia: true}, 1 + r.MediaWeight},
+		{"one reaction", Candidate{Post: post(0), Engagement: 1}, 1 + r.EngagementWeight*math.Ln2},
+		{"three reactions", Candidate{Post: post(0), Engagement: 3}, 1 + 2*r.EngagementWeight*math.Ln2},
+		{"one interaction", Candidate{Post: post(0), Affinity: 1}, 1 + r.AffinityWeight*math.Ln2},
+		{"boosts add up", Candidate{Post: post(r.HalfLife), Engagement: 1, Affinity: 1, HasMedia: true},
+			0.5 * (1 + r.EngagementWeight*math.Ln2 + r.AffinityWeight*math.Ln2 + r.MediaWeight)},
+		{"followed by one friend", Candidate{Post: post(0), FollowedBy: 1}, r.NetworkWeight},
+		{"followed by two friends", Candidate{Post: post(0), FollowedBy: 2}, r.NetworkWeight * (1 + math.Ln2)},
+		{"network scaling is capped", Candidate{Post: post(0), FollowedBy: 10}, 1},
+		{"network scales boosts", Candidate{Post: post(r.HalfLife), FollowedBy: 1, HasMedia: true}, 0.5 * (1 + r.MediaWeight) * r.NetworkWeight},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := r.Score(&tt.c, rankingNow); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("Score() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// constantRanker scores every candidate the same, leaving the order to the
+// tie-breaks
+type constantRanker struct{}
+
+func (constantRanker) Score(c *Candidate, now time.Time) float64 { return 1 }
+
+// postIDs returns the IDs of posts, in order
+func postIDs(posts []*Post) []string {
+	ids := make([]string, len(posts))
+	for i, p := range posts {
+		ids[i] = p.ID
+	}
+	return ids
+}
+
+func TestRankTimeline(t *testing.T) {
+	candidate := func(id string, age time.Duration, c Candidate) Candidate {
+		c.Post = &Post{ID: id, CreatedAt: rankingNow.Add(-age)}
+		return c
+	}
+
+	tests := []struct {
+		name       string
+		ranker     Ranker
+		candidates []Candidate
+		limit      int
+		want       []string
+	}{
+		{
+			name:   "affinity outranks engagement outranks media",
+			ranker: DefaultRanker(),
+			candidates: []Candidate{
+				candidate("plain", 0, Candidate{}),
+				candidate("media", 0, Candidate{HasMedia: true}),
+				candidate("engagement", 0, Candidate{Engagement: 1}),
+				candidate("affinity", 0, Candidate{Affinity: 1}),
+			},
+			limit: 10,
+			want:  []string{"affinity", "engagement", "media", "plain"},
+		},
+		{
+			name:   "boosts can outweigh recency",
+			ranker: DefaultRanker(),
+			candidates: []Candidate{
+				candidate("new", 0, Candidate{}),
+				candidate("popular", time.Hour, Candidate{Engagement: 20, Affinity: 3}),
+				candidate("old", 24*time.Hour, Candidate{Engagement: 20, Affinity: 3}),
+			},
+			limit: 10,
+			want:  []string{"popular", "new", "old"},
+		},
+		{
+			name:   "second-degree posts are scaled down",
+			ranker: DefaultRanker(),
+			candidates: []Candidate{
+				candidate("network", 0, Candidate{FollowedBy: 1}),
+				candidate("followed", time.Hour, Candidate{}),
+				candidate("well-connected", 0, Candidate{FollowedBy: 5}),
+			},
+			limit: 10,
+			want:  []string{"well-connected", "followed", "network"},
+		},
+		{
+			name:   "ties go to the newer post, then the higher ID",
+			ranker: constantRanker{},
+			candidates: []Candidate{
+				candidate("post1", time.Hour, Candidate{}),
+				candidate("post2", 0, Candidate{}),
+				candidate("post4", time.Minute, Candidate{}),
+				candidate("post3", time.Minute, Candidate{}),
+			},
+			limit: 10,
+			want:  []string{"post2", "post4", "post3", "post1"},
+		},
+		{
+			name:   "limit",
+			ranker: constantRanker{},
+			candidates: []Candidate{
+				candidate("post1", time.Hour, Candidate{}),
+				candidate("post2", 0, Candidate{}),
+				candidate("post3", time.Minute, Candidate{}),
+			},
+			limit: 2,
+			want:  []string{"post2", "post3"},
+		},
+		{
+			name:   "no candidates",
+			ranker: DefaultRanker(),
+			limit:  10,
+			want:   []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The order doesn't depend on the order of the candidates
+			reversed := slices.Clone(tt.candidates)
+			slices.Reverse(reversed)
+			for _, candidates := range [][]Candidate{tt.candidates, reversed} {
+				if got := postIDs(RankTimeline(candidates, tt.ranker, rankingNow, tt.limit)); !slices.Equal(got, tt.want) {
+					t.Errorf("RankTimeline() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+// newRankingDatabase returns the mock database with its posts, which are
+// dated by the clock, replaced by ones dated around rankingNow, added with
+// the returned function
+func newRankingDatabase() (*Database, func(id, userID, content string, age time.Duration, visibility Visibility)) {
+	db := NewDatabase()
+	db.Posts = make(map[string][]*Post)
+	db.PostsByID = make(map[string]*Post)
+	add := func(id, userID, content string, age time.Duration, visibility Visibility) {
+		p := &Post{
+			ID:         id,
+			UserID:     userID,
+			Content:    content,
+			Entities:   ParseEntities(content),
+			CreatedAt:  rankingNow.Add(-age),
+			Status:     PostPublished,
+			Visibility: visibility,
+		}
+		db.Posts[userID] = append(db.Posts[userID], p)
+		db.PostsByID[id] = p
+	}
+	return db, add
+}
+
+func TestTimelineCandidates(t *testing.T) {
+	db, add := newRankingDatabase()
+
+	// Alice follows Bob, Charlie and Dave; Bob and Dave follow Eve
+	add("bob-recent", "user2", "Look: https://example.com/cat.png", time.Hour, VisibilityPublic)
+	add("bob-edge", "user2", "Just inside the window", RankingWindow, VisibilityPublic)
+	add("bob-old", "user2", "Too old", RankingWindow+time.Minute, VisibilityPublic)
+	add("bob-future", "user2", "Not yet", -time.Minute, VisibilityPublic)
+	add("charlie-muted-word", "user3", "Spoilers ahead", time.Hour, VisibilityPublic)
+	add("charlie-recent", "user3", "Hello", time.Hour, VisibilityPublic)
+	add("dave-muted", "user4", "Dave is muted", time.Hour, VisibilityPublic)
+	add("eve-recent", "user5", "From the network", 2*time.Hour, VisibilityPublic)
+	add("eve-followers", "user5", "For Eve's followers", 2*time.Hour, VisibilityFollowers)
+	add("alice-own", "user1", "My own post", time.Hour, VisibilityPublic)
+
+	// Charlie liked and replied to Bob's post, Dave reposted it, and Bob
+	// liked it and replied in his own thread; only the first three count.
+	// Votes in its poll aren't engagement. Alice liked Eve's post, so
+	// likes count towards affinity.
+	db.Likes["bob-recent"] = []string{"user2", "user3"}
+	db.Reposts["bob-recent"] = []string{"user4"}
+	add("charlie-reply", "user3", "Cute", RankingWindow+time.Hour, VisibilityPublic)
+	add("bob-reply", "user2", "Thanks", RankingWindow+time.Hour, VisibilityPublic)
+	db.Replies["bob-recent"] = []string{"charlie-reply", "bob-reply"}
+	db.PollVotes["bob-recent"] = map[string]int{"user3": 0, "user4": 1}
+	db.Likes["eve-recent"] = []string{"user1"}
+
+	if _, err := db.MuteUser("user1", "user4", time.Time{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.AddMuteFilter("user1", MuteWord, "spoilers", time.Time{}); err != nil {
+		t.Fatal(err)
+	}
+
+	candidates, err := db.TimelineCandidates("user1", rankingNow)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := make(map[string]Candidate)
+	for _, c := range candidates {
+		got[c.Post.ID] = c
+	}
+	want := map[string]Candidate{
+		"bob-recent":     {Engagement: 3, HasMedia: true},
+		"bob-edge":       {},
+		"charlie-recent": {},
+		"eve-recent":     {FollowedBy: 2, Engagement: 1, Affinity: 1},
+	}
+	if len(got) != len(want) {
+		t.Errorf("candidates = %v, want %v", slices.Sorted(maps.Keys(got)), slices.Sorted(maps.Keys(want)))
+	}
+	for id, w := range want {
+		c, ok := got[id]
+		if !ok {
+			t.Errorf("%s is not a candidate", id)
+			continue
+		}
+		if c.FollowedBy != w.FollowedBy || c.Engagement != w.Engagement || c.Affinity != w.Affinity || c.HasMedia != w.HasMedia {
+			t.Errorf("%s: got %+v, want %+v", id, c, w)
+		}
+	}
+
+	if _, err := db.TimelineCandidates("nobody", rankingNow); err == nil {
+		t.Error("TimelineCandidates() for an unknown user succeeded")
+	}
+}
+
+func TestEngagementChangesRanking(t *testing.T) {
+	db, add := newRankingDatabase()
+
+	// Alice follows Bob and Charlie. Charlie's post is newer, so it leads
+	// until Bob's gathers likes, a reply and a repost.
+	add("bob-older", "user2", "Anyone up for a hike?", 3*time.Hour, VisibilityPublic)
+	add("charlie-newer", "user3", "Coffee time", time.Hour, VisibilityPublic)
+
+	rank := func() []string {
+		t.Helper()
+		candidates, err := db.TimelineCandidates("user1", rankingNow)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return postIDs(RankTimeline(candidates, DefaultRanker(), rankingNow, 10))
+	}
+	if got, want := rank(), []string{"charlie-newer", "bob-older"}; !slices.Equal(got, want) {
+		t.Fatalf("ranking without engagement = %v, want %v", got, want)
+	}
+
+	// Bob's own like doesn't count, so on its own it changes nothing
+	if _, err := db.LikePost("user2", "bob-older"); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := rank(), []string{"charlie-newer", "bob-older"}; !slices.Equal(got, want) {
+		t.Fatalf("ranking with the author's like = %v, want %v", got, want)
+	}
+
+	// The reply is dated by the clock, after rankingNow, so it isn't a
+	// candidate itself
+	for _, userID := range []string{"user4", "user5"} {
+		if _, err := db.LikePost(userID, "bob-older"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := db.RepostPost("user4", "bob-older"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.CreatePost("user5", "Count me in", PostOptions{ReplyToID: "bob-older"}); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := rank(), []string{"bob-older", "charlie-newer"}; !slices.Equal(got, want) {
+		t.Errorf("ranking with engagement = %v, want %v", got, want)
+	}
+}
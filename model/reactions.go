@@ -0,0 +1,160 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrAlreadyLiked    = errors.New("user has already liked this post")
+	ErrNotLiked        = errors.New("user has not liked this post")
+	ErrAlreadyReposted = errors.New("user has already reposted this post")
+	ErrNotReposted     = errors.New("user has not reposted this post")
+	ErrRepostNotPublic = errors.New("only public posts can be reposted")
+)
+
+// Reactions are the likes, replies and reposts of a post as seen by one
+// viewer
+type Reactions struct {
+	Likes          int
+	Replies        int
+	Reposts        int
+	ViewerLiked    bool
+	ViewerReposted bool
+}
+
+// LikePost records userID liking a post they can see. Each user can like
+// a post once.
+func (db *Database) LikePost(userID, postID string) (*Post, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	post, err := db.reactionTarget(userID, postID)
+	if err != nil {
+		return nil, err
+	}
+	if containsID(db.Likes[postID], userID) {
+		return nil, ErrAlreadyLiked
+	}
+
+	db.Likes[postID] = append(db.Likes[postID], userID)
+	db.record(Event{Type: EventPostLiked, ActorID: userID, SubjectID: post.UserID, PostID: postID, Post: post})
+	return post, nil
+}
+
+// UnlikePost takes back userID's like of a post
+func (db *Database) UnlikePost(userID, postID string) (*Post, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	post, err := db.reactionTarget(userID, postID)
+	if err != nil {
+		return nil, err
+	}
+	if !containsID(db.Likes[postID], userID) {
+		return nil, ErrNotLiked
+	}
+
+	db.Likes[postID] = removeID(db.Likes[postID], userID)
+	db.record(Event{Type: EventPostUnliked, ActorID: userID, SubjectID: post.UserID, PostID: postID, Post: post})
+	return post, nil
+}
+
+// RepostPost records userID reposting a post they can see. Only public
+// posts can be reposted, since a repost shares the post further than its
+// author chose to.
+func (db *Database) RepostPost(userID, postID string) (*Post, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	post, err := db.reactionTarget(userID, postID)
+	if err != nil {
+		return nil, err
+	}
+	if author := db.Users[post.UserID]; post.Visibility != VisibilityPublic || author == nil || author.Protected {
+		return nil, ErrRepostNotPublic
+	}
+	if containsID(db.Reposts[postID], userID) {
+		return nil, ErrAlreadyReposted
+	}
+
+	db.Reposts[postID] = append(db.Reposts[postID], userID)
+	db.record(Event{Type: EventPostReposted, ActorID: userID, SubjectID: post.UserID, PostID: postID, Post: post})
+	return post, nil
+}
+
+// UndoRepost takes back userID's repost of a post
+func (db *Database) UndoRepost(userID, postID string) (*Post, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	post, err := db.reactionTarget(userID, postID)
+	if err != nil {
+		return nil, err
+	}
+	if !containsID(db.Reposts[postID], userID) {
+		return nil, ErrNotReposted
+	}
+
+	db.Reposts[postID] = removeID(db.Reposts[postID], userID)
+	db.record(Event{Type: EventPostUnreposted, ActorID: userID, SubjectID: post.UserID, PostID: postID, Post: post})
+	return post, nil
+}
+
+// GetReactions counts the reactions to a post for a viewer
+func (db *Database) GetReactions(post *Post, viewerID string) *Reactions {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	return &Reactions{
+		Likes:          len(db.Likes[post.ID]),
+		Replies:        len(db.Replies[post.ID]),
+		Reposts:        len(db.Reposts[post.ID]),
+		ViewerLiked:    containsID(db.Likes[post.ID], viewerID),
+		ViewerReposted: containsID(db.Reposts[post.ID], viewerID),
+	}
+}
+
+// reactionTarget returns a published post userID may like, repost or
+// reply to. Must be called with mu held.
+func (db *Database) reactionTarget(userID, postID string) (*Post, error) {
+	if _, exists := db.Users[userID]; !exists {
+		return nil, fmt.Errorf("user with ID %s not found", userID)
+	}
+	post, exists := db.PostsByID[postID]
+	if !exists || !db.canView(userID, post) {
+		return nil, fmt.Errorf("post with ID %s not found", postID)
+	}
+	return post, nil
+}
+
+// addReply links a newly published reply to the post it replies to and
+// records the reply. A draft replying to a post that was deleted, or
+// hidden from its author, in the meantime is published as a post of its
+// own. Must be called with mu held for writing, before the reply is
+// returned.
+func (db *Database) addReply(reply *Post) {
+	if reply.ReplyToID == "" {
+		return
+	}
+	parent, err := db.reactionTarget(reply.UserID, reply.ReplyToID)
+	if err != nil {
+		reply.ReplyToID = ""
+		return
+	}
+
+	db.Replies[parent.ID] = append(db.Replies[parent.ID], reply.ID)
+	db.record(Event{Type: EventPostReplied, ActorID: reply.UserID, SubjectID: parent.UserID, PostID: parent.ID, Post: parent, OccurredAt: reply.CreatedAt})
+}
+
+// removeReactions drops the reactions to a deleted post, and the post from
+// the replies of the post it replied to. Must be called with mu held for
+// writing.
+func (db *Database) removeReactions(post *Post) {
+	delete(db.Likes, post.ID)
+	delete(db.Reposts, post.ID)
+	delete(db.Replies, post.ID)
+	if post.ReplyToID != "" {
+		db.Replies[post.ReplyToID] = removeID(db.Replies[post.ReplyToID], post.ID)
+	}
+}
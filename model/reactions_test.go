@@ -0,0 +1,77 @@
+package model
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestReactions(t *testing.T) {
+	db := NewDatabase()
+
+	public, err := db.CreatePost("user1", "Morning all", PostOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	followers, err := db.CreatePost("user1", "Followers only", PostOptions{Visibility: VisibilityFollowers})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := db.LikePost("user2", public.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.LikePost("user2", public.ID); !errors.Is(err, ErrAlreadyLiked) {
+		t.Errorf("liking twice = %v, want %v", err, ErrAlreadyLiked)
+	}
+	if _, err := db.UnlikePost("user3", public.ID); !errors.Is(err, ErrNotLiked) {
+		t.Errorf("unliking an unliked post = %v, want %v", err, ErrNotLiked)
+	}
+	// Everyone follows Alice, so Charlie unfollows her to have a post he
+	// can't see
+	if err := db.UnfollowUser("user3", "user1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.LikePost("user3", followers.ID); err == nil {
+		t.Error("liked a followers-only post of an account the user doesn't follow")
+	}
+	if _, err := db.RepostPost("user2", followers.ID); !errors.Is(err, ErrRepostNotPublic) {
+		t.Errorf("reposting a followers-only post = %v, want %v", err, ErrRepostNotPublic)
+	}
+	if _, err := db.RepostPost("user2", public.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.RepostPost("user2", public.ID); !errors.Is(err, ErrAlreadyReposted) {
+		t.Errorf("reposting twice = %v, want %v", err, ErrAlreadyReposted)
+	}
+
+	reply, err := db.CreatePost("user3", "Morning!", PostOptions{ReplyToID: public.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reply.ReplyToID != public.ID {
+		t.Errorf("reply.ReplyToID = %q, want %q", reply.ReplyToID, public.ID)
+	}
+	if _, err := db.CreatePost("user3", "Hidden", PostOptions{ReplyToID: followers.ID}); err == nil {
+		t.Error("replied to a post hidden from the user")
+	}
+
+	want := Reactions{Likes: 1, Replies: 1, Reposts: 1, ViewerLiked: true, ViewerReposted: true}
+	if got := db.GetReactions(public, "user2"); *got != want {
+		t.Errorf("reactions seen by user2 = %+v, want %+v", *got, want)
+	}
+
+	// Deleting the reply takes it out of the count; undoing takes the
+	// viewer's reactions back
+	if _, err := db.DeletePost(reply.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.UnlikePost("user2", public.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.UndoRepost("user2", public.ID); err != nil {
+		t.Fatal(err)
+	}
+	if got := db.GetReactions(public, "user2"); *got != (Reactions{}) {
+		t.Errorf("reactions after undoing = %+v, want none", *got)
+	}
+}
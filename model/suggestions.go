@@ -0,0 +1,145 @@
+package model
+
+import (
+	"sort"
+	"time"
+)
+
+// Weights of the signals that rank suggested users
+const (
+	suggestFriendWeight   = 3.0 // Per followed account that follows the candidate
+	suggestMutualWeight   = 2.0 // Per follower who also follows the candidate
+	suggestActivityWeight = 0.5 // Per recent post, up to suggestMaxRecentPosts
+	suggestMaxRecentPosts = 5
+
+	// SuggestActivityWindow is how far back posts count as recent activity
+	SuggestActivityWindow = 7 * 24 * time.Hour
+)
+
+// Suggestion is an account suggested for a user to follow, with the signals
+// it was ranked on
+type Suggestion struct {
+	User             *User
+	FriendsFollowing int // Accounts the user follows that follow this one
+	MutualFollowers  int // The user's followers that follow this one
+	RecentPosts      int // Posts published within SuggestActivityWindow
+	Score            float64
+}
+
+// SuggestUsers ranks the accounts userID might want to follow, best first,
+// returning at most limit. Candidates come from the follow graph: accounts
+// followed by the people userID follows, and by userID's followers. Accounts
+// userID already follows or has asked to follow, and accounts blocked either
+// way or muted, are never suggested. Recent activity only breaks ties
+// between accounts with a social connection.
+func (db *Database) SuggestUsers(userID string, limit int, now time.Time) []Suggestion {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	user, exists := db.Users[userID]
+	if !exists {
+		return nil
+	}
+
+	candidates := make(map[string]*Suggestion)
+	candidate := func(id string) *Suggestion {
+		c, seen := candidates[id]
+		if !seen {
+			c = &Suggestion{User: db.Users[id]}
+			candidates[id] = c
+		}
+		return c
+	}
+
+	// Friends of friends
+	for _, friendID := range user.Follows {
+		if friend, exists := db.Users[friendID]; exists {
+			for _, id := range friend.Follows {
+				candidate(id).FriendsFollowing++
+			}
+		}
+	}
+
+	// Accounts followed by the user's followers
+	for _, follower := range db.Users {
+		if follower.ID == userID || !follows(follower, userID) {
+			continue
+		}
+		for _, id := range follower.Follows {
+			candidate(id).MutualFollowers++
+		}
+	}
+
+	suggestions := make([]Suggestion, 0, len(candidates))
+	for id, c := range candidates {
+		if c.User == nil || !db.suggestable(user, id, now) {
+			continue
+		}
+
+		c.RecentPosts = db.recentPostCount(id, now)
+		c.Score = suggestFriendWeight*float64(c.FriendsFollowing) +
+			suggestMutualWeight*float64(c.MutualFollowers) +
+			suggestActivityWeight*float64(min(c.RecentPosts, suggestMaxRecentPosts))
+		suggestions = append(suggestions, *c)
+	}
+
+	sort.Slice(suggestions, func(i, j int) bool {
+		if suggestions[i].Score != suggestions[j].Score {
+			return suggestions[i].Score > suggestions[j].Score
+		}
+		return suggestions[i].User.Username < suggestions[j].User.Username
+	})
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	return suggestions
+}
+
+// FilterSuggestions drops suggestions that no longer apply to userID, such
+// as accounts followed or blocked since they were computed
+func (db *Database) FilterSuggestions(userID string, suggestions []Suggestion, now time.Time) []Suggestion {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	user, exists := db.Users[userID]
+	if !exists {
+		return nil
+	}
+
+	filtered := make([]Suggestion, 0, len(suggestions))
+	for _, s := range suggestions {
+		if db.suggestable(user, s.User.ID, now) {
+			filtered = append(filtered, s)
+		}
+	}
+	return filtered
+}
+
+// suggestable must be called with mu held
+func (db *Database) suggestable(user *User, candidateID string, now time.Time) bool {
+	if candidateID == user.ID || follows(user, candidateID) {
+		return false
+	}
+	if containsID(db.FollowRequests[candidateID], user.ID) {
+		return false
+	}
+	if db.blockedEitherWay(user.ID, candidateID) {
+		return false
+	}
+	expiresAt, muted := db.Mutes[user.ID][candidateID]
+	return !muted || !muteActive(expiresAt, now)
+}
+
+// recentPostCount counts a user's posts published within
+// SuggestActivityWindow of now. Must be called with mu held.
+func (db *Database) recentPostCount(userID string, now time.Time) int {
+	since := now.Add(-SuggestActivityWindow)
+
+	count := 0
+	for _, p := range db.Posts[userID] {
+		if p.CreatedAt.After(since) {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,248 @@
+package model
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// nameKind says which part of a user a name in the trie comes from
+type nameKind int
+
+const (
+	nameUsername nameKind = iota
+	nameDisplayName
+)
+
+// nameEntry is a user whose name ends at a trie node
+type nameEntry struct {
+	userID string
+	kind   nameKind
+}
+
+// nameTrie indexes users by the folded runes of their usernames and
+// display names, for prefix search. Display names are indexed whole and
+// from each of their later words, so "Jane Smith" is found by "smi".
+type nameTrie struct {
+	root *trieNode
+}
+
+// trieNode is a node of a nameTrie, reached by the runes of a name prefix
+type trieNode struct {
+	children map[rune]*trieNode
+	entries  []nameEntry
+}
+
+func newNameTrie() *nameTrie {
+	return &nameTrie{root: &trieNode{}}
+}
+
+// userName is a name a user is found by
+type userName struct {
+	name string
+	kind nameKind
+}
+
+// foldName lowercases s and strips its accents, so "José" is found by
+// "jose". Letters that aren't an accented form of another, like "ø" or
+// "ß", are kept.
+func foldName(s string) string {
+	stripped, _, err := transform.String(transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC), s)
+	if err != nil {
+		stripped = s
+	}
+	return strings.ToLower(stripped)
+}
+
+// userNames returns the names user is found by, folded
+func userNames(user *User) []userName {
+	names := []userName{{name: foldName(user.Username), kind: nameUsername}}
+	words := strings.Fields(foldName(user.DisplayName))
+	for i := range words {
+		names = append(names, userName{name: strings.Join(words[i:], " "), kind: nameDisplayName})
+	}
+	return names
+}
+
+// add indexes user's names
+func (t *nameTrie) add(user *User) {
+	for _, name := range userNames(user) {
+		node := t.root
+		for _, r := range name.name {
+			child, exists := node.children[r]
+			if !exists {
+				if node.children == nil {
+					node.children = make(map[rune]*trieNode)
+				}
+				child = &trieNode{}
+				node.children[r] = child
+			}
+			node = child
+		}
+		entry := nameEntry{userID: user.ID, kind: name.kind}
+		if !slices.Contains(node.entries, entry) {
+			node.entries = append(node.entries, entry)
+		}
+	}
+}
+
+// remove drops user's names, as they were when added. Nodes left without
+// names below them are pruned.
+func (t *nameTrie) remove(user *User) {
+	for _, name := range userNames(user) {
+		t.root.remove([]rune(name.name), nameEntry{userID: user.ID, kind: name.kind})
+	}
+}
+
+// remove drops entry from the node at the end of name, below n. It
+// reports whether n is left empty.
+func (n *trieNode) remove(name []rune, entry nameEntry) bool {
+	if len(name) == 0 {
+		n.entries = slices.DeleteFunc(n.entries, func(e nameEntry) bool { return e == entry })
+	} else if child, exists := n.children[name[0]]; exists && child.remove(name[1:], entry) {
+		delete(n.children, name[0])
+	}
+	return len(n.entries) == 0 && len(n.children) == 0
+}
+
+// nameMatch is how well a user's names match a search
+type nameMatch struct {
+	edits    int  // Typos between the search and a prefix of the name
+	exact    bool // The search is the whole name
+	username bool // The best match is the username
+}
+
+// better reports whether m ranks above other
+func (m nameMatch) better(other nameMatch) bool {
+	if m.edits != other.edits {
+		return m.edits < other.edits
+	}
+	if m.exact != other.exact {
+		return m.exact
+	}
+	return m.username && !other.username
+}
+
+// search finds users with a name starting with query, allowing up to
+// maxEdits typos: letters missing, extra, wrong or swapped with the next.
+// It walks the trie with a row of the edit distance table per node,
+// leaving branches that are too far off.
+func (t *nameTrie) search(query string, maxEdits int) map[string]nameMatch {
+	q := []rune(query)
+	row := make([]int, len(q)+1)
+	for i := range row {
+		row[i] = i
+	}
+
+	matches := make(map[string]nameMatch)
+	t.root.search(q, 0, row, nil, len(q)+1, maxEdits, matches)
+	return matches
+}
+
+// search visits n, reached by r, given the edit distance rows of its
+// parent and grandparent. best is the fewest edits from the query to the
+// name prefixes on the way to n; once it is small enough, every name below
+// matches.
+func (n *trieNode) search(q []rune, r rune, row, parentRow []int, best int, maxEdits int, matches map[string]nameMatch) {
+	best = min(best, row[len(q)])
+	if best <= maxEdits {
+		for _, e := range n.entries {
+			m := nameMatch{edits: best, exact: row[len(q)] == 0, username: e.kind == nameUsername}
+			if current, found := matches[e.userID]; !found || m.better(current) {
+				matches[e.userID] = m
+			}
+		}
+	} else if slices.Min(row) > maxEdits {
+		return
+	}
+
+	for c, child := range n.children {
+		next := make([]int, len(row))
+		next[0] = row[0] + 1
+		for i := 1; i < len(next); i++ {
+			cost := 1
+			if q[i-1] == c {
+				cost = 0
+			}
+			next[i] = min(row[i]+1, next[i-1]+1, row[i-1]+cost)
+
+			// Two letters swapped
+			if i > 1 && parentRow != nil && q[i-1] == r && q[i-2] == c {
+				next[i] = min(next[i], parentRow[i-2]+1)
+			}
+		}
+		child.search(q, c, next, row, best, maxEdits, matches)
+	}
+}
+
+// typoAllowance is how many typos a search of n letters may contain. Short
+// searches must match exactly, or nearly everything would.
+func typoAllowance(n int) int {
+	switch {
+	case n < 3:
+		return 0
+	case n < 6:
+		return 1
+	}
+	return 2
+}
+
+// SearchUsers finds up to limit users whose username or display name
+// starts with prefix, for @-mention autocomplete. Case, accents and a
+// leading @ are ignored and small typos are tolerated. Accounts viewerID follows come
+// first, then the closest matches, then the most followed. The viewer
+// and users blocked either way are left out.
+func (db *Database) SearchUsers(viewerID string, prefix string, limit int) []*User {
+	prefix = foldName(strings.TrimPrefix(strings.TrimSpace(prefix), "@"))
+	if prefix == "" || limit <= 0 {
+		return nil
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	matches := db.names.search(prefix, typoAllowance(utf8.RuneCountInString(prefix)))
+	viewer := db.Users[viewerID]
+
+	type result struct {
+		user     *User
+		match    nameMatch
+		followed bool
+	}
+	results := make([]result, 0, len(matches))
+	for id, m := range matches {
+		u, exists := db.Users[id]
+		if !exists || id == viewerID || db.blockedEitherWay(viewerID, id) {
+			continue
+		}
+		results = append(results, result{user: u, match: m, followed: viewer != nil && follows(viewer, id)})
+	}
+
+	slices.SortFunc(results, func(a, b result) int {
+		switch {
+		case a.followed != b.followed:
+			if a.followed {
+				return -1
+			}
+			return 1
+		case a.match.better(b.match):
+			return -1
+		case b.match.better(a.match):
+			return 1
+		case db.FollowerCounts[a.user.ID] != db.FollowerCounts[b.user.ID]:
+			return db.FollowerCounts[b.user.ID] - db.FollowerCounts[a.user.ID]
+		}
+		return strings.Compare(strings.ToLower(a.user.Username), strings.ToLower(b.user.Username))
+	})
+
+	users := make([]*User, 0, min(limit, len(results)))
+	for _, r := range results[:min(limit, len(results))] {
+		users = append(users, r.user)
+	}
+	return users
+}
@@ -0,0 +1,171 @@
+package model
+
+import (
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestNameTrieSearch(t *testing.T) {
+	trie := newNameTrie()
+	for _, u := range []*User{
+		{ID: "jo", Username: "jo"},
+		{ID: "jordan", Username: "jordan", Profile: Profile{DisplayName: "Jordan Smith"}},
+		{ID: "jose", Username: "jmartinez", Profile: Profile{DisplayName: "José Martínez"}},
+		{ID: "maria", Username: "MariaK", Profile: Profile{DisplayName: "María Kowalska"}},
+		{ID: "smith", Username: "smithers"},
+	} {
+		trie.add(u)
+	}
+
+	tests := []struct {
+		query    string
+		maxEdits int
+		want     map[string]nameMatch
+	}{
+		// Prefixes, exact names and usernames
+		{"jo", 0, map[string]nameMatch{
+			"jo":     {exact: true, username: true},
+			"jordan": {username: true},
+			"jose":   {},
+		}},
+		{"jordan", 0, map[string]nameMatch{"jordan": {exact: true, username: true}}},
+		{"smi", 0, map[string]nameMatch{"jordan": {}, "smith": {username: true}}},
+		{"jordan smith", 0, map[string]nameMatch{"jordan": {exact: true}}},
+
+		// Case and accents are folded when indexing; queries are folded by
+		// SearchUsers
+		{"mariak", 0, map[string]nameMatch{"maria": {exact: true, username: true}}},
+		{"maria k", 0, map[string]nameMatch{"maria": {}}},
+		{"martinez", 0, map[string]nameMatch{"jose": {exact: true}}},
+
+		// One edit of each kind
+		{"jrdan", 1, map[string]nameMatch{"jordan": {edits: 1, username: true}}},
+		{"jordaan", 1, map[string]nameMatch{"jordan": {edits: 1, username: true}}},
+		{"jorden", 1, map[string]nameMatch{"jordan": {edits: 1, username: true}}},
+		{"jodran", 1, map[string]nameMatch{"jordan": {edits: 1, username: true}}},
+		{"smtih", 1, map[string]nameMatch{"jordan": {edits: 1}, "smith": {edits: 1, username: true}}},
+
+		// The cutoff: one edit too many finds nothing
+		{"jodren", 1, map[string]nameMatch{}},
+		{"jodren", 2, map[string]nameMatch{"jordan": {edits: 2, username: true}}},
+		{"jrdn", 0, map[string]nameMatch{}},
+		{"xyz", 2, map[string]nameMatch{}},
+	}
+	for _, tt := range tests {
+		got := trie.search(tt.query, tt.maxEdits)
+		if !maps.Equal(got, tt.want) {
+			t.Errorf("search(%q, %d) = %+v, want %+v", tt.query, tt.maxEdits, got, tt.want)
+		}
+	}
+
+	// Removed names are no longer found, and emptied branches are pruned
+	trie.remove(&User{ID: "smith", Username: "smithers"})
+	if got := trie.search("smith", 0); !maps.Equal(got, map[string]nameMatch{"jordan": {exact: true}}) {
+		t.Errorf("search(%q) after removing smithers = %+v", "smith", got)
+	}
+	if _, exists := trie.root.children['s'].children['m'].children['i'].children['t'].children['h'].children['e']; exists {
+		t.Error("removing smithers left its branch in the trie")
+	}
+}
+
+func TestTypoAllowance(t *testing.T) {
+	for n, want := range []int{0, 0, 0, 1, 1, 1, 2, 2, 2} {
+		if got := typoAllowance(n); got != want {
+			t.Errorf("typoAllowance(%d) = %d, want %d", n, got, want)
+		}
+	}
+}
+
+func TestSearchUsers(t *testing.T) {
+	db := NewDatabase()
+	ids := make(map[string]string) // Username to ID
+	for _, u := range []struct {
+		username, displayName string
+	}{
+		{"samantha", "Samantha Lee"},
+		{"samuel", "Sam Okafor"},
+		{"sam", "Sam"},
+		{"samwise", "Samwise Gamgee"},
+		{"nperez", "Ñoño Pérez"},
+		{"rdupont", "Rene Dupont"},
+		{"blocker", "Sam Blocker"},
+	} {
+		created, err := db.CreateUser(u.username, Profile{DisplayName: u.displayName})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids[created.Username] = created.ID
+	}
+
+	// Alice follows Samwise; Samuel is followed by two others; the blocker
+	// blocks Alice
+	if _, err := db.FollowUser("user1", ids["samwise"]); err != nil {
+		t.Fatal(err)
+	}
+	for _, follower := range []string{"user2", "user3"} {
+		if _, err := db.FollowUser(follower, ids["samuel"]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := db.BlockUser(ids["blocker"], "user1"); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name, prefix string
+		want         []string
+	}{
+		// Followed first, then the exact name, then the most followed
+		{"prefix ranking", "sam", []string{"samwise", "sam", "samuel", "samantha"}},
+		{"followed accounts come first even with a typo", "samu", []string{"samwise", "samuel", "sam", "samantha"}},
+		{"case and @ are ignored", "@SAMA", []string{"samwise", "samantha", "samuel", "sam"}},
+		{"longer searches narrow it down", "samant", []string{"samantha"}},
+		// Two accents would be more typos than four letters may have
+		{"accents in names are folded", "nono", []string{"nperez"}},
+		{"accents in the search are folded", "Réné", []string{"rdupont"}},
+		{"later words of display names", "pere", []string{"nperez"}},
+
+		// Typos: closer matches rank above further ones
+		{"swapped letters", "smauel", []string{"samuel"}},
+		{"missing letter", "samntha", []string{"samantha"}},
+		{"wrong letter", "sanantha", []string{"samantha"}},
+		{"two typos in a long search", "smanatha", []string{"samantha"}},
+		{"typo matches rank below exact ones", "samwi", []string{"samwise"}},
+		{"short searches must match exactly", "sm", nil},
+		{"two typos allowed in six letters", "xamuxl", []string{"samuel"}},
+		{"too many typos", "xamuxly", nil},
+		{"three typos are too many", "smnthaa", nil},
+
+		// Leaving out the viewer and blocks
+		{"the viewer", "alic", nil},
+		{"blocked either way", "blocker", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, u := range db.SearchUsers("user1", tt.prefix, 10) {
+				got = append(got, u.Username)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("SearchUsers(%q) = %v, want %v", tt.prefix, got, tt.want)
+			}
+		})
+	}
+
+	if got := db.SearchUsers("user1", "sam", 2); len(got) != 2 || got[0].Username != "samwise" {
+		t.Errorf("SearchUsers() with a limit of 2 = %d users", len(got))
+	}
+
+	// Renaming updates the index
+	newName := "Dana Scully"
+	if _, err := db.UpdateProfile(ids["samantha"], ProfileUpdate{DisplayName: &newName}); err != nil {
+		t.Fatal(err)
+	}
+	if got := db.SearchUsers("user1", "scul", 10); len(got) != 1 || got[0].Username != "samantha" {
+		t.Errorf("search for the new display name found %d users", len(got))
+	}
+	if got := db.SearchUsers("user1", "lee", 10); len(got) != 0 {
+		t.Errorf("search for the old display name found %d users", len(got))
+	}
+}
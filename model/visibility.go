@@ -0,0 +1,101 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Visibility controls who can see a published post
+type Visibility string
+
+const (
+	VisibilityPublic    Visibility = "public"
+	VisibilityFollowers Visibility = "followers" // Followers of the author and mentioned users
+	VisibilityMentioned Visibility = "mentioned" // Only users mentioned in the post
+)
+
+// validVisibility normalizes an unset visibility to public
+func validVisibility(v Visibility) (Visibility, error) {
+	switch v {
+	case "":
+		return VisibilityPublic, nil
+	case VisibilityPublic, VisibilityFollowers, VisibilityMentioned:
+		return v, nil
+	}
+	return "", fmt.Errorf("unknown visibility %q", v)
+}
+
+// CanView reports whether viewerID may see post. An empty viewerID is an
+// anonymous viewer and only sees public posts.
+func (db *Database) CanView(viewerID string, post *Post) bool {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	return db.canView(viewerID, post)
+}
+
+// canView is the single visibility check every read path goes through.
+// Must be called with mu held.
+func (db *Database) canView(viewerID string, post *Post) bool {
+	if viewerID != "" && viewerID == post.UserID {
+		return true
+	}
+	if post.Status != PostPublished {
+		return false
+	}
+
+	if db.blockedEitherWay(viewerID, post.UserID) {
+		return false
+	}
+
+	viewer := db.Users[viewerID]
+
+	// Protected accounts are only seen by their followers, whatever the
+	// visibility of the post
+	if author, exists := db.Users[post.UserID]; exists && author.Protected {
+		if viewer == nil || !follows(viewer, post.UserID) {
+			return false
+		}
+	}
+
+	switch post.Visibility {
+	case "", VisibilityPublic:
+		return true
+	}
+
+	if viewer == nil {
+		return false
+	}
+	if mentions(post, viewer) {
+		return true
+	}
+	return post.Visibility == VisibilityFollowers && follows(viewer, post.UserID)
+}
+
+// visiblePosts returns the posts viewerID may see, in their original
+// order. Must be called with mu held.
+func (db *Database) visiblePosts(viewerID string, posts []*Post) []*Post {
+	visible := make([]*Post, 0, len(posts))
+	for _, p := range posts {
+		if db.canView(viewerID, p) {
+			visible = append(visible, p)
+		}
+	}
+	return visible
+}
+
+// mentions reports whether post mentions user. Usernames are matched
+// case-insensitively.
+func mentions(post *Post, user *User) bool {
+	for _, e := range post.Entities {
+		if e.Type == EntityMention && strings.EqualFold(e.Value, user.Username) {
+			return true
+		}
+	}
+	return false
+}
+
+// follows reports whether user follows authorID
+func follows(user *User, authorID string) bool {
+	return containsID(user.Follows, authorID)
+}
@@ -0,0 +1,428 @@
+package model
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const (
+	MaxWebhooksPerUser = 10
+
+	// MaxWebhookDeliveries is how many delivery attempts are logged per
+	// webhook; the oldest are dropped
+	MaxWebhookDeliveries = 100
+
+	// MaxDeadLetters is how many undeliverable events are kept per webhook
+	MaxDeadLetters = 100
+
+	// WebhookDisableAfter is how many events in a row a webhook may fail to
+	// take, after all retries, before it is disabled
+	WebhookDisableAfter = 5
+)
+
+var (
+	ErrWebhookNotFound    = errors.New("webhook not found")
+	ErrDeadLetterNotFound = errors.New("dead letter not found")
+	ErrTooManyWebhooks    = fmt.Errorf("a user can have at most %d webhooks", MaxWebhooksPerUser)
+	ErrWebhookDisabled    = errors.New("webhook is disabled")
+)
+
+// WebhookEvents are the event types webhooks can subscribe to
+var WebhookEvents = []EventType{
+	EventPostCreated,
+	EventPostUpdated,
+	EventPostDeleted,
+	EventUserFollowed,
+	EventUserUnfollowed,
+	EventFollowRequested,
+}
+
+// Webhook is an endpoint a user registered to be sent events about their
+// own posts and follows. Payloads are signed with the webhook's secret.
+type Webhook struct {
+	ID     string
+	UserID string
+	URL    string
+	Secret string
+	Events []EventType
+
+	Active              bool
+	DisabledReason      string // Why the webhook was disabled automatically
+	ConsecutiveFailures int    // Events in a row that couldn't be delivered
+	CreatedAt           time.Time
+}
+
+// WebhookDelivery is one attempt to deliver an event to a webhook
+type WebhookDelivery struct {
+	ID            string
+	WebhookID     string
+	EventSequence int64
+	EventType     EventType
+	Attempt       int // Starting at 1
+	StatusCode    int // Zero if no response was received
+	Error         string
+	Duration      time.Duration
+	At            time.Time
+}
+
+// Succeeded reports whether the endpoint accepted the event
+func (d WebhookDelivery) Succeeded() bool {
+	return d.Error == ""
+}
+
+// DeadLetter is an event a webhook still hadn't taken after all retries.
+// It keeps the payload so it can be redelivered as it was.
+type DeadLetter struct {
+	ID            string
+	WebhookID     string
+	EventSequence int64
+	EventType     EventType
+	Payload       []byte
+	Attempts      int
+	LastError     string
+	FailedAt      time.Time
+}
+
+// CreateWebhook registers an endpoint for userID, subscribed to the given
+// event types. The webhook's signing secret is generated here.
+func (db *Database) CreateWebhook(userID, rawURL string, events []EventType) (*Webhook, error) {
+	if err := validateWebhookURL(rawURL); err != nil {
+		return nil, err
+	}
+	events, err := validateWebhookEvents(events)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := newWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if _, exists := db.Users[userID]; !exists {
+		return nil, fmt.Errorf("user with ID %s not found", userID)
+	}
+	if len(db.UserWebhooks[userID]) >= MaxWebhooksPerUser {
+		return nil, ErrTooManyWebhooks
+	}
+
+	webhook := &Webhook{
+		ID:        fmt.Sprintf("webhook%d", db.NextWebhookID),
+		UserID:    userID,
+		URL:       rawURL,
+		Secret:    secret,
+		Events:    events,
+		Active:    true,
+		CreatedAt: time.Now(),
+	}
+	db.NextWebhookID++
+	db.Webhooks[webhook.ID] = webhook
+	db.UserWebhooks[userID] = append(db.UserWebhooks[userID], webhook.ID)
+
+	return webhook, nil
+}
+
+// DeleteWebhook deletes one of userID's webhooks with its delivery log and
+// dead letters
+func (db *Database) DeleteWebhook(userID, webhookID string) (*Webhook, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	webhook, err := db.ownedWebhook(userID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(db.Webhooks, webhookID)
+	delete(db.WebhookDeliveries, webhookID)
+	delete(db.DeadLetters, webhookID)
+	if remaining := removeID(db.UserWebhooks[userID], webhookID); len(remaining) > 0 {
+		db.UserWebhooks[userID] = remaining
+	} else {
+		delete(db.UserWebhooks, userID)
+	}
+
+	return webhook, nil
+}
+
+// SetWebhookActive enables or disables one of userID's webhooks. Enabling
+// a webhook that was disabled automatically gives it a fresh start.
+func (db *Database) SetWebhookActive(userID, webhookID string, active bool) (*Webhook, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	webhook, err := db.ownedWebhook(userID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := *webhook
+	updated.Active = active
+	updated.DisabledReason = ""
+	if active {
+		updated.ConsecutiveFailures = 0
+	}
+	db.Webhooks[webhookID] = &updated
+	return &updated, nil
+}
+
+// GetWebhook returns a webhook by ID, or nil if it doesn't exist
+func (db *Database) GetWebhook(webhookID string) *Webhook {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	return db.Webhooks[webhookID]
+}
+
+// GetWebhooks returns userID's webhooks, oldest first
+func (db *Database) GetWebhooks(userID string) []*Webhook {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	webhooks := make([]*Webhook, 0, len(db.UserWebhooks[userID]))
+	for _, id := range db.UserWebhooks[userID] {
+		webhooks = append(webhooks, db.Webhooks[id])
+	}
+	return webhooks
+}
+
+// WebhooksForEvent returns the active webhooks an event should be sent to:
+// those subscribed to its type whose owner made the change or, for
+// follows, was followed
+func (db *Database) WebhooksForEvent(event Event) []*Webhook {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	owners := []string{event.ActorID}
+	if event.SubjectID != "" && event.SubjectID != event.ActorID {
+		owners = append(owners, event.SubjectID)
+	}
+
+	var webhooks []*Webhook
+	for _, owner := range owners {
+		for _, id := range db.UserWebhooks[owner] {
+			webhook := db.Webhooks[id]
+			if webhook.Active && containsEventType(webhook.Events, event.Type) {
+				webhooks = append(webhooks, webhook)
+			}
+		}
+	}
+	return webhooks
+}
+
+// LogWebhookDelivery records a delivery attempt. A successful one resets
+// the webhook's failure count.
+func (db *Database) LogWebhookDelivery(delivery WebhookDelivery) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	webhook, exists := db.Webhooks[delivery.WebhookID]
+	if !exists {
+		return
+	}
+
+	delivery.ID = fmt.Sprintf("delivery%d", db.NextWebhookDeliveryID)
+	db.NextWebhookDeliveryID++
+	deliveries := append(db.WebhookDeliveries[delivery.WebhookID], delivery)
+	if len(deliveries) > MaxWebhookDeliveries {
+		deliveries = append([]WebhookDelivery(nil), deliveries[len(deliveries)-MaxWebhookDeliveries:]...)
+	}
+	db.WebhookDeliveries[delivery.WebhookID] = deliveries
+
+	if delivery.Succeeded() && webhook.ConsecutiveFailures > 0 {
+		updated := *webhook
+		updated.ConsecutiveFailures = 0
+		db.Webhooks[webhook.ID] = &updated
+	}
+}
+
+// AddDeadLetter records an event a webhook didn't take. After
+// WebhookDisableAfter of them in a row the webhook is disabled. It returns
+// the webhook as it is now, or nil if it has been deleted.
+func (db *Database) AddDeadLetter(deadLetter DeadLetter) *Webhook {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	webhook, exists := db.Webhooks[deadLetter.WebhookID]
+	if !exists {
+		return nil
+	}
+
+	deadLetter.ID = fmt.Sprintf("deadletter%d", db.NextDeadLetterID)
+	db.NextDeadLetterID++
+	deadLetters := append(db.DeadLetters[webhook.ID], &deadLetter)
+	if len(deadLetters) > MaxDeadLetters {
+		deadLetters = append([]*DeadLetter(nil), deadLetters[len(deadLetters)-MaxDeadLetters:]...)
+	}
+	db.DeadLetters[webhook.ID] = deadLetters
+
+	// Events dropped because the webhook is already disabled don't count
+	if !webhook.Active {
+		return webhook
+	}
+	updated := *webhook
+	updated.ConsecutiveFailures++
+	if updated.ConsecutiveFailures >= WebhookDisableAfter {
+		updated.Active = false
+		updated.DisabledReason = fmt.Sprintf("%d events in a row could not be delivered", updated.ConsecutiveFailures)
+	}
+	db.Webhooks[webhook.ID] = &updated
+	return &updated
+}
+
+// GetWebhookDeliveries returns up to first of the delivery attempts logged
+// for one of userID's webhooks, newest first
+func (db *Database) GetWebhookDeliveries(userID, webhookID string, first int) ([]WebhookDelivery, error) {
+	first = ClampPageSize(first)
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	if _, err := db.ownedWebhook(userID, webhookID); err != nil {
+		return nil, err
+	}
+
+	logged := db.WebhookDeliveries[webhookID]
+	deliveries := make([]WebhookDelivery, 0, min(first, len(logged)))
+	for i := len(logged) - 1; i >= 0 && len(deliveries) < first; i-- {
+		deliveries = append(deliveries, logged[i])
+	}
+	return deliveries, nil
+}
+
+// GetDeadLetters returns the dead letters of one of userID's webhooks,
+// newest first
+func (db *Database) GetDeadLetters(userID, webhookID string) ([]*DeadLetter, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	if _, err := db.ownedWebhook(userID, webhookID); err != nil {
+		return nil, err
+	}
+
+	stored := db.DeadLetters[webhookID]
+	deadLetters := make([]*DeadLetter, 0, len(stored))
+	for i := len(stored) - 1; i >= 0; i-- {
+		deadLetters = append(deadLetters, stored[i])
+	}
+	return deadLetters, nil
+}
+
+// TakeDeadLetter removes one of the dead letters of userID's webhooks so
+// it can be redelivered. The webhook must be active.
+func (db *Database) TakeDeadLetter(userID, deadLetterID string) (*DeadLetter, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	for _, webhookID := range db.UserWebhooks[userID] {
+		for i, deadLetter := range db.DeadLetters[webhookID] {
+			if deadLetter.ID != deadLetterID {
+				continue
+			}
+			if !db.Webhooks[webhookID].Active {
+				return nil, ErrWebhookDisabled
+			}
+
+			stored := db.DeadLetters[webhookID]
+			remaining := make([]*DeadLetter, 0, len(stored)-1)
+			remaining = append(remaining, stored[:i]...)
+			db.DeadLetters[webhookID] = append(remaining, stored[i+1:]...)
+			return deadLetter, nil
+		}
+	}
+	return nil, ErrDeadLetterNotFound
+}
+
+// RestoreDeadLetter puts back a dead letter taken with TakeDeadLetter whose
+// redelivery couldn't be queued, in its place by failure time. It is
+// dropped if its webhook has been deleted since.
+func (db *Database) RestoreDeadLetter(deadLetter *DeadLetter) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if _, exists := db.Webhooks[deadLetter.WebhookID]; !exists {
+		return
+	}
+
+	stored := db.DeadLetters[deadLetter.WebhookID]
+	i := 0
+	for i < len(stored) && !stored[i].FailedAt.After(deadLetter.FailedAt) {
+		i++
+	}
+	restored := make([]*DeadLetter, 0, len(stored)+1)
+	restored = append(restored, stored[:i]...)
+	restored = append(restored, deadLetter)
+	db.DeadLetters[deadLetter.WebhookID] = append(restored, stored[i:]...)
+}
+
+// ownedWebhook returns one of userID's webhooks. Must be called with mu
+// held.
+func (db *Database) ownedWebhook(userID, webhookID string) (*Webhook, error) {
+	webhook, exists := db.Webhooks[webhookID]
+	if !exists || webhook.UserID != userID {
+		return nil, ErrWebhookNotFound
+	}
+	return webhook, nil
+}
+
+// validateWebhookURL accepts absolute http(s) URLs without credentials.
+// Where they may point is checked when they are dialed.
+func validateWebhookURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid webhook URL: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return errors.New("webhook URL must be http or https")
+	}
+	if u.Host == "" {
+		return errors.New("webhook URL has no host")
+	}
+	if u.User != nil {
+		return errors.New("webhook URL must not contain credentials")
+	}
+	return nil
+}
+
+// validateWebhookEvents checks that events is a non-empty set of webhook
+// event types, and returns it without duplicates
+func validateWebhookEvents(events []EventType) ([]EventType, error) {
+	if len(events) == 0 {
+		return nil, errors.New("a webhook must subscribe to at least one event")
+	}
+
+	unique := make([]EventType, 0, len(events))
+	for _, e := range events {
+		if !containsEventType(WebhookEvents, e) {
+			return nil, fmt.Errorf("webhooks can't subscribe to %s events", e)
+		}
+		if !containsEventType(unique, e) {
+			unique = append(unique, e)
+		}
+	}
+	return unique, nil
+}
+
+func containsEventType(types []EventType, t EventType) bool {
+	for _, v := range types {
+		if v == t {
+			return true
+		}
+	}
+	return false
+}
+
+// newWebhookSecret generates a random signing secret
+func newWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
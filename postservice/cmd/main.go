@@ -13,7 +13,7 @@ func main() {
 
 	// Start the internal TCP server (not exposed externally)
 	log.Println("Starting internal post service on port 50051...")
-	if err := postservice.StartServer(db, ":50051"); err != nil {
+	if err := postservice.StartServer(db, ":50051", "data/drafts.json"); err != nil {
 		log.Fatalf("Failed to start post service: %v", err)
 	}
 }
@@ -0,0 +1,203 @@
+package postservice
+
+import (
+	"time"
+
+	"github.com/paper-social/feed-service/linkpreview"
+	"github.com/paper-social/feed-service/model"
+	"github.com/paper-social/feed-service/proto/post"
+)
+
+// entityTypes maps model entity types to their proto enum values
+var entityTypes = map[model.EntityType]post.EntityType{
+	model.EntityLink:      post.EntityType_ENTITY_TYPE_LINK,
+	model.EntityImageLink: post.EntityType_ENTITY_TYPE_IMAGE_LINK,
+	model.EntityMention:   post.EntityType_ENTITY_TYPE_MENTION,
+	model.EntityHashtag:   post.EntityType_ENTITY_TYPE_HASHTAG,
+	model.EntityCashtag:   post.EntityType_ENTITY_TYPE_CASHTAG,
+}
+
+// postStatuses maps model post statuses to their proto enum values
+var postStatuses = map[model.PostStatus]post.PostStatus{
+	model.PostPublished: post.PostStatus_POST_STATUS_PUBLISHED,
+	model.PostDraft:     post.PostStatus_POST_STATUS_DRAFT,
+	model.PostScheduled: post.PostStatus_POST_STATUS_SCHEDULED,
+}
+
+// visibilities maps model post visibilities to their proto enum values
+var visibilities = map[model.Visibility]post.Visibility{
+	model.VisibilityPublic:    post.Visibility_VISIBILITY_PUBLIC,
+	model.VisibilityFollowers: post.Visibility_VISIBILITY_FOLLOWERS,
+	model.VisibilityMentioned: post.Visibility_VISIBILITY_MENTIONED,
+}
+
+// toProtoPost converts a model post to its proto representation as seen by
+// viewerID, attaching any link previews that have been unfurled already
+func (s *Server) toProtoPost(p *model.Post, viewerID string) *post.Post {
+	pbPost := &post.Post{
+		Id:        p.ID,
+		UserId:    p.UserID,
+		Content:   p.Content,
+		CreatedAt: p.CreatedAt.Unix(),
+		Entities:  toProtoEntities(p.Entities),
+		Media:     toProtoMedia(p.Media),
+		Status:    postStatuses[p.Status],
+		Pinned:    s.db.IsPinned(p),
+		ReplyToId: p.ReplyToID,
+
+		Visibility: visibilities[p.Visibility],
+	}
+	if p.Status == model.PostPublished {
+		reactions := s.db.GetReactions(p, viewerID)
+		pbPost.LikeCount = int32(reactions.Likes)
+		pbPost.ReplyCount = int32(reactions.Replies)
+		pbPost.RepostCount = int32(reactions.Reposts)
+		pbPost.Liked = reactions.ViewerLiked
+		pbPost.Reposted = reactions.ViewerReposted
+	}
+	if !p.PublishAt.IsZero() {
+		pbPost.PublishAt = p.PublishAt.Unix()
+	}
+
+	if p.Poll != nil {
+		pbPost.Poll = s.toProtoPoll(p, viewerID)
+	}
+
+	for _, url := range p.LinkURLs() {
+		if preview := s.previews.Get(url); preview != nil {
+			pbPost.LinkPreviews = append(pbPost.LinkPreviews, toProtoLinkPreview(preview))
+		}
+	}
+
+	return pbPost
+}
+
+// toProtoPostPage converts a page of posts as seen by viewerID
+func (s *Server) toProtoPostPage(page *model.PostPage, viewerID string) *post.PostPage {
+	pbPage := &post.PostPage{
+		Posts:       make([]*post.Post, 0, len(page.Posts)),
+		HasNextPage: page.HasNextPage,
+	}
+	for _, p := range page.Posts {
+		pbPage.Posts = append(pbPage.Posts, s.toProtoPost(p, viewerID))
+	}
+	if len(page.Posts) > 0 {
+		pbPage.EndCursor = page.EndCursor.Encode()
+	}
+	return pbPage
+}
+
+// toProtoPoll converts a post's poll for viewerID. Tallies stay hidden
+// until the viewer has voted or the poll has closed; authors always see
+// their own results.
+func (s *Server) toProtoPoll(p *model.Post, viewerID string) *post.Poll {
+	results := s.db.GetPollResults(p, viewerID)
+	closed := p.Poll.Closed(time.Now())
+	visible := closed || results.ViewerVote >= 0 || viewerID == p.UserID
+
+	pbPoll := &post.Poll{
+		ExpiresAt:      p.Poll.ExpiresAt.Unix(),
+		Closed:         closed,
+		ResultsVisible: visible,
+		ViewerVote:     int32(results.ViewerVote),
+	}
+	if visible {
+		pbPoll.TotalVotes = int32(results.TotalVotes)
+	}
+	for i, option := range p.Poll.Options {
+		pbOption := &post.PollOption{Text: option}
+		if visible {
+			pbOption.Votes = int32(results.Counts[i])
+		}
+		pbPoll.Options = append(pbPoll.Options, pbOption)
+	}
+
+	return pbPoll
+}
+
+// pollFromProto converts a proto poll input to the model type
+func pollFromProto(input *post.PollInput) *model.Poll {
+	if input == nil {
+		return nil
+	}
+	return &model.Poll{
+		Options:   append([]string(nil), input.Options...),
+		ExpiresAt: time.Unix(input.ExpiresAt, 0),
+	}
+}
+
+// toProtoEntities converts model entities to their proto representation
+func toProtoEntities(entities []model.Entity) []*post.Entity {
+	pbEntities := make([]*post.Entity, 0, len(entities))
+	for _, e := range entities {
+		pbEntities = append(pbEntities, &post.Entity{
+			Type:      entityTypes[e.Type],
+			Text:      e.Text,
+			Value:     e.Value,
+			Start:     int32(e.Start),
+			End:       int32(e.End),
+			ByteStart: int32(e.ByteStart),
+			ByteEnd:   int32(e.ByteEnd),
+		})
+	}
+	return pbEntities
+}
+
+// toProtoLinkPreview converts a link preview to its proto representation
+func toProtoLinkPreview(p *linkpreview.Preview) *post.LinkPreview {
+	return &post.LinkPreview{
+		Url:         p.URL,
+		Title:       p.Title,
+		Description: p.Description,
+		ImageUrl:    p.ImageURL,
+		SiteName:    p.SiteName,
+		AuthorName:  p.AuthorName,
+		Type:        p.Type,
+		FetchedAt:   p.FetchedAt.Unix(),
+	}
+}
+
+// toProtoMedia converts model media attachments to their proto representation
+func toProtoMedia(media []model.Media) []*post.Media {
+	pbMedia := make([]*post.Media, 0, len(media))
+	for _, m := range media {
+		pbMedia = append(pbMedia, &post.Media{
+			Id:           m.ID,
+			Url:          m.URL,
+			ThumbnailUrl: m.ThumbnailURL,
+			ContentType:  m.ContentType,
+			Width:        int32(m.Width),
+			Height:       int32(m.Height),
+			AltText:      m.AltText,
+		})
+	}
+	return pbMedia
+}
+
+// mediaFromProto converts proto media attachments to the model type
+func mediaFromProto(pbMedia []*post.Media) []model.Media {
+	media := make([]model.Media, 0, len(pbMedia))
+	for _, m := range pbMedia {
+		media = append(media, model.Media{
+			ID:           m.Id,
+			URL:          m.Url,
+			ThumbnailURL: m.ThumbnailUrl,
+			ContentType:  m.ContentType,
+			Width:        int(m.Width),
+			Height:       int(m.Height),
+			AltText:      m.AltText,
+		})
+	}
+	return media
+}
+
+// visibilityFromProto converts a proto visibility to the model value
+func visibilityFromProto(v post.Visibility) model.Visibility {
+	for mv, pv := range visibilities {
+		if pv == v {
+			return mv
+		}
+	}
+	// Let the model reject values it doesn't know
+	return model.Visibility(v.String())
+}
@@ -0,0 +1,144 @@
+package postservice
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/paper-social/feed-service/model"
+)
+
+// DraftStore persists drafts and scheduled posts so they survive a restart
+// of the post service
+type DraftStore interface {
+	Load() (SavedDrafts, error)
+	Save(saved SavedDrafts) error
+}
+
+// SavedDrafts is what a DraftStore keeps. NextPostID is saved alongside
+// the posts so IDs given to posts published since the newest draft aren't
+// issued again after a restart; the outbox, webhooks and federated
+// activities still refer to them. It runs ahead of the IDs actually issued,
+// so new posts only need saving once per block of IDs.
+type SavedDrafts struct {
+	NextPostID int           `json:"nextPostId"`
+	Posts      []*model.Post `json:"posts"`
+}
+
+// FileDraftStore keeps unpublished posts in a JSON file
+type FileDraftStore struct {
+	path string
+}
+
+// NewFileDraftStore creates a draft store writing to path
+func NewFileDraftStore(path string) *FileDraftStore {
+	return &FileDraftStore{path: path}
+}
+
+// Load reads the saved posts, returning none if nothing was saved yet.
+// Files holding only a list of posts, as written before the next post ID
+// was saved, are read too.
+func (fs *FileDraftStore) Load() (SavedDrafts, error) {
+	data, err := os.ReadFile(fs.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return SavedDrafts{}, nil
+	}
+	if err != nil {
+		return SavedDrafts{}, err
+	}
+
+	var saved SavedDrafts
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		err = json.Unmarshal(data, &saved.Posts)
+	} else {
+		err = json.Unmarshal(data, &saved)
+	}
+	if err != nil {
+		return SavedDrafts{}, err
+	}
+	return saved, nil
+}
+
+// Save replaces the saved posts. The file is written next to the target
+// and renamed over it, so a crash never leaves a truncated file behind.
+func (fs *FileDraftStore) Save(saved SavedDrafts) error {
+	data, err := json.MarshalIndent(saved, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(fs.path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(dir, ".drafts-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), fs.path)
+}
+
+// postIDBlock is how many post IDs are reserved each time the next post ID
+// is saved. IDs reserved but never issued before a restart are skipped.
+const postIDBlock = 100
+
+// draftPersister snapshots the unpublished posts of a database, and the
+// next post ID, into a DraftStore. Snapshots are taken and written under
+// one lock, so an older snapshot can never overwrite a newer one.
+type draftPersister struct {
+	mu       sync.Mutex
+	db       *model.Database
+	store    DraftStore
+	reserved int // Post IDs below this are covered by the saved next post ID
+}
+
+func (p *draftPersister) save() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.saveLocked()
+}
+
+// reservePostIDs saves once the post IDs issued have caught up with those
+// already reserved, so a restart never issues them again
+func (p *draftPersister) reservePostIDs() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.db.NextPostNumber() < p.reserved {
+		return nil
+	}
+	return p.saveLocked()
+}
+
+// saveLocked writes a snapshot, reserving another block of post IDs if
+// needed. Must be called with mu held.
+func (p *draftPersister) saveLocked() error {
+	posts, nextPostID := p.db.AllUnpublishedPosts()
+	reserved := p.reserved
+	if nextPostID >= reserved {
+		reserved = nextPostID + postIDBlock
+	}
+	if err := p.store.Save(SavedDrafts{NextPostID: reserved, Posts: posts}); err != nil {
+		return err
+	}
+	p.reserved = reserved
+	return nil
+}
@@ -0,0 +1,138 @@
+package postservice
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/paper-social/feed-service/linkpreview"
+	"github.com/paper-social/feed-service/model"
+	"github.com/paper-social/feed-service/proto/post"
+)
+
+// newTestPreviews returns a preview service that never fetches anything
+func newTestPreviews(t *testing.T) *linkpreview.Service {
+	cfg := linkpreview.DefaultConfig()
+	cfg.Workers = 0
+	previews := linkpreview.NewService(cfg)
+	t.Cleanup(previews.Close)
+	return previews
+}
+
+func TestRestoreKeepsPostIDsUnique(t *testing.T) {
+	ctx := context.Background()
+	store := NewFileDraftStore(filepath.Join(t.TempDir(), "drafts.json"))
+	previews := newTestPreviews(t)
+
+	before := NewServer(model.NewDatabase(), previews, store)
+	draft, err := before.SaveDraft(ctx, &post.CreatePostRequest{UserId: "user1", Content: "Draft"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	issued := map[string]bool{draft.Id: true}
+
+	// Published after the newest draft, so only the saved next post ID
+	// keeps their IDs from being reused
+	for _, content := range []string{"First", "Second"} {
+		p, err := before.CreatePost(ctx, &post.CreatePostRequest{UserId: "user2", Content: content})
+		if err != nil {
+			t.Fatal(err)
+		}
+		issued[p.Id] = true
+	}
+
+	db := model.NewDatabase()
+	after := NewServer(db, previews, store)
+
+	drafts := db.GetUnpublishedPosts("user1")
+	if len(drafts) != 1 || drafts[0].ID != draft.Id || drafts[0].Content != "Draft" {
+		t.Fatalf("restored drafts = %v, want %s", drafts, draft.Id)
+	}
+
+	for range 3 {
+		p, err := after.CreatePost(ctx, &post.CreatePostRequest{UserId: "user1", Content: "After restart"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if issued[p.Id] {
+			t.Fatalf("post ID %s was issued again after a restart", p.Id)
+		}
+		issued[p.Id] = true
+	}
+}
+
+// countingDraftStore counts the saves made to a draft store
+type countingDraftStore struct {
+	DraftStore
+	saves int
+}
+
+func (s *countingDraftStore) Save(saved SavedDrafts) error {
+	s.saves++
+	return s.DraftStore.Save(saved)
+}
+
+func TestCreatePostReservesIDsInBlocks(t *testing.T) {
+	ctx := context.Background()
+	store := &countingDraftStore{DraftStore: NewFileDraftStore(filepath.Join(t.TempDir(), "drafts.json"))}
+	s := NewServer(model.NewDatabase(), newTestPreviews(t), store)
+
+	create := func(n int) {
+		t.Helper()
+		for range n {
+			if _, err := s.CreatePost(ctx, &post.CreatePostRequest{UserId: "user1", Content: "Hello"}); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	// The first post reserves a block of IDs, which lasts until it runs out
+	create(postIDBlock)
+	if store.saves != 1 {
+		t.Errorf("%d posts saved the store %d times, want once", postIDBlock, store.saves)
+	}
+	create(1)
+	if store.saves != 2 {
+		t.Errorf("the post after the block saved the store %d times in all, want twice", store.saves)
+	}
+
+	// Saving drafts keeps the reservation
+	if _, err := s.SaveDraft(ctx, &post.CreatePostRequest{UserId: "user1", Content: "Draft"}); err != nil {
+		t.Fatal(err)
+	}
+	saved, err := store.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next := s.db.NextPostNumber(); saved.NextPostID <= next {
+		t.Errorf("saved next post ID %d, want one past %d", saved.NextPostID, next)
+	}
+}
+
+func TestFileDraftStoreLoadsPostList(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "drafts.json")
+	data, err := json.Marshal([]*model.Post{{ID: "post42", UserID: "user1", Content: "Old", Status: model.PostDraft}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	saved, err := NewFileDraftStore(path).Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(saved.Posts) != 1 || saved.Posts[0].ID != "post42" || saved.NextPostID != 0 {
+		t.Fatalf("Load() = %+v", saved)
+	}
+
+	// The restored ID still moves new IDs past it
+	db := model.NewDatabase()
+	db.RestoreUnpublishedPosts(saved.Posts, saved.NextPostID)
+	if db.NextPostID != 43 {
+		t.Errorf("NextPostID = %d, want 43", db.NextPostID)
+	}
+}
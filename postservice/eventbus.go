@@ -0,0 +1,146 @@
+package postservice
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/paper-social/feed-service/model"
+)
+
+const (
+	// eventBatch caps how many outbox events a consumer reads at once
+	eventBatch = 100
+
+	// Retry delays for a consumer whose handler fails, doubling per attempt
+	minEventRetry = 100 * time.Millisecond
+	maxEventRetry = 30 * time.Second
+
+	// maxEventAttempts is how many times an event is handed to a consumer
+	// before it is parked, about a minute of retries
+	maxEventAttempts = 10
+)
+
+// Consumer reacts to domain events. Delivery is at least once: an event is
+// handed over again until Handle succeeds, so handlers must be idempotent.
+// An event that still fails after maxEventAttempts is parked for the
+// consumer and skipped, so one bad event can't hold it up for good.
+type Consumer interface {
+	Handle(ctx context.Context, event model.Event) error
+}
+
+// ConsumerFunc adapts a function to the Consumer interface
+type ConsumerFunc func(ctx context.Context, event model.Event) error
+
+// Handle calls f
+func (f ConsumerFunc) Handle(ctx context.Context, event model.Event) error {
+	return f(ctx, event)
+}
+
+// EventBus delivers the domain events mutations record in the database
+// outbox to registered consumers. Each consumer reads the outbox in order
+// from its own offset, so a slow or failing consumer holds up only itself.
+type EventBus struct {
+	db *model.Database
+
+	// Retry delays, doubling per attempt, and the attempts an event gets
+	// before it is parked
+	minRetry    time.Duration
+	maxRetry    time.Duration
+	maxAttempts int
+
+	mu        sync.Mutex
+	consumers map[string]Consumer
+}
+
+// NewEventBus creates a bus with no consumers
+func NewEventBus(db *model.Database) *EventBus {
+	return &EventBus{
+		db:          db,
+		minRetry:    minEventRetry,
+		maxRetry:    maxEventRetry,
+		maxAttempts: maxEventAttempts,
+		consumers:   make(map[string]Consumer),
+	}
+}
+
+// Register adds a consumer under a unique name, which keys its offset.
+// Consumers must be registered before Run; events recorded from then on
+// are kept in the outbox until the consumer has handled them.
+func (b *EventBus) Register(name string, c Consumer) {
+	b.db.RegisterConsumer(name)
+
+	b.mu.Lock()
+	b.consumers[name] = c
+	b.mu.Unlock()
+}
+
+// Run delivers events to every consumer until ctx is cancelled
+func (b *EventBus) Run(ctx context.Context) {
+	b.mu.Lock()
+	consumers := make(map[string]Consumer, len(b.consumers))
+	for name, c := range b.consumers {
+		consumers[name] = c
+	}
+	b.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for name, c := range consumers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			b.deliver(ctx, name, c)
+		}()
+	}
+	wg.Wait()
+}
+
+// deliver feeds one consumer the events after its offset, acknowledging
+// each once it has been handled or parked
+func (b *EventBus) deliver(ctx context.Context, name string, c Consumer) {
+	offset := b.db.ConsumerOffset(name)
+	for {
+		events, changed := b.db.ReadOutbox(offset, eventBatch)
+		for _, event := range events {
+			if err := b.handle(ctx, name, c, event); err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				log.Printf("Consumer %s gave up on event %d (%s) after %d attempts, parking it: %v", name, event.Sequence, event.Type, b.maxAttempts, err)
+				b.db.ParkEvent(name, event, b.maxAttempts, err)
+			}
+			offset = event.Sequence
+			b.db.AckEvents(name, offset)
+		}
+
+		if len(events) == 0 {
+			select {
+			case <-ctx.Done():
+				return
+			case <-changed:
+			}
+		}
+	}
+}
+
+// handle retries an event with backoff until the consumer handles it,
+// returning the last error if every attempt fails. If ctx is cancelled
+// first it returns ctx's error.
+func (b *EventBus) handle(ctx context.Context, name string, c Consumer, event model.Event) error {
+	delay := b.minRetry
+	for attempt := 1; ; attempt++ {
+		err := c.Handle(ctx, event)
+		if err == nil || attempt >= b.maxAttempts {
+			return err
+		}
+		log.Printf("Consumer %s failed to handle event %d (%s), retrying in %v: %v", name, event.Sequence, event.Type, delay, err)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay = min(2*delay, b.maxRetry)
+	}
+}
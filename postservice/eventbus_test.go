@@ -0,0 +1,141 @@
+package postservice
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/paper-social/feed-service/model"
+)
+
+// waitFor polls cond until it holds, failing the test after a few seconds
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestEventBusParksPoisonEvents(t *testing.T) {
+	db := model.NewDatabase()
+	bus := NewEventBus(db)
+	bus.minRetry = time.Millisecond
+	bus.maxRetry = time.Millisecond
+	bus.maxAttempts = 3
+
+	// The poison consumer can never handle the post saying "poison"
+	errPoison := errors.New("cannot handle this post")
+	var mu sync.Mutex
+	attempts := make(map[int64]int)
+	handled := make(map[string][]int64)
+	consumer := func(name string, fails func(model.Event) bool) Consumer {
+		return ConsumerFunc(func(ctx context.Context, event model.Event) error {
+			mu.Lock()
+			defer mu.Unlock()
+			if name == "poison" {
+				attempts[event.Sequence]++
+			}
+			if fails(event) {
+				return errPoison
+			}
+			handled[name] = append(handled[name], event.Sequence)
+			return nil
+		})
+	}
+	bus.Register("poison", consumer("poison", func(e model.Event) bool {
+		return e.Type == model.EventPostCreated && e.Post.Content == "poison"
+	}))
+	bus.Register("healthy", consumer("healthy", func(model.Event) bool { return false }))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		bus.Run(ctx)
+		close(done)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	for _, content := range []string{"before", "poison", "after"} {
+		if _, err := db.CreatePost("user1", content, model.PostOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	last := db.NextEventSequence - 1
+
+	// Both consumers get past the poison event, and the outbox drains
+	for _, name := range []string{"poison", "healthy"} {
+		waitFor(t, name+" to reach the last event", func() bool {
+			return db.ConsumerOffset(name) == last
+		})
+	}
+	if events, _ := db.ReadOutbox(0, eventBatch); len(events) != 0 {
+		t.Errorf("outbox still holds %d events", len(events))
+	}
+
+	parked := db.GetParkedEvents("poison")
+	if len(parked) != 1 {
+		t.Fatalf("parked %d events, want 1", len(parked))
+	}
+	if parked[0].Event.Post.Content != "poison" || parked[0].Attempts != 3 || parked[0].LastError != errPoison.Error() {
+		t.Errorf("parked event = %+v", parked[0])
+	}
+	if len(db.GetParkedEvents("healthy")) != 0 {
+		t.Error("healthy consumer parked events")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if n := attempts[parked[0].Event.Sequence]; n != 3 {
+		t.Errorf("poison event handed over %d times, want 3", n)
+	}
+	if got, want := len(handled["poison"]), len(handled["healthy"])-1; got != want {
+		t.Errorf("poison consumer handled %d events, want %d", got, want)
+	}
+}
+
+func TestEventBusStopsRetryingWhenCancelled(t *testing.T) {
+	db := model.NewDatabase()
+	bus := NewEventBus(db)
+	bus.minRetry = time.Hour
+
+	failed := make(chan struct{}, 1)
+	bus.Register("failing", ConsumerFunc(func(ctx context.Context, event model.Event) error {
+		select {
+		case failed <- struct{}{}:
+		default:
+		}
+		return errors.New("unavailable")
+	}))
+	offset := db.ConsumerOffset("failing")
+
+	if _, err := db.CreatePost("user1", "hello", model.PostOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		bus.Run(ctx)
+		close(done)
+	}()
+	<-failed
+	cancel()
+	<-done
+
+	// Shutting down mid-retry neither parks nor acknowledges the event
+	if len(db.GetParkedEvents("failing")) != 0 {
+		t.Error("event was parked on shutdown")
+	}
+	if got := db.ConsumerOffset("failing"); got != offset {
+		t.Errorf("offset = %d, want %d", got, offset)
+	}
+}
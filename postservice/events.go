@@ -0,0 +1,234 @@
+package postservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/paper-social/feed-service/model"
+	"github.com/paper-social/feed-service/proto/post"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// eventLogSize is how many recent events the log keeps for watchers to
+	// catch up on or resume from
+	eventLogSize = 10000
+
+	// eventReadBatch caps how many events a watcher takes from the log at once
+	eventReadBatch = 100
+)
+
+// Headers WatchPosts sends before the first event, giving the log_id and
+// after_sequence that resume the stream from where it started
+const (
+	LogIDHeader    = "x-post-log-id"
+	SequenceHeader = "x-post-log-sequence"
+)
+
+var (
+	// ErrEventsExpired means a watcher asked for events the log no longer
+	// holds, because it fell too far behind or the log was restarted
+	ErrEventsExpired = errors.New("events are no longer in the log")
+)
+
+// postEvent is a change to a published post, before it is shaped for a
+// particular viewer
+type postEvent struct {
+	sequence   int64
+	kind       post.PostEventType
+	post       *model.Post
+	occurredAt time.Time
+}
+
+// PostEventLog is an in-process log of changes to published posts, fed by
+// the event bus. Events are numbered from 1 and the most recent
+// eventLogSize are kept, so watchers read it at their own pace and can
+// resume after the last event they saw. Appending never blocks on
+// watchers.
+type PostEventLog struct {
+	id string // Distinguishes this log from the logs of earlier runs
+
+	mu     sync.Mutex
+	events []postEvent   // Ring buffer indexed by sequence
+	last   int64         // Sequence of the newest event, 0 if none
+	notify chan struct{} // Closed and replaced when an event is appended
+}
+
+// NewPostEventLog creates an empty event log. Its ID is the time it was
+// created, which tells the logs of different runs apart.
+func NewPostEventLog() *PostEventLog {
+	return &PostEventLog{
+		id:     strconv.FormatInt(time.Now().UnixNano(), 36),
+		events: make([]postEvent, eventLogSize),
+		notify: make(chan struct{}),
+	}
+}
+
+// Handle implements Consumer, logging post created, updated and deleted
+// events
+func (l *PostEventLog) Handle(ctx context.Context, event model.Event) error {
+	switch event.Type {
+	case model.EventPostCreated:
+		l.append(post.PostEventType_POST_EVENT_TYPE_CREATED, event.Post, event.OccurredAt)
+	case model.EventPostUpdated:
+		l.append(post.PostEventType_POST_EVENT_TYPE_UPDATED, event.Post, event.OccurredAt)
+	case model.EventPostDeleted:
+		l.append(post.PostEventType_POST_EVENT_TYPE_DELETED, event.Post, event.OccurredAt)
+	}
+	return nil
+}
+
+// append adds an event about a published post to the log
+func (l *PostEventLog) append(kind post.PostEventType, p *model.Post, occurredAt time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.last++
+	l.events[l.last%eventLogSize] = postEvent{
+		sequence:   l.last,
+		kind:       kind,
+		post:       p,
+		occurredAt: occurredAt,
+	}
+
+	close(l.notify)
+	l.notify = make(chan struct{})
+}
+
+// start returns the sequence a watcher reads after: the newest event for
+// a new watcher, or the event it resumes after, which must be from this
+// log and still held by it
+func (l *PostEventLog) start(logID string, afterSequence int64) (int64, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if logID == "" && afterSequence == 0 {
+		return l.last, nil
+	}
+	if logID != l.id {
+		return 0, fmt.Errorf("%w: log %q has been replaced", ErrEventsExpired, logID)
+	}
+	if afterSequence > l.last {
+		return 0, fmt.Errorf("sequence %d is ahead of the log", afterSequence)
+	}
+	if err := l.checkHeld(afterSequence); err != nil {
+		return 0, err
+	}
+	return afterSequence, nil
+}
+
+// read returns up to eventReadBatch events after the given sequence. When
+// there are none it returns a channel that is closed once there are.
+func (l *PostEventLog) read(after int64) ([]postEvent, <-chan struct{}, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := l.checkHeld(after); err != nil {
+		return nil, nil, err
+	}
+
+	end := min(l.last, after+eventReadBatch)
+	events := make([]postEvent, 0, end-after)
+	for seq := after + 1; seq <= end; seq++ {
+		events = append(events, l.events[seq%eventLogSize])
+	}
+	return events, l.notify, nil
+}
+
+// checkHeld checks that every event after the given sequence is still in
+// the log. Must be called with mu held.
+func (l *PostEventLog) checkHeld(after int64) error {
+	if oldest := l.last - eventLogSize + 1; after+1 < oldest {
+		return fmt.Errorf("%w: wanted events after %d, oldest is %d", ErrEventsExpired, after, oldest)
+	}
+	return nil
+}
+
+// WatchPosts implements the gRPC method to stream changes to the posts of
+// a set of authors
+func (s *Server) WatchPosts(req *post.WatchPostsRequest, stream grpc.ServerStreamingServer[post.PostEvent]) error {
+	log.Printf("User %s watching posts of %d authors after %s/%d", req.ViewerId, len(req.AuthorIds), req.LogId, req.AfterSequence)
+
+	after, err := s.events.start(req.LogId, req.AfterSequence)
+	if err != nil {
+		log.Printf("Error watching posts: %v", err)
+		return watchError(err)
+	}
+
+	// The headers tell the client where in the log the stream starts, so it
+	// can resume from there before the first event arrives. Once it has them
+	// nothing published from then on is missed.
+	header := metadata.Pairs(
+		LogIDHeader, s.events.id,
+		SequenceHeader, strconv.FormatInt(after, 10),
+	)
+	if err := stream.SendHeader(header); err != nil {
+		return err
+	}
+
+	authors := make(map[string]bool, len(req.AuthorIds))
+	for _, id := range req.AuthorIds {
+		authors[id] = true
+	}
+
+	for {
+		events, wait, err := s.events.read(after)
+		if err != nil {
+			log.Printf("Dropping post watcher of user %s: %v", req.ViewerId, err)
+			return watchError(err)
+		}
+
+		for _, event := range events {
+			after = event.sequence
+
+			// Visibility is checked as each event goes out, since follows
+			// and blocks can change while the stream is open
+			if !authors[event.post.UserID] || !s.db.CanView(req.ViewerId, event.post) {
+				continue
+			}
+			if err := stream.Send(s.toProtoPostEvent(event, req.ViewerId)); err != nil {
+				return err
+			}
+		}
+
+		if len(events) == 0 {
+			select {
+			case <-stream.Context().Done():
+				return nil
+			case <-wait:
+			}
+		}
+	}
+}
+
+// watchError maps event log errors to gRPC status codes
+func watchError(err error) error {
+	if errors.Is(err, ErrEventsExpired) {
+		return status.Error(codes.OutOfRange, err.Error())
+	}
+	return status.Error(codes.InvalidArgument, err.Error())
+}
+
+// toProtoPostEvent converts a post event to proto as seen by viewerID
+func (s *Server) toProtoPostEvent(event postEvent, viewerID string) *post.PostEvent {
+	pb := &post.PostEvent{
+		Type:       event.kind,
+		PostId:     event.post.ID,
+		UserId:     event.post.UserID,
+		OccurredAt: event.occurredAt.Unix(),
+		Sequence:   event.sequence,
+		LogId:      s.events.id,
+	}
+	if event.kind != post.PostEventType_POST_EVENT_TYPE_DELETED {
+		pb.Post = s.toProtoPost(event.post, viewerID)
+	}
+	return pb
+}
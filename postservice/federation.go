@@ -0,0 +1,329 @@
+package postservice
+
+import (
+	"context"
+	"crypto/rsa"
+	"errors"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/paper-social/feed-service/activitypub"
+	"github.com/paper-social/feed-service/model"
+	"github.com/paper-social/feed-service/proto/user"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FederationConfig controls ActivityPub delivery
+type FederationConfig struct {
+	// BaseURL is the public URL of the GraphQL service, which serves the
+	// ActivityPub endpoints that activities refer to
+	BaseURL string
+	WebURL  string // Public URL prefix of profile and post pages
+
+	Timeout   time.Duration // Per attempt
+	UserAgent string
+	Workers   int // Concurrent deliveries
+	QueueSize int // Deliveries waiting for a worker before the event bus is held up
+
+	// A delivery is attempted up to MaxAttempts times, waiting MinRetry
+	// after the first failure and doubling up to MaxRetry
+	MaxAttempts int
+	MinRetry    time.Duration
+	MaxRetry    time.Duration
+
+	// AllowPrivateNetworks lets deliveries reach loopback and private
+	// addresses. Only for local testing.
+	AllowPrivateNetworks bool
+}
+
+// DefaultFederationConfig returns the configuration used by the post
+// service
+func DefaultFederationConfig() FederationConfig {
+	return FederationConfig{
+		BaseURL:     "http://localhost:8080",
+		WebURL:      "https://paper.social",
+		Timeout:     10 * time.Second,
+		UserAgent:   "PaperSocialFederation/1.0 (+https://paper.social)",
+		Workers:     4,
+		QueueSize:   256,
+		MaxAttempts: 8,
+		MinRetry:    2 * time.Second,
+		MaxRetry:    5 * time.Minute,
+	}
+}
+
+// federationJob is an activity on its way to one inbox
+type federationJob struct {
+	userID   string // The local user sending it, whose key signs it
+	username string
+	inbox    string
+	activity *activitypub.Activity
+	attempts int // Made so far
+}
+
+// Federator is the event bus consumer that sends users' public posts to
+// their followers on other servers over ActivityPub, as Create, Update and
+// Delete activities, and accepts remote follows. Each server gets one copy
+// of a post, at its shared inbox when it has one. Deliveries run on a pool
+// of workers and failed ones are retried with exponential backoff.
+type Federator struct {
+	db     *model.Database
+	cfg    FederationConfig
+	iris   activitypub.IRIs
+	client *activitypub.Client
+	jobs   chan *federationJob
+}
+
+// NewFederator creates a federator. Deliveries start once Run is called.
+func NewFederator(db *model.Database, cfg FederationConfig) *Federator {
+	return &Federator{
+		db:     db,
+		cfg:    cfg,
+		iris:   activitypub.IRIs{BaseURL: cfg.BaseURL},
+		client: activitypub.NewClient(cfg.Timeout, cfg.UserAgent, cfg.AllowPrivateNetworks),
+		jobs:   make(chan *federationJob, cfg.QueueSize),
+	}
+}
+
+// Run delivers queued activities until ctx is cancelled
+func (f *Federator) Run(ctx context.Context) {
+	for range f.cfg.Workers {
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case job := <-f.jobs:
+					f.attempt(ctx, job)
+				}
+			}
+		}()
+	}
+	<-ctx.Done()
+}
+
+// Handle implements Consumer by queueing the activities an event calls
+// for. Only public posts of accounts that aren't protected are federated.
+func (f *Federator) Handle(ctx context.Context, event model.Event) error {
+	switch event.Type {
+	case model.EventPostCreated, model.EventPostUpdated, model.EventPostDeleted:
+		return f.handlePost(ctx, event)
+	case model.EventRemoteFollowed:
+		return f.accept(ctx, event)
+	}
+	return nil
+}
+
+// handlePost sends a post change to the author's remote followers
+func (f *Federator) handlePost(ctx context.Context, event model.Event) error {
+	if event.Post.Visibility != model.VisibilityPublic {
+		return nil
+	}
+	author := f.db.GetUserByID(event.ActorID)
+	if author == nil || author.Protected {
+		return nil
+	}
+	followers := f.db.GetRemoteFollowers(author.ID)
+	if len(followers) == 0 {
+		return nil
+	}
+
+	var activity *activitypub.Activity
+	switch event.Type {
+	case model.EventPostCreated:
+		activity = activitypub.Create(f.iris.Note(author.Username, f.federatedPost(author, event.Post)))
+	case model.EventPostUpdated:
+		post := f.federatedPost(author, event.Post)
+		post.Updated = event.OccurredAt
+		activity = activitypub.Update(f.iris.Note(author.Username, post), event.OccurredAt)
+	case model.EventPostDeleted:
+		activity = activitypub.Delete(f.iris, author.Username, event.Post.ID)
+	}
+
+	sent := make(map[string]bool)
+	for _, follower := range followers {
+		inbox := follower.DeliveryInbox()
+		if sent[inbox] {
+			continue
+		}
+		sent[inbox] = true
+
+		job := &federationJob{userID: author.ID, username: author.Username, inbox: inbox, activity: activity}
+		if err := f.enqueue(ctx, job); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// accept answers a remote follow, unless it was undone since
+func (f *Federator) accept(ctx context.Context, event model.Event) error {
+	follower := f.db.GetRemoteFollower(event.SubjectID, event.RemoteActorID)
+	followee := f.db.GetUserByID(event.SubjectID)
+	if follower == nil || followee == nil {
+		return nil
+	}
+
+	activity := activitypub.Accept(f.iris, followee.Username, strconv.FormatInt(event.Sequence, 10), follower.FollowID, follower.ActorID)
+	return f.enqueue(ctx, &federationJob{
+		userID:   followee.ID,
+		username: followee.Username,
+		inbox:    follower.Inbox,
+		activity: activity,
+	})
+}
+
+// federatedPost converts a post to what notes are made of. Image links
+// are attached as well as linked, like uploaded media.
+func (f *Federator) federatedPost(author *model.User, p *model.Post) activitypub.Post {
+	post := activitypub.Post{
+		ID:        p.ID,
+		Content:   p.Content,
+		URL:       f.cfg.WebURL + "/@" + author.Username + "/" + p.ID,
+		Published: p.CreatedAt,
+	}
+	for _, e := range p.Entities {
+		switch e.Type {
+		case model.EntityLink:
+			post.Links = append(post.Links, activitypub.Link{Start: e.ByteStart, End: e.ByteEnd, Href: e.Value})
+		case model.EntityImageLink:
+			post.Links = append(post.Links, activitypub.Link{Start: e.ByteStart, End: e.ByteEnd, Href: e.Value})
+			post.Images = append(post.Images, activitypub.Document{Type: "Image", URL: e.Value})
+		case model.EntityMention:
+			post.Links = append(post.Links, activitypub.Link{Start: e.ByteStart, End: e.ByteEnd, Mention: e.Value})
+		}
+	}
+	for _, m := range p.Media {
+		post.Images = append(post.Images, activitypub.Document{Type: "Image", MediaType: m.ContentType, URL: m.URL, Name: m.AltText})
+	}
+	return post
+}
+
+// enqueue waits for room in the queue
+func (f *Federator) enqueue(ctx context.Context, job *federationJob) error {
+	select {
+	case f.jobs <- job:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// attempt makes one delivery attempt and schedules a retry or gives up
+func (f *Federator) attempt(ctx context.Context, job *federationJob) {
+	key, err := f.db.ActorKey(job.userID)
+	if err != nil {
+		log.Printf("Error loading actor key of user %s: %v", job.userID, err)
+		return
+	}
+
+	job.attempts++
+	err = f.client.Deliver(ctx, job.inbox, job.activity, f.iris.Key(job.username), key)
+	if err == nil || ctx.Err() != nil {
+		return
+	}
+
+	if job.attempts >= f.cfg.MaxAttempts {
+		log.Printf("Gave up delivering %s %s to %s after %d attempts: %v", job.activity.Type, job.activity.ID, job.inbox, job.attempts, err)
+		return
+	}
+
+	delay := min(f.cfg.MinRetry<<(job.attempts-1), f.cfg.MaxRetry)
+	log.Printf("Delivering %s %s to %s failed (attempt %d), retrying in %v: %v", job.activity.Type, job.activity.ID, job.inbox, job.attempts, delay, err)
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-time.After(delay):
+			f.enqueue(ctx, job)
+		}
+	}()
+}
+
+// toProtoRemoteFollower converts a remote follower to its proto
+// representation
+func toProtoRemoteFollower(userID string, f *model.RemoteFollower) *user.RemoteFollower {
+	return &user.RemoteFollower{
+		UserId:      userID,
+		ActorId:     f.ActorID,
+		Inbox:       f.Inbox,
+		SharedInbox: f.SharedInbox,
+		FollowId:    f.FollowID,
+		FollowedAt:  f.FollowedAt.Unix(),
+	}
+}
+
+// GetActorKey implements the gRPC method to get a user's public key
+func (s *UserServer) GetActorKey(ctx context.Context, req *user.ActorKeyRequest) (*user.ActorKey, error) {
+	log.Printf("Getting actor key of user %s", req.UserId)
+
+	key, err := s.db.ActorKey(req.UserId)
+	if err != nil {
+		log.Printf("Error getting actor key: %v", err)
+		return nil, err
+	}
+	pem, err := activitypub.EncodePublicKey(key.Public().(*rsa.PublicKey))
+	if err != nil {
+		log.Printf("Error encoding actor key: %v", err)
+		return nil, err
+	}
+
+	return &user.ActorKey{UserId: req.UserId, PublicKeyPem: pem}, nil
+}
+
+// AddRemoteFollower implements the gRPC method to add a follower on
+// another server. The federator accepts the follow once it is recorded.
+func (s *UserServer) AddRemoteFollower(ctx context.Context, req *user.RemoteFollowerRequest) (*user.RemoteFollower, error) {
+	log.Printf("Remote actor %s following user %s", req.ActorId, req.UserId)
+
+	follower, err := s.db.AddRemoteFollower(req.UserId, model.RemoteFollower{
+		ActorID:     req.ActorId,
+		Inbox:       req.Inbox,
+		SharedInbox: req.SharedInbox,
+		FollowID:    req.FollowId,
+	})
+	if err != nil {
+		log.Printf("Error adding remote follower: %v", err)
+		if errors.Is(err, model.ErrProtectedNotFederated) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, err
+	}
+
+	return toProtoRemoteFollower(req.UserId, follower), nil
+}
+
+// RemoveRemoteFollower implements the gRPC method to remove a follower on
+// another server
+func (s *UserServer) RemoveRemoteFollower(ctx context.Context, req *user.RemoteFollowerRequest) (*user.RemoteFollower, error) {
+	log.Printf("Remote actor %s unfollowing user %s", req.ActorId, req.UserId)
+
+	follower, err := s.db.RemoveRemoteFollower(req.UserId, req.ActorId)
+	if err != nil {
+		log.Printf("Error removing remote follower: %v", err)
+		if errors.Is(err, model.ErrRemoteFollowerNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+
+	return toProtoRemoteFollower(req.UserId, follower), nil
+}
+
+// GetActorKey calls the user service to get a user's public key
+func (c *Client) GetActorKey(ctx context.Context, req *user.ActorKeyRequest) (*user.ActorKey, error) {
+	return c.users.GetActorKey(ctx, req)
+}
+
+// AddRemoteFollower calls the user service to add a follower on another
+// server
+func (c *Client) AddRemoteFollower(ctx context.Context, req *user.RemoteFollowerRequest) (*user.RemoteFollower, error) {
+	return c.users.AddRemoteFollower(ctx, req)
+}
+
+// RemoveRemoteFollower calls the user service to remove a follower on
+// another server
+func (c *Client) RemoveRemoteFollower(ctx context.Context, req *user.RemoteFollowerRequest) (*user.RemoteFollower, error) {
+	return c.users.RemoveRemoteFollower(ctx, req)
+}
@@ -0,0 +1,181 @@
+package postservice
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/paper-social/feed-service/activitypub"
+	"github.com/paper-social/feed-service/model"
+)
+
+// delivery is an activity received by a remote inbox
+type delivery struct {
+	inbox    string
+	activity activitypub.Activity
+	note     activitypub.Note
+}
+
+// remoteInboxes is a server taking deliveries at any path, checking they
+// are signed by the local user they're from
+type remoteInboxes struct {
+	*httptest.Server
+	mu         sync.Mutex
+	deliveries []delivery
+}
+
+func newRemoteInboxes(t *testing.T, db *model.Database, iris activitypub.IRIs) *remoteInboxes {
+	r := &remoteInboxes{}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		var d delivery
+		d.inbox = req.URL.Path
+		d.activity.Object = &d.note
+		if err := json.Unmarshal(body, &d.activity); err != nil {
+			t.Errorf("invalid delivery: %v", err)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		username, _ := iris.Username(d.activity.Actor)
+		keyID, _ := activitypub.SignatureKeyID(req)
+		author := db.GetUserByUsername(username)
+		if author == nil || keyID != iris.Key(username) {
+			t.Errorf("%s by %s signed with key %s", d.activity.Type, d.activity.Actor, keyID)
+			http.Error(w, "unknown key", http.StatusUnauthorized)
+			return
+		}
+		key, err := db.ActorKey(author.ID)
+		if err == nil {
+			err = activitypub.Verify(req, body, &key.PublicKey)
+		}
+		if err != nil {
+			t.Errorf("invalid signature: %v", err)
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		r.mu.Lock()
+		r.deliveries = append(r.deliveries, d)
+		r.mu.Unlock()
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	t.Cleanup(r.Close)
+	return r
+}
+
+// received returns the deliveries so far
+func (r *remoteInboxes) received() []delivery {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.deliveries)
+}
+
+// handleEvents hands the events after a sequence to a consumer
+func handleEvents(t *testing.T, db *model.Database, after int64, c Consumer) {
+	t.Helper()
+	events, _ := db.ReadOutbox(after, 1000)
+	for _, event := range events {
+		if err := c.Handle(context.Background(), event); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestFederatorDeliversPublicPosts(t *testing.T) {
+	db := model.NewDatabase()
+	cfg := DefaultFederationConfig()
+	cfg.BaseURL = "https://paper.example"
+	cfg.Timeout = 5 * time.Second
+	cfg.Workers = 1 // Deliveries arrive in order
+	cfg.AllowPrivateNetworks = true
+	federator := NewFederator(db, cfg)
+	remote := newRemoteInboxes(t, db, federator.iris)
+
+	// Two of Alice's followers share their server's inbox; Bob's and
+	// Charlie's followers are on servers without one
+	for _, actor := range []string{"x", "y"} {
+		if _, err := db.AddRemoteFollower("user1", model.RemoteFollower{
+			ActorID:     remote.URL + "/users/" + actor,
+			Inbox:       remote.URL + "/users/" + actor + "/inbox",
+			SharedInbox: remote.URL + "/inbox",
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for userID, actor := range map[string]string{"user2": "z", "user3": "w"} {
+		if _, err := db.AddRemoteFollower(userID, model.RemoteFollower{
+			ActorID: remote.URL + "/users/" + actor,
+			Inbox:   remote.URL + "/users/" + actor + "/inbox",
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go federator.Run(ctx)
+
+	// Bob's public post goes to his follower's own inbox
+	after := db.NextEventSequence - 1
+	if _, err := db.CreatePost("user2", "Hello from Bob", model.PostOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	handleEvents(t, db, after, federator)
+	waitFor(t, "Bob's post to be delivered", func() bool { return len(remote.received()) == 1 })
+	if d := remote.received()[0]; d.inbox != "/users/z/inbox" || d.activity.Type != "Create" || !strings.Contains(d.note.Content, "Hello from Bob") {
+		t.Fatalf("delivered %s %q to %s, want Create of Bob's note to /users/z/inbox", d.activity.Type, d.note.Content, d.inbox)
+	}
+
+	// Posts that aren't public, and posts by accounts that became protected,
+	// are never sent
+	after = db.NextEventSequence - 1
+	for _, visibility := range []model.Visibility{model.VisibilityFollowers, model.VisibilityMentioned} {
+		if _, err := db.CreatePost("user1", "Not for everyone @bob", model.PostOptions{Visibility: visibility}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := db.SetProtected("user2", true); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.CreatePost("user2", "Followers only now", model.PostOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	post, err := db.CreatePost("user1", "Hello, fediverse", model.PostOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.CreatePost("user3", "Hello from Charlie", model.PostOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	handleEvents(t, db, after, federator)
+
+	// Alice's public post goes once to the shared inbox. Charlie's was
+	// queued last, so anything else sent arrives before it.
+	waitFor(t, "Charlie's post to be delivered", func() bool {
+		received := remote.received()
+		return len(received) > 1 && received[len(received)-1].inbox == "/users/w/inbox"
+	})
+	deliveries := remote.received()[1:]
+	if len(deliveries) != 2 {
+		t.Fatalf("got %d more deliveries, want Alice's and Charlie's public posts", len(deliveries))
+	}
+	d := deliveries[0]
+	alice := federator.iris.Actor("alice")
+	if d.inbox != "/inbox" || d.activity.Type != "Create" || d.activity.Actor != alice {
+		t.Errorf("delivered %s by %s to %s, want Create by %s to /inbox", d.activity.Type, d.activity.Actor, d.inbox, alice)
+	}
+	if d.note.ID != federator.iris.Post("alice", post.ID) || d.note.AttributedTo != alice || !strings.Contains(d.note.Content, "Hello, fediverse") {
+		t.Errorf("note = %+v", d.note)
+	}
+	if !slices.Contains(d.note.To, activitypub.Public) {
+		t.Errorf("note is addressed to %v, want the public", d.note.To)
+	}
+}
@@ -0,0 +1,157 @@
+package postservice
+
+import (
+	"context"
+	"log"
+
+	"github.com/paper-social/feed-service/model"
+	"github.com/paper-social/feed-service/proto/user"
+)
+
+// toProtoList converts a list to its proto representation. Members that no
+// longer exist are left out.
+func (s *UserServer) toProtoList(l *model.List) *user.List {
+	pbList := &user.List{
+		Id:          l.ID,
+		Name:        l.Name,
+		Description: l.Description,
+		Private:     l.Private,
+		Members:     make([]*user.User, 0, len(l.MemberIDs)),
+		CreatedAt:   l.CreatedAt.Unix(),
+	}
+	if owner := s.db.GetUserByID(l.OwnerID); owner != nil {
+		pbList.Owner = s.toProtoUser(owner)
+	}
+	for _, id := range l.MemberIDs {
+		if u := s.db.GetUserByID(id); u != nil {
+			pbList.Members = append(pbList.Members, s.toProtoUser(u))
+		}
+	}
+	return pbList
+}
+
+// CreateList implements the gRPC method to create a list
+func (s *UserServer) CreateList(ctx context.Context, req *user.CreateListRequest) (*user.List, error) {
+	log.Printf("User %s creating list: %s", req.UserId, req.Name)
+
+	list, err := s.db.CreateList(req.UserId, req.Name, req.Description, req.Private)
+	if err != nil {
+		log.Printf("Error creating list: %v", err)
+		return nil, err
+	}
+
+	return s.toProtoList(list), nil
+}
+
+// UpdateList implements the gRPC method to update a list
+func (s *UserServer) UpdateList(ctx context.Context, req *user.UpdateListRequest) (*user.List, error) {
+	log.Printf("User %s updating list: %s", req.UserId, req.ListId)
+
+	list, err := s.db.UpdateList(req.UserId, req.ListId, req.Name, req.Description, req.Private)
+	if err != nil {
+		log.Printf("Error updating list: %v", err)
+		return nil, err
+	}
+
+	return s.toProtoList(list), nil
+}
+
+// DeleteList implements the gRPC method to delete a list
+func (s *UserServer) DeleteList(ctx context.Context, req *user.DeleteListRequest) (*user.List, error) {
+	log.Printf("User %s deleting list: %s", req.UserId, req.ListId)
+
+	list, err := s.db.DeleteList(req.UserId, req.ListId)
+	if err != nil {
+		log.Printf("Error deleting list: %v", err)
+		return nil, err
+	}
+
+	return s.toProtoList(list), nil
+}
+
+// AddListMember implements the gRPC method to add an account to a list
+func (s *UserServer) AddListMember(ctx context.Context, req *user.ListMemberRequest) (*user.List, error) {
+	log.Printf("User %s adding %s to list: %s", req.UserId, req.MemberId, req.ListId)
+
+	list, err := s.db.AddListMember(req.UserId, req.ListId, req.MemberId)
+	if err != nil {
+		log.Printf("Error adding list member: %v", err)
+		return nil, err
+	}
+
+	return s.toProtoList(list), nil
+}
+
+// RemoveListMember implements the gRPC method to remove an account from a list
+func (s *UserServer) RemoveListMember(ctx context.Context, req *user.ListMemberRequest) (*user.List, error) {
+	log.Printf("User %s removing %s from list: %s", req.UserId, req.MemberId, req.ListId)
+
+	list, err := s.db.RemoveListMember(req.UserId, req.ListId, req.MemberId)
+	if err != nil {
+		log.Printf("Error removing list member: %v", err)
+		return nil, err
+	}
+
+	return s.toProtoList(list), nil
+}
+
+// GetList implements the gRPC method to get a list
+func (s *UserServer) GetList(ctx context.Context, req *user.GetListRequest) (*user.List, error) {
+	log.Printf("Received request for list: %s", req.ListId)
+
+	list, err := s.db.GetList(req.ListId, req.ViewerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toProtoList(list), nil
+}
+
+// ListUserLists implements the gRPC method to list the lists a user owns
+func (s *UserServer) ListUserLists(ctx context.Context, req *user.ListUserListsRequest) (*user.ListUserListsResponse, error) {
+	log.Printf("Received request for lists of user: %s", req.UserId)
+
+	lists := s.db.GetListsByUser(req.UserId, req.ViewerId)
+
+	pbLists := make([]*user.List, 0, len(lists))
+	for _, l := range lists {
+		pbLists = append(pbLists, s.toProtoList(l))
+	}
+
+	return &user.ListUserListsResponse{Lists: pbLists}, nil
+}
+
+// CreateList calls the user service to create a list
+func (c *Client) CreateList(ctx context.Context, req *user.CreateListRequest) (*user.List, error) {
+	return c.users.CreateList(ctx, req)
+}
+
+// UpdateList calls the user service to update a list
+func (c *Client) UpdateList(ctx context.Context, req *user.UpdateListRequest) (*user.List, error) {
+	return c.users.UpdateList(ctx, req)
+}
+
+// DeleteList calls the user service to delete a list
+func (c *Client) DeleteList(ctx context.Context, req *user.DeleteListRequest) (*user.List, error) {
+	return c.users.DeleteList(ctx, req)
+}
+
+// AddListMember calls the user service to add an account to a list
+func (c *Client) AddListMember(ctx context.Context, req *user.ListMemberRequest) (*user.List, error) {
+	return c.users.AddListMember(ctx, req)
+}
+
+// RemoveListMember calls the user service to remove an account from a list
+func (c *Client) RemoveListMember(ctx context.Context, req *user.ListMemberRequest) (*user.List, error) {
+	return c.users.RemoveListMember(ctx, req)
+}
+
+// GetList calls the user service to get a list
+func (c *Client) GetList(ctx context.Context, req *user.GetListRequest) (*user.List, error) {
+	return c.users.GetList(ctx, req)
+}
+
+// ListUserLists calls the user service to list the lists a user owns
+func (c *Client) ListUserLists(ctx context.Context, req *user.ListUserListsRequest) (*user.ListUserListsResponse, error) {
+	return c.users.ListUserLists(ctx, req)
+}
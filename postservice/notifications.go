@@ -0,0 +1,250 @@
+package postservice
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	"github.com/paper-social/feed-service/model"
+	"github.com/paper-social/feed-service/proto/user"
+	"google.golang.org/grpc"
+)
+
+// notificationWatcherBuffer is how many pushes a notification watcher may
+// fall behind before further ones are dropped
+const notificationWatcherBuffer = 16
+
+// notificationKinds maps model notification kinds to their proto enum values
+var notificationKinds = map[model.NotificationKind]user.NotificationKind{
+	model.NotifyFollow:        user.NotificationKind_NOTIFICATION_KIND_FOLLOW,
+	model.NotifyFollowRequest: user.NotificationKind_NOTIFICATION_KIND_FOLLOW_REQUEST,
+	model.NotifyMention:       user.NotificationKind_NOTIFICATION_KIND_MENTION,
+	model.NotifyPollVote:      user.NotificationKind_NOTIFICATION_KIND_POLL_VOTE,
+	model.NotifyLike:          user.NotificationKind_NOTIFICATION_KIND_LIKE,
+	model.NotifyReply:         user.NotificationKind_NOTIFICATION_KIND_REPLY,
+	model.NotifyRepost:        user.NotificationKind_NOTIFICATION_KIND_REPOST,
+}
+
+// Notifier turns domain events into notifications and pushes them to the
+// recipients' WatchNotifications streams
+type Notifier struct {
+	db *model.Database
+
+	mu       sync.Mutex
+	watchers map[string]map[chan *model.Notification]bool // Recipient ID -> streams
+}
+
+// NewNotifier creates a notifier with no watchers
+func NewNotifier(db *model.Database) *Notifier {
+	return &Notifier{
+		db:       db,
+		watchers: make(map[string]map[chan *model.Notification]bool),
+	}
+}
+
+// reactionKinds maps the events of users reacting to a post to the kind
+// of notification its author gets
+var reactionKinds = map[model.EventType]model.NotificationKind{
+	model.EventPollVoted:    model.NotifyPollVote,
+	model.EventPostLiked:    model.NotifyLike,
+	model.EventPostReplied:  model.NotifyReply,
+	model.EventPostReposted: model.NotifyRepost,
+}
+
+// Handle implements Consumer. Follows and follow requests notify the
+// followed account; likes, replies, reposts and poll votes notify the
+// author of the post, grouped per post. New posts notify the users they
+// mention and who can see them. Edits don't notify, so fixing a typo
+// doesn't mention anyone twice, and neither does taking back a like or
+// repost.
+func (n *Notifier) Handle(ctx context.Context, event model.Event) error {
+	var activity []model.NotificationActivity
+	switch event.Type {
+	case model.EventUserFollowed:
+		activity = append(activity, model.NotificationActivity{UserID: event.SubjectID, Kind: model.NotifyFollow, ActorID: event.ActorID, At: event.OccurredAt})
+	case model.EventFollowRequested:
+		activity = append(activity, model.NotificationActivity{UserID: event.SubjectID, Kind: model.NotifyFollowRequest, ActorID: event.ActorID, At: event.OccurredAt})
+	case model.EventPollVoted, model.EventPostLiked, model.EventPostReplied, model.EventPostReposted:
+		activity = append(activity, model.NotificationActivity{UserID: event.SubjectID, Kind: reactionKinds[event.Type], ActorID: event.ActorID, PostID: event.PostID, At: event.OccurredAt})
+	case model.EventPostCreated:
+		activity = n.mentionActivity(event)
+	}
+	if len(activity) == 0 {
+		return nil
+	}
+
+	for _, notification := range n.db.AddNotifications(event.Sequence, activity) {
+		n.push(notification)
+	}
+	return nil
+}
+
+// mentionActivity returns a mention for each user a new post mentions
+// that can see it
+func (n *Notifier) mentionActivity(event model.Event) []model.NotificationActivity {
+	var activity []model.NotificationActivity
+	seen := make(map[string]bool)
+	for _, e := range event.Post.Entities {
+		if e.Type != model.EntityMention {
+			continue
+		}
+		mentioned := n.db.GetUserByUsername(e.Value)
+		if mentioned == nil || seen[mentioned.ID] || !n.db.CanView(mentioned.ID, event.Post) {
+			continue
+		}
+		seen[mentioned.ID] = true
+
+		activity = append(activity, model.NotificationActivity{
+			UserID:  mentioned.ID,
+			Kind:    model.NotifyMention,
+			ActorID: event.ActorID,
+			PostID:  event.PostID,
+			At:      event.OccurredAt,
+		})
+	}
+	return activity
+}
+
+// push sends a notification to its recipient's watchers, as the recipient
+// would see it listed. A watcher that is behind misses the push.
+func (n *Notifier) push(notification *model.Notification) {
+	visible := n.db.VisibleNotification(notification)
+	if visible == nil {
+		return
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	for w := range n.watchers[visible.UserID] {
+		select {
+		case w <- visible:
+		default:
+		}
+	}
+}
+
+// watch registers a stream for userID's notifications
+func (n *Notifier) watch(userID string) chan *model.Notification {
+	w := make(chan *model.Notification, notificationWatcherBuffer)
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.watchers[userID] == nil {
+		n.watchers[userID] = make(map[chan *model.Notification]bool)
+	}
+	n.watchers[userID][w] = true
+	return w
+}
+
+// unwatch removes a stream registered with watch
+func (n *Notifier) unwatch(userID string, w chan *model.Notification) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	delete(n.watchers[userID], w)
+	if len(n.watchers[userID]) == 0 {
+		delete(n.watchers, userID)
+	}
+}
+
+// toProtoNotification converts a notification to its proto representation
+func toProtoNotification(n *model.Notification) *user.Notification {
+	return &user.Notification{
+		Id:        n.ID,
+		UserId:    n.UserID,
+		Kind:      notificationKinds[n.Kind],
+		ActorIds:  n.ActorIDs,
+		PostId:    n.PostID,
+		CreatedAt: n.CreatedAt.Unix(),
+		UpdatedAt: n.UpdatedAt.Unix(),
+		Read:      n.Read,
+	}
+}
+
+// ListNotifications implements the gRPC method to page through a user's
+// notifications
+func (s *UserServer) ListNotifications(ctx context.Context, req *user.ListNotificationsRequest) (*user.NotificationPage, error) {
+	log.Printf("Listing notifications of user %s", req.UserId)
+
+	page, err := s.db.GetNotifications(req.UserId, int(req.First), req.After)
+	if err != nil {
+		log.Printf("Error listing notifications: %v", err)
+		return nil, err
+	}
+
+	pbPage := &user.NotificationPage{
+		Notifications: make([]*user.Notification, 0, len(page.Notifications)),
+		EndCursor:     page.EndCursor,
+		HasNextPage:   page.HasNextPage,
+	}
+	for _, n := range page.Notifications {
+		pbPage.Notifications = append(pbPage.Notifications, toProtoNotification(n))
+	}
+	return pbPage, nil
+}
+
+// GetUnreadNotificationCount implements the gRPC method to count a user's
+// unread notifications
+func (s *UserServer) GetUnreadNotificationCount(ctx context.Context, req *user.UnreadNotificationCountRequest) (*user.UnreadNotificationCount, error) {
+	log.Printf("Counting unread notifications of user %s", req.UserId)
+
+	return &user.UnreadNotificationCount{Count: int32(s.db.UnreadNotificationCount(req.UserId))}, nil
+}
+
+// MarkNotificationsRead implements the gRPC method to mark notifications
+// read
+func (s *UserServer) MarkNotificationsRead(ctx context.Context, req *user.MarkNotificationsReadRequest) (*user.UnreadNotificationCount, error) {
+	log.Printf("Marking %d notifications of user %s read", len(req.Ids), req.UserId)
+
+	unread, err := s.db.MarkNotificationsRead(req.UserId, req.Ids)
+	if err != nil {
+		log.Printf("Error marking notifications read: %v", err)
+		return nil, err
+	}
+	return &user.UnreadNotificationCount{Count: int32(unread)}, nil
+}
+
+// WatchNotifications implements the gRPC method to stream a user's
+// notifications as they change
+func (s *UserServer) WatchNotifications(req *user.WatchNotificationsRequest, stream grpc.ServerStreamingServer[user.Notification]) error {
+	log.Printf("User %s watching notifications", req.UserId)
+
+	w := s.notifier.watch(req.UserId)
+	defer s.notifier.unwatch(req.UserId, w)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case n := <-w:
+			if err := stream.Send(toProtoNotification(n)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// ListNotifications calls the user service to get a page of a user's
+// notifications
+func (c *Client) ListNotifications(ctx context.Context, req *user.ListNotificationsRequest) (*user.NotificationPage, error) {
+	return c.users.ListNotifications(ctx, req)
+}
+
+// GetUnreadNotificationCount calls the user service to count a user's
+// unread notifications
+func (c *Client) GetUnreadNotificationCount(ctx context.Context, req *user.UnreadNotificationCountRequest) (*user.UnreadNotificationCount, error) {
+	return c.users.GetUnreadNotificationCount(ctx, req)
+}
+
+// MarkNotificationsRead calls the user service to mark notifications read
+func (c *Client) MarkNotificationsRead(ctx context.Context, req *user.MarkNotificationsReadRequest) (*user.UnreadNotificationCount, error) {
+	return c.users.MarkNotificationsRead(ctx, req)
+}
+
+// WatchNotifications calls the user service to stream a user's
+// notifications. The stream ends when ctx is cancelled.
+func (c *Client) WatchNotifications(ctx context.Context, req *user.WatchNotificationsRequest) (grpc.ServerStreamingClient[user.Notification], error) {
+	return c.users.WatchNotifications(ctx, req)
+}
@@ -0,0 +1,122 @@
+package postservice
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/paper-social/feed-service/model"
+)
+
+// notifyAll hands every event in the outbox to the notifier
+func notifyAll(t *testing.T, db *model.Database, n *Notifier) {
+	t.Helper()
+	events, _ := db.ReadOutbox(0, 1000)
+	for _, event := range events {
+		if err := n.Handle(context.Background(), event); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestNotifierPollVotes(t *testing.T) {
+	db := model.NewDatabase()
+	notifier := NewNotifier(db)
+
+	poll, err := db.CreatePost("user1", "Tabs or spaces?", model.PostOptions{
+		Poll: &model.Poll{Options: []string{"Tabs", "Spaces"}, ExpiresAt: time.Now().Add(time.Hour)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The author's own vote doesn't notify them
+	for _, voterID := range []string{"user2", "user1", "user3"} {
+		if err := db.VotePoll(poll.ID, voterID, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	notifyAll(t, db, notifier)
+
+	page, err := db.GetNotifications("user1", 10, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var votes []*model.Notification
+	for _, n := range page.Notifications {
+		if n.Kind == model.NotifyPollVote {
+			votes = append(votes, n)
+		}
+	}
+	if len(votes) != 1 {
+		t.Fatalf("got %d poll vote notifications, want 1 grouping both votes", len(votes))
+	}
+	if votes[0].PostID != poll.ID || !slices.Equal(votes[0].ActorIDs, []string{"user3", "user2"}) {
+		t.Errorf("notification = %+v, want votes by user3 and user2 on %s", votes[0], poll.ID)
+	}
+
+	// Events delivered again don't notify twice
+	notifyAll(t, db, notifier)
+	if unread := db.UnreadNotificationCount("user1"); unread != len(page.Notifications) {
+		t.Errorf("unread count went from %d to %d on redelivery", len(page.Notifications), unread)
+	}
+}
+
+func TestNotifierReactions(t *testing.T) {
+	db := model.NewDatabase()
+	notifier := NewNotifier(db)
+
+	liked, err := db.CreatePost("user1", "Lunch spot recommendations?", model.PostOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, userID := range []string{"user2", "user1", "user3"} {
+		if _, err := db.LikePost(userID, liked.ID); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Taking a like back doesn't notify, and liking again doesn't notify
+	// twice
+	if _, err := db.UnlikePost("user2", liked.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.LikePost("user2", liked.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.RepostPost("user5", liked.ID); err != nil {
+		t.Fatal(err)
+	}
+	for _, userID := range []string{"user2", "user3"} {
+		if _, err := db.CreatePost(userID, "The noodle place", model.PostOptions{ReplyToID: liked.ID}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	notifyAll(t, db, notifier)
+
+	page, err := db.GetNotifications("user1", 10, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := make(map[model.NotificationKind][]string)
+	for _, n := range page.Notifications {
+		if n.PostID != liked.ID {
+			continue
+		}
+		if _, seen := got[n.Kind]; seen {
+			t.Errorf("more than one %s notification on %s", n.Kind, liked.ID)
+		}
+		got[n.Kind] = n.ActorIDs
+	}
+
+	want := map[model.NotificationKind][]string{
+		model.NotifyLike:   {"user2", "user3"},
+		model.NotifyRepost: {"user5"},
+		model.NotifyReply:  {"user3", "user2"},
+	}
+	for kind, actors := range want {
+		if !slices.Equal(got[kind], actors) {
+			t.Errorf("%s notification actors = %v, want %v", kind, got[kind], actors)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package postservice
+
+import (
+	"context"
+	"log"
+
+	"github.com/paper-social/feed-service/model"
+	"github.com/paper-social/feed-service/proto/post"
+)
+
+// RankTimeline implements the gRPC method to rank a user's "For You"
+// timeline
+func (s *Server) RankTimeline(ctx context.Context, req *post.RankTimelineRequest) (*post.ListPostsResponse, error) {
+	log.Printf("Ranking timeline for user: %s", req.UserId)
+
+	now := s.now()
+	candidates, err := s.db.TimelineCandidates(req.UserId, now)
+	if err != nil {
+		log.Printf("Error ranking timeline: %v", err)
+		return nil, err
+	}
+	posts := model.RankTimeline(candidates, s.ranker, now, model.ClampPageSize(int(req.First)))
+
+	pbPosts := make([]*post.Post, 0, len(posts))
+	for _, p := range posts {
+		pbPosts = append(pbPosts, s.toProtoPost(p, req.UserId))
+	}
+
+	return &post.ListPostsResponse{Posts: pbPosts}, nil
+}
+
+// RankTimeline calls the post service to rank a user's "For You" timeline
+func (c *Client) RankTimeline(ctx context.Context, req *post.RankTimelineRequest) (*post.ListPostsResponse, error) {
+	return c.client.RankTimeline(ctx, req)
+}
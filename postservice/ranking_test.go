@@ -0,0 +1,68 @@
+package postservice
+
+import (
+	"context"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/paper-social/feed-service/model"
+	"github.com/paper-social/feed-service/proto/post"
+)
+
+func TestRankTimelineUsesServerClock(t *testing.T) {
+	db := model.NewDatabase()
+	s := NewServer(db, newTestPreviews(t), NewFileDraftStore(filepath.Join(t.TempDir(), "drafts.json")))
+
+	// Date the mock posts around a fixed time: Bob's newest is an hour
+	// ahead of it and his oldest has left the ranking window
+	now := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+	for _, p := range db.AllPublishedPosts() {
+		p.CreatedAt = now.Add(-3 * time.Hour)
+	}
+	db.PostsByID["post3"].CreatedAt = now.Add(time.Hour)
+	db.PostsByID["post4"].CreatedAt = now.Add(-model.RankingWindow - time.Hour)
+	db.PostsByID["post7"].CreatedAt = now.Add(-time.Minute)
+
+	rank := func(at time.Time) []string {
+		t.Helper()
+		s.now = func() time.Time { return at }
+		resp, err := s.RankTimeline(context.Background(), &post.RankTimelineRequest{UserId: "user1", First: 20})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids := make([]string, len(resp.Posts))
+		for i, p := range resp.Posts {
+			ids[i] = p.Id
+		}
+		return ids
+	}
+
+	// Ranked as of the server's clock, not the wall clock
+	got := rank(now)
+	candidates, err := db.TimelineCandidates("user1", now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want []string
+	for _, p := range model.RankTimeline(candidates, model.DefaultRanker(), now, 20) {
+		want = append(want, p.ID)
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("ranked %v, want %v", got, want)
+	}
+	if len(got) == 0 || got[0] != "post7" || slices.Contains(got, "post3") || slices.Contains(got, "post4") {
+		t.Errorf("ranked %v, want post7 first and neither post3 nor post4", got)
+	}
+
+	// Two hours later Bob's newest post is in, and the newest of all
+	if got := rank(now.Add(2 * time.Hour)); len(got) == 0 || got[0] != "post3" {
+		t.Errorf("ranked %v two hours later, want post3 first", got)
+	}
+
+	// Once the window has passed, nothing is left
+	if got := rank(now.Add(model.RankingWindow + 2*time.Hour)); len(got) != 0 {
+		t.Errorf("ranked %v after the ranking window, want nothing", got)
+	}
+}
@@ -0,0 +1,64 @@
+package postservice
+
+import (
+	"context"
+	"log"
+
+	"github.com/paper-social/feed-service/model"
+	"github.com/paper-social/feed-service/proto/post"
+)
+
+// LikePost implements the gRPC method to like a post
+func (s *Server) LikePost(ctx context.Context, req *post.ReactionRequest) (*post.Post, error) {
+	log.Printf("User %s liking post: %s", req.UserId, req.PostId)
+	return s.react(req, "liking", s.db.LikePost)
+}
+
+// UnlikePost implements the gRPC method to take back a like
+func (s *Server) UnlikePost(ctx context.Context, req *post.ReactionRequest) (*post.Post, error) {
+	log.Printf("User %s unliking post: %s", req.UserId, req.PostId)
+	return s.react(req, "unliking", s.db.UnlikePost)
+}
+
+// RepostPost implements the gRPC method to repost a post
+func (s *Server) RepostPost(ctx context.Context, req *post.ReactionRequest) (*post.Post, error) {
+	log.Printf("User %s reposting post: %s", req.UserId, req.PostId)
+	return s.react(req, "reposting", s.db.RepostPost)
+}
+
+// UndoRepost implements the gRPC method to take back a repost
+func (s *Server) UndoRepost(ctx context.Context, req *post.ReactionRequest) (*post.Post, error) {
+	log.Printf("User %s undoing repost of post: %s", req.UserId, req.PostId)
+	return s.react(req, "undoing repost of", s.db.UndoRepost)
+}
+
+// react applies a reaction and returns the post as the reacting user now
+// sees it
+func (s *Server) react(req *post.ReactionRequest, action string, apply func(userID, postID string) (*model.Post, error)) (*post.Post, error) {
+	p, err := apply(req.UserId, req.PostId)
+	if err != nil {
+		log.Printf("Error %s post: %v", action, err)
+		return nil, err
+	}
+	return s.toProtoPost(p, req.UserId), nil
+}
+
+// LikePost calls the post service to like a post
+func (c *Client) LikePost(ctx context.Context, req *post.ReactionRequest) (*post.Post, error) {
+	return c.client.LikePost(ctx, req)
+}
+
+// UnlikePost calls the post service to take back a like
+func (c *Client) UnlikePost(ctx context.Context, req *post.ReactionRequest) (*post.Post, error) {
+	return c.client.UnlikePost(ctx, req)
+}
+
+// RepostPost calls the post service to repost a post
+func (c *Client) RepostPost(ctx context.Context, req *post.ReactionRequest) (*post.Post, error) {
+	return c.client.RepostPost(ctx, req)
+}
+
+// UndoRepost calls the post service to take back a repost
+func (c *Client) UndoRepost(ctx context.Context, req *post.ReactionRequest) (*post.Post, error) {
+	return c.client.UndoRepost(ctx, req)
+}
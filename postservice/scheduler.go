@@ -0,0 +1,52 @@
+package postservice
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// RunScheduler publishes scheduled posts as they become due, checking every
+// interval until ctx is cancelled
+func (s *Server) RunScheduler(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	// Catch up on anything that fell due while the service was down
+	s.publishDuePosts(time.Now())
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.publishDuePosts(now)
+		}
+	}
+}
+
+// publishDuePosts publishes due posts and then persists the remaining
+// unpublished posts. If the service stops between the two steps the
+// in-memory publish is lost with it, and the post is published again from
+// the saved schedule on restart, so it still goes out exactly once.
+func (s *Server) publishDuePosts(now time.Time) {
+	published := s.db.PublishDuePosts(now)
+	if len(published) == 0 {
+		return
+	}
+
+	for _, p := range published {
+		log.Printf("Published scheduled post %s for user %s", p.ID, p.UserID)
+		s.previews.Enqueue(p.LinkURLs()...)
+	}
+
+	s.saveDrafts()
+}
+
+// saveDrafts persists drafts, scheduled posts and the next post ID,
+// logging failures since the in-memory state is already updated
+func (s *Server) saveDrafts() {
+	if err := s.drafts.save(); err != nil {
+		log.Printf("Error saving drafts: %v", err)
+	}
+}
@@ -0,0 +1,192 @@
+package postservice
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/paper-social/feed-service/model"
+	"github.com/paper-social/feed-service/proto/post"
+	"github.com/paper-social/feed-service/search"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PostIndex is the event bus consumer that keeps the full-text index of
+// published posts in step with posts being created, edited and deleted.
+// The index holds every published post; who may see a result is checked
+// when searching.
+type PostIndex struct {
+	index *search.Index
+}
+
+// NewPostIndex creates an index of the posts already published in db.
+// Events recorded from then on must reach it, so it is registered with
+// the event bus before posts can be written.
+func NewPostIndex(db *model.Database, cfg search.Config) *PostIndex {
+	pi := &PostIndex{index: search.NewIndex(cfg)}
+	for _, p := range db.AllPublishedPosts() {
+		pi.index.Add(searchDocument(p))
+	}
+	log.Printf("Indexed %d posts for search", pi.index.Len())
+	return pi
+}
+
+// Handle implements Consumer. Indexing a post replaces what was indexed
+// for it before, so events handled twice do no harm.
+func (pi *PostIndex) Handle(ctx context.Context, event model.Event) error {
+	switch event.Type {
+	case model.EventPostCreated, model.EventPostUpdated:
+		pi.index.Add(searchDocument(event.Post))
+	case model.EventPostDeleted:
+		pi.index.Remove(event.PostID)
+	}
+	return nil
+}
+
+// searchDocument converts a post to what the index is made of
+func searchDocument(p *model.Post) search.Document {
+	doc := search.Document{
+		ID:        p.ID,
+		AuthorID:  p.UserID,
+		Text:      p.Content,
+		CreatedAt: p.CreatedAt,
+	}
+
+	has := make(map[search.Feature]bool)
+	for _, m := range p.Media {
+		has[search.FeatureMedia] = true
+		if strings.HasPrefix(m.ContentType, "image/") {
+			has[search.FeatureImage] = true
+		}
+	}
+	for _, e := range p.Entities {
+		switch e.Type {
+		case model.EntityImageLink:
+			has[search.FeatureImage] = true
+			has[search.FeatureLink] = true
+		case model.EntityLink:
+			has[search.FeatureLink] = true
+		}
+	}
+	if p.Poll != nil {
+		has[search.FeaturePoll] = true
+	}
+	for _, f := range []search.Feature{search.FeatureImage, search.FeatureMedia, search.FeatureLink, search.FeaturePoll} {
+		if has[f] {
+			doc.Features = append(doc.Features, f)
+		}
+	}
+	return doc
+}
+
+// SearchPosts implements the gRPC method to search published posts. Only
+// posts the viewer may see are returned, best match first.
+func (s *Server) SearchPosts(ctx context.Context, req *post.SearchPostsRequest) (*post.PostPage, error) {
+	log.Printf("Searching posts for %q", req.Query)
+
+	query, err := search.ParseQuery(req.Query)
+	if err != nil {
+		log.Printf("Error searching posts: %v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Later pages are ranked as the first was, so scores stay comparable
+	// with the cursor
+	var (
+		ranking search.Ranking
+		cursor  *search.Cursor
+	)
+	if req.After != "" {
+		c, err := search.DecodeCursor(req.After)
+		if err != nil {
+			log.Printf("Error searching posts: %v", err)
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		cursor = &c
+		ranking = c.Ranking
+	} else {
+		ranking = s.search.index.RankingFor(query, time.Now())
+	}
+
+	if query.From != "" {
+		author := s.db.GetUserByUsername(query.From)
+		if author == nil {
+			return &post.PostPage{}, nil
+		}
+		query.AuthorID = author.ID
+	}
+
+	hits := s.search.index.Search(query, ranking)
+	if cursor != nil {
+		start := len(hits)
+		for i, hit := range hits {
+			if cursor.After(hit) {
+				start = i
+				break
+			}
+		}
+		hits = hits[start:]
+	}
+
+	page := s.visibleHits(hits, req.ViewerId, model.ClampPageSize(int(req.First)))
+
+	pbPage := &post.PostPage{
+		Posts:       make([]*post.Post, 0, len(page.posts)),
+		HasNextPage: page.hasNextPage,
+	}
+	for _, p := range page.posts {
+		pbPage.Posts = append(pbPage.Posts, s.toProtoPost(p, req.ViewerId))
+	}
+	if len(page.posts) > 0 {
+		pbPage.EndCursor = search.CursorFor(page.last, ranking).Encode()
+	}
+	return pbPage, nil
+}
+
+// hitPage is a page of search results
+type hitPage struct {
+	posts       []*model.Post
+	last        search.Hit // Of the last post, for the end cursor
+	hasNextPage bool
+}
+
+// visibleHits takes up to first of the hits that viewerID may see, in
+// order. Hits are looked up a page at a time, since most are usually
+// visible.
+func (s *Server) visibleHits(hits []search.Hit, viewerID string, first int) *hitPage {
+	page := &hitPage{}
+	for len(hits) > 0 {
+		n := min(first+1, len(hits))
+		ids := make([]string, n)
+		for i, hit := range hits[:n] {
+			ids[i] = hit.ID
+		}
+
+		// Hits that can't be seen, or were deleted since, are left out
+		visible := make(map[string]*model.Post)
+		for _, p := range s.db.GetPostsByIDs(ids, viewerID) {
+			visible[p.ID] = p
+		}
+		for _, hit := range hits[:n] {
+			p, exists := visible[hit.ID]
+			if !exists {
+				continue
+			}
+			if len(page.posts) == first {
+				page.hasNextPage = true
+				return page
+			}
+			page.posts = append(page.posts, p)
+			page.last = hit
+		}
+		hits = hits[n:]
+	}
+	return page
+}
+
+// SearchPosts calls the post service to search published posts
+func (c *Client) SearchPosts(ctx context.Context, req *post.SearchPostsRequest) (*post.PostPage, error) {
+	return c.client.SearchPosts(ctx, req)
+}
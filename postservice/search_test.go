@@ -0,0 +1,113 @@
+package postservice
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/paper-social/feed-service/model"
+	"github.com/paper-social/feed-service/proto/post"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestSearchPostsPagesVisibleResults pages through results where posts the
+// viewer can't see are mixed in with those they can, writing posts between
+// pages
+func TestSearchPostsPagesVisibleResults(t *testing.T) {
+	ctx := context.Background()
+	db := model.NewDatabase()
+	s := NewServer(db, newTestPreviews(t), NewFileDraftStore(filepath.Join(t.TempDir(), "drafts.json")))
+	client := startTestServer(t, s)
+
+	// Alice doesn't follow Eve, so Eve's followers-only posts and posts for
+	// the people they mention are hidden from her, and Bob blocks her
+	if _, err := db.BlockUser("user2", "user1"); err != nil {
+		t.Fatal(err)
+	}
+	after := db.NextEventSequence - 1
+	visible := make(map[string]bool)
+	for i := range 20 {
+		var (
+			userID     = "user5"
+			visibility = model.VisibilityPublic
+			content    = fmt.Sprintf("Lantern festival, day %d", i)
+		)
+		switch i % 4 {
+		case 1:
+			visibility = model.VisibilityFollowers
+		case 2:
+			visibility = model.VisibilityMentioned
+			content += " with @charlie"
+		case 3:
+			userID = "user2"
+		}
+		p, err := db.CreatePost(userID, content, model.PostOptions{Visibility: visibility})
+		if err != nil {
+			t.Fatal(err)
+		}
+		visible[p.ID] = i%4 == 0
+	}
+	handleEvents(t, db, after, s.search)
+
+	var seen []string
+	cursor := ""
+	for pages := 0; ; pages++ {
+		if pages > 20 {
+			t.Fatal("paging didn't end")
+		}
+		page, err := client.SearchPosts(ctx, &post.SearchPostsRequest{Query: "lantern", ViewerId: "user1", First: 2, After: cursor})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, p := range page.Posts {
+			if !visible[p.Id] {
+				t.Errorf("search showed hidden post %s: %q", p.Id, p.Content)
+			}
+			seen = append(seen, p.Id)
+		}
+		if !page.HasNextPage {
+			break
+		}
+		if len(page.Posts) != 2 {
+			t.Errorf("page %d has %d posts, want 2", pages, len(page.Posts))
+		}
+		cursor = page.EndCursor
+
+		// Posts written between pages change the index statistics but
+		// don't shift later pages; the short one that matches ranks above
+		// the cursor
+		next := db.NextEventSequence - 1
+		for _, content := range []string{"Lanterns!", "Nothing to do with the festival, just a great many other words"} {
+			if _, err := db.CreatePost("user3", content, model.PostOptions{}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		handleEvents(t, db, next, s.search)
+	}
+
+	var want []string
+	for id, ok := range visible {
+		if ok {
+			want = append(want, id)
+		}
+	}
+	slices.Sort(want)
+	got := slices.Clone(seen)
+	slices.Sort(got)
+	if !slices.Equal(got, want) {
+		t.Errorf("search results = %v, want each of %v once", seen, want)
+	}
+
+	// Cursors that aren't ours, and unknown authors
+	_, err := client.SearchPosts(ctx, &post.SearchPostsRequest{Query: "lantern", ViewerId: "user1", After: "nonsense"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("invalid cursor = %v, want InvalidArgument", err)
+	}
+	page, err := client.SearchPosts(ctx, &post.SearchPostsRequest{Query: "lantern from:nobody", ViewerId: "user1"})
+	if err != nil || len(page.Posts) != 0 {
+		t.Errorf("from:nobody = %v, %v, want no posts", page, err)
+	}
+}
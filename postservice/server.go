@@ -2,42 +2,122 @@ package postservice
 
 import (
 	"context"
+	"errors"
 	"log"
 	"net"
+	"time"
 
+	"github.com/paper-social/feed-service/linkpreview"
 	"github.com/paper-social/feed-service/model"
 	"github.com/paper-social/feed-service/proto/post"
+	"github.com/paper-social/feed-service/proto/user"
+	"github.com/paper-social/feed-service/search"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 )
 
 // Server implements the post service gRPC server
 type Server struct {
 	post.UnimplementedPostServiceServer
-	db *model.Database
+	db       *model.Database
+	previews *linkpreview.Service
+	drafts   *draftPersister
+	events   *PostEventLog
+	search   *PostIndex
+
+	// ranker scores the "For You" timeline as of now
+	ranker model.Ranker
+	now    func() time.Time
 }
 
-// NewServer creates a new post service server
-func NewServer(db *model.Database) *Server {
-	return &Server{db: db}
+// NewServer creates a new post service server, restoring drafts and
+// scheduled posts saved in the draft store
+func NewServer(db *model.Database, previews *linkpreview.Service, drafts DraftStore) *Server {
+	s := &Server{
+		db:       db,
+		previews: previews,
+		drafts:   &draftPersister{db: db, store: drafts},
+		events:   NewPostEventLog(),
+		ranker:   model.DefaultRanker(),
+		now:      time.Now,
+	}
+
+	saved, err := drafts.Load()
+	if err != nil {
+		log.Printf("Error loading drafts: %v", err)
+	} else if len(saved.Posts) > 0 || saved.NextPostID > 0 {
+		log.Printf("Restored %d drafts and scheduled posts", db.RestoreUnpublishedPosts(saved.Posts, saved.NextPostID))
+	}
+
+	// Index the published posts for search
+	s.search = NewPostIndex(db, search.DefaultConfig())
+
+	// Unfurl links in existing posts so previews are warm on startup
+	for _, p := range db.AllPublishedPosts() {
+		previews.Enqueue(p.LinkURLs()...)
+	}
+
+	return s
 }
 
 // ListPostsByUser implements the gRPC method to list posts by user
 func (s *Server) ListPostsByUser(ctx context.Context, req *post.ListPostsRequest) (*post.ListPostsResponse, error) {
 	log.Printf("Received request for posts of user: %s", req.UserId)
 
+	// Page through the posts if asked to
+	if req.First > 0 || req.After != "" {
+		page, err := s.db.PagePostsByUserID(req.UserId, req.ViewerId, int(req.First), req.After)
+		if err != nil {
+			log.Printf("Error listing posts: %v", err)
+			if errors.Is(err, model.ErrInvalidCursor) {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+			return nil, err
+		}
+
+		pbPage := s.toProtoPostPage(page, req.ViewerId)
+		return &post.ListPostsResponse{
+			Posts:       pbPage.Posts,
+			EndCursor:   pbPage.EndCursor,
+			HasNextPage: pbPage.HasNextPage,
+		}, nil
+	}
+
 	// Get posts for the requested user
-	posts := s.db.GetPostsByUserID(req.UserId)
+	posts := s.db.GetPostsByUserID(req.UserId, req.ViewerId)
 
 	// Convert to proto posts
 	pbPosts := make([]*post.Post, 0, len(posts))
 	for _, p := range posts {
-		pbPosts = append(pbPosts, &post.Post{
-			Id:        p.ID,
-			UserId:    p.UserID,
-			Content:   p.Content,
-			CreatedAt: p.CreatedAt.Unix(),
-		})
+		pbPosts = append(pbPosts, s.toProtoPost(p, req.ViewerId))
+	}
+
+	return &post.ListPostsResponse{Posts: pbPosts}, nil
+}
+
+// GetPost implements the gRPC method to get a single post
+func (s *Server) GetPost(ctx context.Context, req *post.GetPostRequest) (*post.Post, error) {
+	log.Printf("Received request for post: %s", req.Id)
+
+	p, err := s.db.GetPostByID(req.Id, req.ViewerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toProtoPost(p, req.ViewerId), nil
+}
+
+// GetPosts implements the gRPC method to get several posts at once
+func (s *Server) GetPosts(ctx context.Context, req *post.GetPostsRequest) (*post.ListPostsResponse, error) {
+	log.Printf("Received request for %d posts", len(req.Ids))
+
+	posts := s.db.GetPostsByIDs(req.Ids, req.ViewerId)
+
+	pbPosts := make([]*post.Post, 0, len(posts))
+	for _, p := range posts {
+		pbPosts = append(pbPosts, s.toProtoPost(p, req.ViewerId))
 	}
 
 	return &post.ListPostsResponse{Posts: pbPosts}, nil
@@ -48,21 +128,26 @@ func (s *Server) CreatePost(ctx context.Context, req *post.CreatePostRequest) (*
 	log.Printf("Creating post for user: %s", req.UserId)
 
 	// Create the post in the database
-	newPost, err := s.db.CreatePost(req.UserId, req.Content)
+	newPost, err := s.db.CreatePost(req.UserId, req.Content, model.PostOptions{
+		Media:      mediaFromProto(req.Media),
+		Poll:       pollFromProto(req.Poll),
+		Visibility: visibilityFromProto(req.Visibility),
+		ReplyToID:  req.ReplyToId,
+	})
 	if err != nil {
 		log.Printf("Error creating post: %v", err)
 		return nil, err
 	}
 
-	// Convert to proto post
-	pbPost := &post.Post{
-		Id:        newPost.ID,
-		UserId:    newPost.UserID,
-		Content:   newPost.Content,
-		CreatedAt: newPost.CreatedAt.Unix(),
+	// Unfurl links in the background, previews show up once fetched
+	s.previews.Enqueue(newPost.LinkURLs()...)
+
+	// The post took an ID, which must not be issued again after a restart
+	if err := s.drafts.reservePostIDs(); err != nil {
+		log.Printf("Error saving the next post ID: %v", err)
 	}
 
-	return pbPost, nil
+	return s.toProtoPost(newPost, newPost.UserID), nil
 }
 
 // UpdatePost implements the gRPC method to update an existing post
@@ -76,15 +161,12 @@ func (s *Server) UpdatePost(ctx context.Context, req *post.UpdatePostRequest) (*
 		return nil, err
 	}
 
-	// Convert to proto post
-	pbPost := &post.Post{
-		Id:        updatedPost.ID,
-		UserId:    updatedPost.UserID,
-		Content:   updatedPost.Content,
-		CreatedAt: updatedPost.CreatedAt.Unix(),
+	s.previews.Enqueue(updatedPost.LinkURLs()...)
+	if updatedPost.Status != model.PostPublished {
+		s.saveDrafts()
 	}
 
-	return pbPost, nil
+	return s.toProtoPost(updatedPost, updatedPost.UserID), nil
 }
 
 // DeletePost implements the gRPC method to delete a post
@@ -92,7 +174,7 @@ func (s *Server) DeletePost(ctx context.Context, req *post.DeletePostRequest) (*
 	log.Printf("Deleting post: %s", req.Id)
 
 	// Delete the post from the database
-	success, err := s.db.DeletePost(req.Id)
+	_, err := s.db.DeletePost(req.Id)
 	if err != nil {
 		log.Printf("Error deleting post: %v", err)
 		return &post.DeletePostResponse{
@@ -101,14 +183,150 @@ func (s *Server) DeletePost(ctx context.Context, req *post.DeletePostRequest) (*
 		}, nil
 	}
 
+	// The post may have been a draft
+	s.saveDrafts()
+
 	return &post.DeletePostResponse{
-		Success: success,
+		Success: true,
 		Message: "Post deleted successfully",
 	}, nil
 }
 
+// VotePoll implements the gRPC method to vote in a post's poll
+func (s *Server) VotePoll(ctx context.Context, req *post.VotePollRequest) (*post.Post, error) {
+	log.Printf("User %s voting in poll on post: %s", req.UserId, req.PostId)
+
+	if err := s.db.VotePoll(req.PostId, req.UserId, int(req.Option)); err != nil {
+		log.Printf("Error voting in poll: %v", err)
+		return nil, err
+	}
+
+	votedPost, err := s.db.GetPostByID(req.PostId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toProtoPost(votedPost, req.UserId), nil
+}
+
+// SaveDraft implements the gRPC method to save a draft
+func (s *Server) SaveDraft(ctx context.Context, req *post.CreatePostRequest) (*post.Post, error) {
+	log.Printf("Saving draft for user: %s", req.UserId)
+
+	draft, err := s.db.CreatePost(req.UserId, req.Content, model.PostOptions{
+		Media:      mediaFromProto(req.Media),
+		Poll:       pollFromProto(req.Poll),
+		Visibility: visibilityFromProto(req.Visibility),
+		ReplyToID:  req.ReplyToId,
+		Status:     model.PostDraft,
+	})
+	if err != nil {
+		log.Printf("Error saving draft: %v", err)
+		return nil, err
+	}
+	s.saveDrafts()
+
+	return s.toProtoPost(draft, draft.UserID), nil
+}
+
+// SchedulePost implements the gRPC method to schedule a draft
+func (s *Server) SchedulePost(ctx context.Context, req *post.SchedulePostRequest) (*post.Post, error) {
+	log.Printf("Scheduling post %s for %d", req.Id, req.PublishAt)
+
+	scheduled, err := s.db.SchedulePost(req.Id, time.Unix(req.PublishAt, 0))
+	if err != nil {
+		log.Printf("Error scheduling post: %v", err)
+		return nil, err
+	}
+	s.saveDrafts()
+
+	return s.toProtoPost(scheduled, scheduled.UserID), nil
+}
+
+// CancelScheduledPost implements the gRPC method to unschedule a post
+func (s *Server) CancelScheduledPost(ctx context.Context, req *post.CancelScheduledPostRequest) (*post.Post, error) {
+	log.Printf("Cancelling scheduled post: %s", req.Id)
+
+	draft, err := s.db.CancelScheduledPost(req.Id)
+	if err != nil {
+		log.Printf("Error cancelling scheduled post: %v", err)
+		return nil, err
+	}
+	s.saveDrafts()
+
+	return s.toProtoPost(draft, draft.UserID), nil
+}
+
+// PublishPost implements the gRPC method to publish a draft immediately
+func (s *Server) PublishPost(ctx context.Context, req *post.PublishPostRequest) (*post.Post, error) {
+	log.Printf("Publishing post: %s", req.Id)
+
+	published, err := s.db.PublishPost(req.Id)
+	if err != nil {
+		log.Printf("Error publishing post: %v", err)
+		return nil, err
+	}
+	s.previews.Enqueue(published.LinkURLs()...)
+	s.saveDrafts()
+
+	return s.toProtoPost(published, published.UserID), nil
+}
+
+// ListDrafts implements the gRPC method to list a user's unpublished posts
+func (s *Server) ListDrafts(ctx context.Context, req *post.ListDraftsRequest) (*post.ListPostsResponse, error) {
+	log.Printf("Received request for drafts of user: %s", req.UserId)
+
+	drafts := s.db.GetUnpublishedPosts(req.UserId)
+
+	pbPosts := make([]*post.Post, 0, len(drafts))
+	for _, p := range drafts {
+		pbPosts = append(pbPosts, s.toProtoPost(p, req.UserId))
+	}
+
+	return &post.ListPostsResponse{Posts: pbPosts}, nil
+}
+
+// PinPost implements the gRPC method to pin a post to its author's profile
+func (s *Server) PinPost(ctx context.Context, req *post.PinPostRequest) (*post.Post, error) {
+	log.Printf("User %s pinning post: %s", req.UserId, req.PostId)
+
+	pinned, err := s.db.PinPost(req.UserId, req.PostId)
+	if err != nil {
+		log.Printf("Error pinning post: %v", err)
+		return nil, err
+	}
+
+	return s.toProtoPost(pinned, req.UserId), nil
+}
+
+// UnpinPost implements the gRPC method to unpin a post
+func (s *Server) UnpinPost(ctx context.Context, req *post.UnpinPostRequest) (*post.Post, error) {
+	log.Printf("User %s unpinning post: %s", req.UserId, req.PostId)
+
+	unpinned, err := s.db.UnpinPost(req.UserId, req.PostId)
+	if err != nil {
+		log.Printf("Error unpinning post: %v", err)
+		return nil, err
+	}
+
+	return s.toProtoPost(unpinned, req.UserId), nil
+}
+
+// ListUserPosts implements the gRPC method to page through a user's profile
+func (s *Server) ListUserPosts(ctx context.Context, req *post.ListUserPostsRequest) (*post.PostPage, error) {
+	log.Printf("Received request for profile posts of user: %s", req.UserId)
+
+	page, err := s.db.ListUserPosts(req.UserId, req.ViewerId, int(req.First), req.After)
+	if err != nil {
+		log.Printf("Error listing profile posts: %v", err)
+		return nil, err
+	}
+
+	return s.toProtoPostPage(page, req.ViewerId), nil
+}
+
 // StartServer starts the gRPC server
-func StartServer(db *model.Database, port string) error {
+func StartServer(db *model.Database, port string, draftsPath string) error {
 	// Create a TCP listener
 	listener, err := net.Listen("tcp", port)
 	if err != nil {
@@ -118,9 +336,34 @@ func StartServer(db *model.Database, port string) error {
 	// Create a gRPC server
 	grpcServer := grpc.NewServer()
 
-	// Register our service
-	server := NewServer(db)
+	// Start the link preview workers
+	previews := linkpreview.NewService(linkpreview.DefaultConfig())
+	defer previews.Close()
+
+	// Register our services
+	server := NewServer(db, previews, NewFileDraftStore(draftsPath))
 	post.RegisterPostServiceServer(grpcServer, server)
+	users := NewUserServer(db)
+	user.RegisterUserServiceServer(grpcServer, users)
+
+	// Consumers of the domain events mutations record
+	bus := NewEventBus(db)
+	bus.Register("watch-posts", server.events)
+	bus.Register("search", server.search)
+	bus.Register("notifications", users.notifier)
+	bus.Register("webhooks", users.webhooks)
+	federator := NewFederator(db, DefaultFederationConfig())
+	bus.Register("federation", federator)
+
+	// Publish scheduled posts, deliver events, webhooks and federated
+	// activities, and refresh follow suggestions in the background
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go server.RunScheduler(ctx, time.Second)
+	go bus.Run(ctx)
+	go users.webhooks.Run(ctx)
+	go federator.Run(ctx)
+	go users.suggestions.Run(ctx, 5*time.Minute)
 
 	log.Printf("Post service gRPC server starting on %s (internal only)", port)
 
@@ -128,9 +371,11 @@ func StartServer(db *model.Database, port string) error {
 	return grpcServer.Serve(listener)
 }
 
-// Client represents a client for the post service
+// Client represents a client for the post service and the user service
+// it hosts
 type Client struct {
 	client post.PostServiceClient
+	users  user.UserServiceClient
 	conn   *grpc.ClientConn
 }
 
@@ -147,6 +392,7 @@ func CreateClient(serverAddr string) *Client {
 
 	return &Client{
 		client: client,
+		users:  user.NewUserServiceClient(conn),
 		conn:   conn,
 	}
 }
@@ -164,6 +410,16 @@ func (c *Client) ListPostsByUser(ctx context.Context, req *post.ListPostsRequest
 	return c.client.ListPostsByUser(ctx, req)
 }
 
+// GetPost calls the post service to get a single post
+func (c *Client) GetPost(ctx context.Context, req *post.GetPostRequest) (*post.Post, error) {
+	return c.client.GetPost(ctx, req)
+}
+
+// GetPosts calls the post service to get several posts at once
+func (c *Client) GetPosts(ctx context.Context, req *post.GetPostsRequest) (*post.ListPostsResponse, error) {
+	return c.client.GetPosts(ctx, req)
+}
+
 // CreatePost calls the post service to create a new post
 func (c *Client) CreatePost(ctx context.Context, req *post.CreatePostRequest) (*post.Post, error) {
 	return c.client.CreatePost(ctx, req)
@@ -178,3 +434,54 @@ func (c *Client) UpdatePost(ctx context.Context, req *post.UpdatePostRequest) (*
 func (c *Client) DeletePost(ctx context.Context, req *post.DeletePostRequest) (*post.DeletePostResponse, error) {
 	return c.client.DeletePost(ctx, req)
 }
+
+// VotePoll calls the post service to vote in a poll
+func (c *Client) VotePoll(ctx context.Context, req *post.VotePollRequest) (*post.Post, error) {
+	return c.client.VotePoll(ctx, req)
+}
+
+// SaveDraft calls the post service to save a draft
+func (c *Client) SaveDraft(ctx context.Context, req *post.CreatePostRequest) (*post.Post, error) {
+	return c.client.SaveDraft(ctx, req)
+}
+
+// SchedulePost calls the post service to schedule a draft
+func (c *Client) SchedulePost(ctx context.Context, req *post.SchedulePostRequest) (*post.Post, error) {
+	return c.client.SchedulePost(ctx, req)
+}
+
+// CancelScheduledPost calls the post service to unschedule a post
+func (c *Client) CancelScheduledPost(ctx context.Context, req *post.CancelScheduledPostRequest) (*post.Post, error) {
+	return c.client.CancelScheduledPost(ctx, req)
+}
+
+// PublishPost calls the post service to publish a draft immediately
+func (c *Client) PublishPost(ctx context.Context, req *post.PublishPostRequest) (*post.Post, error) {
+	return c.client.PublishPost(ctx, req)
+}
+
+// ListDrafts calls the post service to list a user's unpublished posts
+func (c *Client) ListDrafts(ctx context.Context, req *post.ListDraftsRequest) (*post.ListPostsResponse, error) {
+	return c.client.ListDrafts(ctx, req)
+}
+
+// PinPost calls the post service to pin a post
+func (c *Client) PinPost(ctx context.Context, req *post.PinPostRequest) (*post.Post, error) {
+	return c.client.PinPost(ctx, req)
+}
+
+// UnpinPost calls the post service to unpin a post
+func (c *Client) UnpinPost(ctx context.Context, req *post.UnpinPostRequest) (*post.Post, error) {
+	return c.client.UnpinPost(ctx, req)
+}
+
+// ListUserPosts calls the post service to get a page of a user's profile
+func (c *Client) ListUserPosts(ctx context.Context, req *post.ListUserPostsRequest) (*post.PostPage, error) {
+	return c.client.ListUserPosts(ctx, req)
+}
+
+// WatchPosts calls the post service to stream changes to the posts of a
+// set of authors. The stream ends when ctx is cancelled.
+func (c *Client) WatchPosts(ctx context.Context, req *post.WatchPostsRequest) (grpc.ServerStreamingClient[post.PostEvent], error) {
+	return c.client.WatchPosts(ctx, req)
+}
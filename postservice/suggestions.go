@@ -0,0 +1,161 @@
+package postservice
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/paper-social/feed-service/model"
+	"github.com/paper-social/feed-service/proto/user"
+)
+
+const (
+	// maxSuggestions is how many suggestions are computed and cached per user
+	maxSuggestions = 50
+
+	// defaultSuggestions is the page size when a request doesn't set one
+	defaultSuggestions = 10
+
+	// suggestionIdle is how long a user's suggestions stay cached, and are
+	// kept fresh, after they were last asked for
+	suggestionIdle = 30 * time.Minute
+)
+
+// suggestionEntry is one user's cached suggestions
+type suggestionEntry struct {
+	suggestions []model.Suggestion
+	computedAt  time.Time
+	readAt      time.Time
+}
+
+// SuggestionCache caches who-to-follow suggestions per user. Suggestions
+// are computed from the whole follow graph, so reads are served from the
+// cache and a background refresher recomputes them.
+type SuggestionCache struct {
+	db *model.Database
+
+	mu      sync.Mutex
+	entries map[string]*suggestionEntry
+}
+
+// NewSuggestionCache creates an empty suggestion cache
+func NewSuggestionCache(db *model.Database) *SuggestionCache {
+	return &SuggestionCache{
+		db:      db,
+		entries: make(map[string]*suggestionEntry),
+	}
+}
+
+// Get returns up to limit suggestions for userID. The first request for a
+// user computes them; later ones read the cache, dropping suggestions that
+// stopped applying, such as accounts the user has followed since.
+func (c *SuggestionCache) Get(userID string, limit int, now time.Time) []model.Suggestion {
+	c.mu.Lock()
+	entry, cached := c.entries[userID]
+	if cached {
+		entry.readAt = now
+	}
+	c.mu.Unlock()
+
+	var suggestions []model.Suggestion
+	if cached {
+		suggestions = c.db.FilterSuggestions(userID, entry.suggestions, now)
+	} else {
+		suggestions = c.db.SuggestUsers(userID, maxSuggestions, now)
+
+		c.mu.Lock()
+		c.entries[userID] = &suggestionEntry{suggestions: suggestions, computedAt: now, readAt: now}
+		c.mu.Unlock()
+	}
+
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	return suggestions
+}
+
+// Run refreshes cached suggestions older than interval until ctx is
+// cancelled. Users who haven't asked for suggestions in suggestionIdle are
+// dropped from the cache instead.
+func (c *SuggestionCache) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			c.refresh(now, interval)
+		}
+	}
+}
+
+// refresh recomputes stale entries outside the lock, so reads aren't held
+// up behind the graph walk
+func (c *SuggestionCache) refresh(now time.Time, maxAge time.Duration) {
+	var stale []string
+
+	c.mu.Lock()
+	for userID, entry := range c.entries {
+		switch {
+		case now.Sub(entry.readAt) > suggestionIdle:
+			delete(c.entries, userID)
+		case now.Sub(entry.computedAt) >= maxAge:
+			stale = append(stale, userID)
+		}
+	}
+	c.mu.Unlock()
+
+	for _, userID := range stale {
+		suggestions := c.db.SuggestUsers(userID, maxSuggestions, now)
+
+		c.mu.Lock()
+		if entry, cached := c.entries[userID]; cached {
+			entry.suggestions = suggestions
+			entry.computedAt = now
+		}
+		c.mu.Unlock()
+	}
+
+	if len(stale) > 0 {
+		log.Printf("Refreshed suggestions for %d users", len(stale))
+	}
+}
+
+// toProtoSuggestion converts a suggestion to its proto representation
+func (s *UserServer) toProtoSuggestion(sg model.Suggestion) *user.SuggestedUser {
+	return &user.SuggestedUser{
+		User:             s.toProtoUser(sg.User),
+		FriendsFollowing: int32(sg.FriendsFollowing),
+		MutualFollowers:  int32(sg.MutualFollowers),
+		RecentPosts:      int32(sg.RecentPosts),
+		Score:            sg.Score,
+	}
+}
+
+// SuggestUsers implements the gRPC method to suggest accounts to follow
+func (s *UserServer) SuggestUsers(ctx context.Context, req *user.SuggestUsersRequest) (*user.SuggestUsersResponse, error) {
+	log.Printf("Received request for suggested users for user: %s", req.UserId)
+
+	limit := int(req.First)
+	if limit <= 0 {
+		limit = defaultSuggestions
+	}
+	limit = min(limit, maxSuggestions)
+
+	suggestions := s.suggestions.Get(req.UserId, limit, time.Now())
+
+	pbSuggestions := make([]*user.SuggestedUser, 0, len(suggestions))
+	for _, sg := range suggestions {
+		pbSuggestions = append(pbSuggestions, s.toProtoSuggestion(sg))
+	}
+
+	return &user.SuggestUsersResponse{Suggestions: pbSuggestions}, nil
+}
+
+// SuggestUsers calls the user service to suggest accounts to follow
+func (c *Client) SuggestUsers(ctx context.Context, req *user.SuggestUsersRequest) (*user.SuggestUsersResponse, error) {
+	return c.users.SuggestUsers(ctx, req)
+}
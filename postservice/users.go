@@ -0,0 +1,481 @@
+package postservice
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/paper-social/feed-service/model"
+	"github.com/paper-social/feed-service/proto/user"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UserServer implements the user service gRPC server. It shares the post
+// service's database, so follows take effect on visibility immediately.
+type UserServer struct {
+	user.UnimplementedUserServiceServer
+	db          *model.Database
+	suggestions *SuggestionCache
+	notifier    *Notifier
+	webhooks    *WebhookDispatcher
+}
+
+// NewUserServer creates a new user service server
+func NewUserServer(db *model.Database) *UserServer {
+	return &UserServer{
+		db:          db,
+		suggestions: NewSuggestionCache(db),
+		notifier:    NewNotifier(db),
+		webhooks:    NewWebhookDispatcher(db, DefaultWebhookConfig()),
+	}
+}
+
+// toProtoUser converts a model user, with its profile counts, to its proto
+// representation
+func (s *UserServer) toProtoUser(u *model.User) *user.User {
+	stats := s.db.GetUserStats(u.ID)
+	return &user.User{
+		Id:             u.ID,
+		Username:       u.Username,
+		Follows:        u.Follows,
+		Protected:      u.Protected,
+		DisplayName:    u.DisplayName,
+		Bio:            u.Bio,
+		AvatarUrl:      u.AvatarURL,
+		Location:       u.Location,
+		Website:        u.Website,
+		CreatedAt:      u.CreatedAt.Unix(),
+		FollowerCount:  int32(stats.Followers),
+		FollowingCount: int32(stats.Following),
+		PostCount:      int32(stats.Posts),
+	}
+}
+
+// toProtoMutedUser converts a mute to its proto representation
+func (s *UserServer) toProtoMutedUser(m model.MutedUser) *user.MutedUser {
+	pbMuted := &user.MutedUser{User: s.toProtoUser(m.User)}
+	if !m.ExpiresAt.IsZero() {
+		pbMuted.ExpiresAt = m.ExpiresAt.Unix()
+	}
+	return pbMuted
+}
+
+// muteFilterKinds maps model mute filter kinds to their proto enum values
+var muteFilterKinds = map[model.MuteFilterKind]user.MuteFilterKind{
+	model.MuteWord:    user.MuteFilterKind_MUTE_FILTER_KIND_WORD,
+	model.MutePhrase:  user.MuteFilterKind_MUTE_FILTER_KIND_PHRASE,
+	model.MuteHashtag: user.MuteFilterKind_MUTE_FILTER_KIND_HASHTAG,
+	model.MuteRegex:   user.MuteFilterKind_MUTE_FILTER_KIND_REGEX,
+}
+
+// muteFilterKindFromProto converts a proto mute filter kind to the model value
+func muteFilterKindFromProto(k user.MuteFilterKind) model.MuteFilterKind {
+	for mk, pk := range muteFilterKinds {
+		if pk == k {
+			return mk
+		}
+	}
+	// Let the model reject values it doesn't know
+	return model.MuteFilterKind(k.String())
+}
+
+// toProtoMuteFilter converts a mute filter to its proto representation
+func toProtoMuteFilter(f model.MuteFilter) *user.MuteFilter {
+	pbFilter := &user.MuteFilter{
+		Id:        f.ID,
+		Kind:      muteFilterKinds[f.Kind],
+		Pattern:   f.Pattern,
+		CreatedAt: f.CreatedAt.Unix(),
+	}
+	if !f.ExpiresAt.IsZero() {
+		pbFilter.ExpiresAt = f.ExpiresAt.Unix()
+	}
+	return pbFilter
+}
+
+// GetUser implements the gRPC method to get a user
+func (s *UserServer) GetUser(ctx context.Context, req *user.GetUserRequest) (*user.User, error) {
+	u := s.db.GetUserByID(req.Id)
+	if u == nil {
+		return nil, fmt.Errorf("user with ID %s not found", req.Id)
+	}
+
+	return s.toProtoUser(u), nil
+}
+
+// GetUsers implements the gRPC method to get several users at once
+func (s *UserServer) GetUsers(ctx context.Context, req *user.GetUsersRequest) (*user.ListUsersResponse, error) {
+	log.Printf("Received request for %d users", len(req.Ids))
+
+	users := s.db.GetUsersByIDs(req.Ids)
+
+	pbUsers := make([]*user.User, 0, len(users))
+	for _, u := range users {
+		pbUsers = append(pbUsers, s.toProtoUser(u))
+	}
+
+	return &user.ListUsersResponse{Users: pbUsers}, nil
+}
+
+// GetUserByUsername implements the gRPC method to look up a user by username
+func (s *UserServer) GetUserByUsername(ctx context.Context, req *user.GetUserByUsernameRequest) (*user.User, error) {
+	u := s.db.GetUserByUsername(req.Username)
+	if u == nil {
+		return nil, status.Errorf(codes.NotFound, "user %s not found", req.Username)
+	}
+
+	return s.toProtoUser(u), nil
+}
+
+// CreateUser implements the gRPC method to sign up a new account
+func (s *UserServer) CreateUser(ctx context.Context, req *user.CreateUserRequest) (*user.User, error) {
+	log.Printf("Creating user: %s", req.Username)
+
+	u, err := s.db.CreateUser(req.Username, model.Profile{
+		DisplayName: req.DisplayName,
+		Bio:         req.Bio,
+		AvatarURL:   req.AvatarUrl,
+		Location:    req.Location,
+		Website:     req.Website,
+	})
+	if err != nil {
+		log.Printf("Error creating user: %v", err)
+		return nil, err
+	}
+
+	return s.toProtoUser(u), nil
+}
+
+// UpdateProfile implements the gRPC method to edit a user's profile
+func (s *UserServer) UpdateProfile(ctx context.Context, req *user.UpdateProfileRequest) (*user.User, error) {
+	log.Printf("Updating profile of user: %s", req.UserId)
+
+	u, err := s.db.UpdateProfile(req.UserId, model.ProfileUpdate{
+		DisplayName: req.DisplayName,
+		Bio:         req.Bio,
+		AvatarURL:   req.AvatarUrl,
+		Location:    req.Location,
+		Website:     req.Website,
+	})
+	if err != nil {
+		log.Printf("Error updating profile: %v", err)
+		return nil, err
+	}
+
+	return s.toProtoUser(u), nil
+}
+
+// FollowUser implements the gRPC method to follow a user
+func (s *UserServer) FollowUser(ctx context.Context, req *user.FollowUserRequest) (*user.FollowUserResponse, error) {
+	log.Printf("User %s following user: %s", req.FollowerId, req.FolloweeId)
+
+	status, err := s.db.FollowUser(req.FollowerId, req.FolloweeId)
+	if err != nil {
+		log.Printf("Error following user: %v", err)
+		return nil, err
+	}
+
+	resp := &user.FollowUserResponse{
+		Status:   user.FollowStatus_FOLLOW_STATUS_FOLLOWING,
+		Followee: s.toProtoUser(s.db.GetUserByID(req.FolloweeId)),
+	}
+	if status == model.FollowRequested {
+		resp.Status = user.FollowStatus_FOLLOW_STATUS_REQUESTED
+	}
+	return resp, nil
+}
+
+// UnfollowUser implements the gRPC method to unfollow a user
+func (s *UserServer) UnfollowUser(ctx context.Context, req *user.FollowUserRequest) (*user.User, error) {
+	log.Printf("User %s unfollowing user: %s", req.FollowerId, req.FolloweeId)
+
+	if err := s.db.UnfollowUser(req.FollowerId, req.FolloweeId); err != nil {
+		log.Printf("Error unfollowing user: %v", err)
+		return nil, err
+	}
+
+	return s.toProtoUser(s.db.GetUserByID(req.FolloweeId)), nil
+}
+
+// SetProtected implements the gRPC method to protect or unprotect an account
+func (s *UserServer) SetProtected(ctx context.Context, req *user.SetProtectedRequest) (*user.User, error) {
+	log.Printf("Setting protected=%t for user: %s", req.Protected, req.UserId)
+
+	u, err := s.db.SetProtected(req.UserId, req.Protected)
+	if err != nil {
+		log.Printf("Error updating account: %v", err)
+		return nil, err
+	}
+
+	return s.toProtoUser(u), nil
+}
+
+// ApproveFollowRequest implements the gRPC method to approve a follow request
+func (s *UserServer) ApproveFollowRequest(ctx context.Context, req *user.FollowRequestDecision) (*user.User, error) {
+	log.Printf("User %s approving follow request from: %s", req.UserId, req.RequesterId)
+
+	requester, err := s.db.ApproveFollowRequest(req.UserId, req.RequesterId)
+	if err != nil {
+		log.Printf("Error approving follow request: %v", err)
+		return nil, err
+	}
+
+	return s.toProtoUser(requester), nil
+}
+
+// RejectFollowRequest implements the gRPC method to reject a follow request
+func (s *UserServer) RejectFollowRequest(ctx context.Context, req *user.FollowRequestDecision) (*user.User, error) {
+	log.Printf("User %s rejecting follow request from: %s", req.UserId, req.RequesterId)
+
+	requester, err := s.db.RejectFollowRequest(req.UserId, req.RequesterId)
+	if err != nil {
+		log.Printf("Error rejecting follow request: %v", err)
+		return nil, err
+	}
+
+	return s.toProtoUser(requester), nil
+}
+
+// ListFollowRequests implements the gRPC method to list pending follow requests
+func (s *UserServer) ListFollowRequests(ctx context.Context, req *user.ListFollowRequestsRequest) (*user.ListUsersResponse, error) {
+	log.Printf("Received request for follow requests of user: %s", req.UserId)
+
+	requesters := s.db.GetFollowRequests(req.UserId)
+
+	pbUsers := make([]*user.User, 0, len(requesters))
+	for _, u := range requesters {
+		pbUsers = append(pbUsers, s.toProtoUser(u))
+	}
+
+	return &user.ListUsersResponse{Users: pbUsers}, nil
+}
+
+// BlockUser implements the gRPC method to block a user
+func (s *UserServer) BlockUser(ctx context.Context, req *user.UserRelationRequest) (*user.User, error) {
+	log.Printf("User %s blocking user: %s", req.UserId, req.TargetId)
+
+	target, err := s.db.BlockUser(req.UserId, req.TargetId)
+	if err != nil {
+		log.Printf("Error blocking user: %v", err)
+		return nil, err
+	}
+
+	return s.toProtoUser(target), nil
+}
+
+// UnblockUser implements the gRPC method to lift a block
+func (s *UserServer) UnblockUser(ctx context.Context, req *user.UserRelationRequest) (*user.User, error) {
+	log.Printf("User %s unblocking user: %s", req.UserId, req.TargetId)
+
+	target, err := s.db.UnblockUser(req.UserId, req.TargetId)
+	if err != nil {
+		log.Printf("Error unblocking user: %v", err)
+		return nil, err
+	}
+
+	return s.toProtoUser(target), nil
+}
+
+// MuteUser implements the gRPC method to mute a user
+func (s *UserServer) MuteUser(ctx context.Context, req *user.MuteUserRequest) (*user.MutedUser, error) {
+	log.Printf("User %s muting user: %s", req.UserId, req.TargetId)
+
+	var expiresAt time.Time
+	if req.ExpiresAt != 0 {
+		expiresAt = time.Unix(req.ExpiresAt, 0)
+	}
+
+	muted, err := s.db.MuteUser(req.UserId, req.TargetId, expiresAt)
+	if err != nil {
+		log.Printf("Error muting user: %v", err)
+		return nil, err
+	}
+
+	return s.toProtoMutedUser(*muted), nil
+}
+
+// UnmuteUser implements the gRPC method to lift a mute
+func (s *UserServer) UnmuteUser(ctx context.Context, req *user.UserRelationRequest) (*user.User, error) {
+	log.Printf("User %s unmuting user: %s", req.UserId, req.TargetId)
+
+	target, err := s.db.UnmuteUser(req.UserId, req.TargetId)
+	if err != nil {
+		log.Printf("Error unmuting user: %v", err)
+		return nil, err
+	}
+
+	return s.toProtoUser(target), nil
+}
+
+// ListBlockedUsers implements the gRPC method to list blocked users
+func (s *UserServer) ListBlockedUsers(ctx context.Context, req *user.ListBlockedUsersRequest) (*user.ListUsersResponse, error) {
+	log.Printf("Received request for blocked users of user: %s", req.UserId)
+
+	blocked := s.db.GetBlockedUsers(req.UserId)
+
+	pbUsers := make([]*user.User, 0, len(blocked))
+	for _, u := range blocked {
+		pbUsers = append(pbUsers, s.toProtoUser(u))
+	}
+
+	return &user.ListUsersResponse{Users: pbUsers}, nil
+}
+
+// ListMutedUsers implements the gRPC method to list muted users
+func (s *UserServer) ListMutedUsers(ctx context.Context, req *user.ListMutedUsersRequest) (*user.ListMutedUsersResponse, error) {
+	log.Printf("Received request for muted users of user: %s", req.UserId)
+
+	muted := s.db.GetMutedUsers(req.UserId, time.Now())
+
+	pbMuted := make([]*user.MutedUser, 0, len(muted))
+	for _, m := range muted {
+		pbMuted = append(pbMuted, s.toProtoMutedUser(m))
+	}
+
+	return &user.ListMutedUsersResponse{Users: pbMuted}, nil
+}
+
+// AddMuteFilter implements the gRPC method to add a keyword mute filter
+func (s *UserServer) AddMuteFilter(ctx context.Context, req *user.AddMuteFilterRequest) (*user.MuteFilter, error) {
+	log.Printf("User %s adding %s mute filter", req.UserId, req.Kind)
+
+	var expiresAt time.Time
+	if req.ExpiresAt != 0 {
+		expiresAt = time.Unix(req.ExpiresAt, 0)
+	}
+
+	filter, err := s.db.AddMuteFilter(req.UserId, muteFilterKindFromProto(req.Kind), req.Pattern, expiresAt)
+	if err != nil {
+		log.Printf("Error adding mute filter: %v", err)
+		return nil, err
+	}
+
+	return toProtoMuteFilter(*filter), nil
+}
+
+// RemoveMuteFilter implements the gRPC method to remove a keyword mute filter
+func (s *UserServer) RemoveMuteFilter(ctx context.Context, req *user.RemoveMuteFilterRequest) (*user.MuteFilter, error) {
+	log.Printf("User %s removing mute filter: %s", req.UserId, req.FilterId)
+
+	filter, err := s.db.RemoveMuteFilter(req.UserId, req.FilterId)
+	if err != nil {
+		log.Printf("Error removing mute filter: %v", err)
+		return nil, err
+	}
+
+	return toProtoMuteFilter(*filter), nil
+}
+
+// ListMuteFilters implements the gRPC method to list keyword mute filters
+func (s *UserServer) ListMuteFilters(ctx context.Context, req *user.ListMuteFiltersRequest) (*user.ListMuteFiltersResponse, error) {
+	filters := s.db.GetMuteFilters(req.UserId, time.Now())
+
+	pbFilters := make([]*user.MuteFilter, 0, len(filters))
+	for _, f := range filters {
+		pbFilters = append(pbFilters, toProtoMuteFilter(f))
+	}
+
+	return &user.ListMuteFiltersResponse{Filters: pbFilters}, nil
+}
+
+// GetUser calls the user service to get a user
+func (c *Client) GetUser(ctx context.Context, req *user.GetUserRequest) (*user.User, error) {
+	return c.users.GetUser(ctx, req)
+}
+
+// GetUsers calls the user service to get several users at once
+func (c *Client) GetUsers(ctx context.Context, req *user.GetUsersRequest) (*user.ListUsersResponse, error) {
+	return c.users.GetUsers(ctx, req)
+}
+
+// GetUserByUsername calls the user service to look up a user by username
+func (c *Client) GetUserByUsername(ctx context.Context, req *user.GetUserByUsernameRequest) (*user.User, error) {
+	return c.users.GetUserByUsername(ctx, req)
+}
+
+// CreateUser calls the user service to sign up a new account
+func (c *Client) CreateUser(ctx context.Context, req *user.CreateUserRequest) (*user.User, error) {
+	return c.users.CreateUser(ctx, req)
+}
+
+// UpdateProfile calls the user service to edit a user's profile
+func (c *Client) UpdateProfile(ctx context.Context, req *user.UpdateProfileRequest) (*user.User, error) {
+	return c.users.UpdateProfile(ctx, req)
+}
+
+// FollowUser calls the user service to follow a user
+func (c *Client) FollowUser(ctx context.Context, req *user.FollowUserRequest) (*user.FollowUserResponse, error) {
+	return c.users.FollowUser(ctx, req)
+}
+
+// UnfollowUser calls the user service to unfollow a user
+func (c *Client) UnfollowUser(ctx context.Context, req *user.FollowUserRequest) (*user.User, error) {
+	return c.users.UnfollowUser(ctx, req)
+}
+
+// SetProtected calls the user service to protect or unprotect an account
+func (c *Client) SetProtected(ctx context.Context, req *user.SetProtectedRequest) (*user.User, error) {
+	return c.users.SetProtected(ctx, req)
+}
+
+// ApproveFollowRequest calls the user service to approve a follow request
+func (c *Client) ApproveFollowRequest(ctx context.Context, req *user.FollowRequestDecision) (*user.User, error) {
+	return c.users.ApproveFollowRequest(ctx, req)
+}
+
+// RejectFollowRequest calls the user service to reject a follow request
+func (c *Client) RejectFollowRequest(ctx context.Context, req *user.FollowRequestDecision) (*user.User, error) {
+	return c.users.RejectFollowRequest(ctx, req)
+}
+
+// ListFollowRequests calls the user service to list pending follow requests
+func (c *Client) ListFollowRequests(ctx context.Context, req *user.ListFollowRequestsRequest) (*user.ListUsersResponse, error) {
+	return c.users.ListFollowRequests(ctx, req)
+}
+
+// BlockUser calls the user service to block a user
+func (c *Client) BlockUser(ctx context.Context, req *user.UserRelationRequest) (*user.User, error) {
+	return c.users.BlockUser(ctx, req)
+}
+
+// UnblockUser calls the user service to lift a block
+func (c *Client) UnblockUser(ctx context.Context, req *user.UserRelationRequest) (*user.User, error) {
+	return c.users.UnblockUser(ctx, req)
+}
+
+// MuteUser calls the user service to mute a user
+func (c *Client) MuteUser(ctx context.Context, req *user.MuteUserRequest) (*user.MutedUser, error) {
+	return c.users.MuteUser(ctx, req)
+}
+
+// UnmuteUser calls the user service to lift a mute
+func (c *Client) UnmuteUser(ctx context.Context, req *user.UserRelationRequest) (*user.User, error) {
+	return c.users.UnmuteUser(ctx, req)
+}
+
+// ListBlockedUsers calls the user service to list blocked users
+func (c *Client) ListBlockedUsers(ctx context.Context, req *user.ListBlockedUsersRequest) (*user.ListUsersResponse, error) {
+	return c.users.ListBlockedUsers(ctx, req)
+}
+
+// ListMutedUsers calls the user service to list muted users
+func (c *Client) ListMutedUsers(ctx context.Context, req *user.ListMutedUsersRequest) (*user.ListMutedUsersResponse, error) {
+	return c.users.ListMutedUsers(ctx, req)
+}
+
+// AddMuteFilter calls the user service to add a keyword mute filter
+func (c *Client) AddMuteFilter(ctx context.Context, req *user.AddMuteFilterRequest) (*user.MuteFilter, error) {
+	return c.users.AddMuteFilter(ctx, req)
+}
+
+// RemoveMuteFilter calls the user service to remove a keyword mute filter
+func (c *Client) RemoveMuteFilter(ctx context.Context, req *user.RemoveMuteFilterRequest) (*user.MuteFilter, error) {
+	return c.users.RemoveMuteFilter(ctx, req)
+}
+
+// ListMuteFilters calls the user service to list keyword mute filters
+func (c *Client) ListMuteFilters(ctx context.Context, req *user.ListMuteFiltersRequest) (*user.ListMuteFiltersResponse, error) {
+	return c.users.ListMuteFilters(ctx, req)
+}
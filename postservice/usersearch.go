@@ -0,0 +1,42 @@
+package postservice
+
+import (
+	"context"
+	"log"
+
+	"github.com/paper-social/feed-service/proto/user"
+)
+
+const (
+	// maxUserResults caps how many users a search returns
+	maxUserResults = 50
+
+	// defaultUserResults is the number of users returned when a search
+	// doesn't set one
+	defaultUserResults = 10
+)
+
+// SearchUsers implements the gRPC method to find users by name prefix
+func (s *UserServer) SearchUsers(ctx context.Context, req *user.SearchUsersRequest) (*user.ListUsersResponse, error) {
+	log.Printf("Searching users for prefix %q", req.Prefix)
+
+	limit := int(req.First)
+	if limit <= 0 {
+		limit = defaultUserResults
+	}
+	limit = min(limit, maxUserResults)
+
+	users := s.db.SearchUsers(req.ViewerId, req.Prefix, limit)
+
+	pbUsers := make([]*user.User, 0, len(users))
+	for _, u := range users {
+		pbUsers = append(pbUsers, s.toProtoUser(u))
+	}
+
+	return &user.ListUsersResponse{Users: pbUsers}, nil
+}
+
+// SearchUsers calls the user service to find users by name prefix
+func (c *Client) SearchUsers(ctx context.Context, req *user.SearchUsersRequest) (*user.ListUsersResponse, error) {
+	return c.users.SearchUsers(ctx, req)
+}
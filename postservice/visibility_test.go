@@ -0,0 +1,215 @@
+package postservice
+
+import (
+	"context"
+	"maps"
+	"net"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/paper-social/feed-service/model"
+	"github.com/paper-social/feed-service/proto/post"
+	"google.golang.org/grpc"
+)
+
+// startTestServer serves s over gRPC on a local port, returning a client
+func startTestServer(t *testing.T, s *Server) *Client {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend := grpc.NewServer()
+	post.RegisterPostServiceServer(backend, s)
+	go backend.Serve(listener)
+	t.Cleanup(backend.Stop)
+
+	client := CreateClient(listener.Addr().String())
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+// TestReadPathsCheckVisibility runs the same posts through every way of
+// reading them, checking each viewer gets exactly the posts they may see
+func TestReadPathsCheckVisibility(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	db := model.NewDatabase()
+	s := NewServer(db, newTestPreviews(t), NewFileDraftStore(filepath.Join(t.TempDir(), "drafts.json")))
+	client := startTestServer(t, s)
+
+	// Bob blocks Charlie, who followed him; Dave's account is protected and
+	// only Alice follows him
+	if _, err := db.BlockUser("user2", "user3"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.SetProtected("user4", true); err != nil {
+		t.Fatal(err)
+	}
+
+	viewers := map[string]string{
+		"anonymous": "",
+		"alice":     "user1",
+		"bob":       "user2",
+		"charlie":   "user3",
+		"dave":      "user4",
+		"eve":       "user5",
+	}
+	authors := []string{"user2", "user4"}
+
+	// Every viewer watches Bob and Dave, and Alice, whose last post tells
+	// them all the others have gone out
+	watchers := make(map[string]post.PostService_WatchPostsClient)
+	for name, viewerID := range viewers {
+		stream, err := client.WatchPosts(ctx, &post.WatchPostsRequest{
+			ViewerId:  viewerID,
+			AuthorIds: append([]string{"user1"}, authors...),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := stream.Header(); err != nil {
+			t.Fatal(err)
+		}
+		watchers[name] = stream
+	}
+
+	after := db.NextEventSequence - 1
+	posts := make(map[string]string) // Post ID to name
+	for _, p := range []struct {
+		name, userID, content string
+		visibility            model.Visibility
+	}{
+		{"bob-public", "user2", "zebra for everyone", model.VisibilityPublic},
+		{"bob-followers", "user2", "zebra for followers and @dave", model.VisibilityFollowers},
+		{"bob-mentioned", "user2", "zebra for @eve", model.VisibilityMentioned},
+		{"dave-public", "user4", "zebra from a protected account", model.VisibilityPublic},
+		{"dave-mentioned", "user4", "zebra for @eve too", model.VisibilityMentioned},
+	} {
+		created, err := db.CreatePost(p.userID, p.content, model.PostOptions{Visibility: p.visibility})
+		if err != nil {
+			t.Fatal(err)
+		}
+		posts[created.ID] = p.name
+	}
+	last, err := db.CreatePost("user1", "That's all", model.PostOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	handleEvents(t, db, after, s.events)
+	handleEvents(t, db, after, s.search)
+
+	// The pinned post is read from the profile's pins
+	for id, name := range posts {
+		if name == "bob-followers" {
+			if _, err := db.PinPost("user2", id); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	want := map[string][]string{
+		"anonymous": {"bob-public"},
+		"alice":     {"bob-followers", "bob-public", "dave-public"},
+		"bob":       {"bob-followers", "bob-mentioned", "bob-public"},
+		"charlie":   {},
+		"dave":      {"bob-followers", "bob-public", "dave-mentioned", "dave-public"},
+		"eve":       {"bob-mentioned", "bob-public"},
+	}
+
+	for name, viewerID := range viewers {
+		t.Run(name, func(t *testing.T) {
+			// names returns the names of the test posts among pbPosts, sorted
+			names := func(pbPosts []*post.Post) []string {
+				found := []string{}
+				for _, p := range pbPosts {
+					if n, ok := posts[p.Id]; ok {
+						found = append(found, n)
+					}
+				}
+				slices.Sort(found)
+				return found
+			}
+			check := func(path string, got []string) {
+				t.Helper()
+				if !slices.Equal(got, want[name]) {
+					t.Errorf("%s = %v, want %v", path, got, want[name])
+				}
+			}
+
+			var got []*post.Post
+			for id := range posts {
+				if p, err := client.GetPost(ctx, &post.GetPostRequest{Id: id, ViewerId: viewerID}); err == nil {
+					got = append(got, p)
+				}
+			}
+			check("GetPost", names(got))
+
+			resp, err := client.GetPosts(ctx, &post.GetPostsRequest{Ids: slices.Collect(maps.Keys(posts)), ViewerId: viewerID})
+			if err != nil {
+				t.Fatal(err)
+			}
+			check("GetPosts", names(resp.Posts))
+
+			var listed, paged, profile []*post.Post
+			for _, author := range authors {
+				resp, err := client.ListPostsByUser(ctx, &post.ListPostsRequest{UserId: author, ViewerId: viewerID})
+				if err != nil {
+					t.Fatal(err)
+				}
+				listed = append(listed, resp.Posts...)
+
+				resp, err = client.ListPostsByUser(ctx, &post.ListPostsRequest{UserId: author, ViewerId: viewerID, First: 100})
+				if err != nil {
+					t.Fatal(err)
+				}
+				paged = append(paged, resp.Posts...)
+
+				page, err := client.ListUserPosts(ctx, &post.ListUserPostsRequest{UserId: author, ViewerId: viewerID, First: 100})
+				if err != nil {
+					t.Fatal(err)
+				}
+				profile = append(profile, page.Posts...)
+			}
+			check("ListPostsByUser", names(listed))
+			check("ListPostsByUser with paging", names(paged))
+			check("ListUserPosts", names(profile))
+
+			page, err := client.SearchPosts(ctx, &post.SearchPostsRequest{Query: "zebra", ViewerId: viewerID, First: 100})
+			if err != nil {
+				t.Fatal(err)
+			}
+			check("SearchPosts", names(page.Posts))
+
+			var watched []*post.Post
+			for {
+				event, err := watchers[name].Recv()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if event.PostId == last.ID {
+					break
+				}
+				watched = append(watched, event.Post)
+			}
+			check("WatchPosts", names(watched))
+
+			// The timeline only holds posts from the viewer's network, so it
+			// is checked for posts that mustn't be there
+			if viewerID == "" {
+				return
+			}
+			timeline, err := client.RankTimeline(ctx, &post.RankTimelineRequest{UserId: viewerID, First: 100})
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, n := range names(timeline.Posts) {
+				if !slices.Contains(want[name], n) {
+					t.Errorf("RankTimeline has %s", n)
+				}
+			}
+		})
+	}
+}
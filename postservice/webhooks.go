@@ -0,0 +1,536 @@
+package postservice
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/paper-social/feed-service/linkpreview"
+	"github.com/paper-social/feed-service/model"
+	"github.com/paper-social/feed-service/proto/user"
+)
+
+// Headers sent with every webhook request. The signature is
+// "sha256=" followed by the hex HMAC-SHA256, keyed with the webhook's
+// secret, of the timestamp, a dot and the body.
+const (
+	WebhookEventHeader     = "X-Webhook-Event"
+	WebhookIDHeader        = "X-Webhook-Id" // The event ID, the same on every attempt
+	WebhookTimestampHeader = "X-Webhook-Timestamp"
+	WebhookSignatureHeader = "X-Webhook-Signature"
+)
+
+// webhookResponseLimit caps how much of a response body is read
+const webhookResponseLimit = 64 * 1024
+
+// webhookEvents maps model event types to their proto enum values
+var webhookEvents = map[model.EventType]user.WebhookEvent{
+	model.EventPostCreated:     user.WebhookEvent_WEBHOOK_EVENT_POST_CREATED,
+	model.EventPostUpdated:     user.WebhookEvent_WEBHOOK_EVENT_POST_UPDATED,
+	model.EventPostDeleted:     user.WebhookEvent_WEBHOOK_EVENT_POST_DELETED,
+	model.EventUserFollowed:    user.WebhookEvent_WEBHOOK_EVENT_USER_FOLLOWED,
+	model.EventUserUnfollowed:  user.WebhookEvent_WEBHOOK_EVENT_USER_UNFOLLOWED,
+	model.EventFollowRequested: user.WebhookEvent_WEBHOOK_EVENT_FOLLOW_REQUESTED,
+}
+
+// WebhookConfig controls webhook delivery
+type WebhookConfig struct {
+	Timeout   time.Duration // Per attempt
+	UserAgent string
+	Workers   int // Concurrent deliveries
+	QueueSize int // Deliveries waiting for a worker before the event bus is held up
+
+	// An event is attempted up to MaxAttempts times, waiting MinRetry
+	// after the first failure and doubling up to MaxRetry
+	MaxAttempts int
+	MinRetry    time.Duration
+	MaxRetry    time.Duration
+
+	// AllowPrivateNetworks lets webhooks reach loopback and private
+	// addresses. Only for local testing.
+	AllowPrivateNetworks bool
+}
+
+// DefaultWebhookConfig returns the configuration used by the post service
+func DefaultWebhookConfig() WebhookConfig {
+	return WebhookConfig{
+		Timeout:     10 * time.Second,
+		UserAgent:   "PaperSocialWebhooks/1.0 (+https://paper.social)",
+		Workers:     4,
+		QueueSize:   256,
+		MaxAttempts: 6,
+		MinRetry:    time.Second,
+		MaxRetry:    time.Minute,
+	}
+}
+
+// webhookJob is an event on its way to one webhook
+type webhookJob struct {
+	webhookID     string
+	eventSequence int64
+	eventType     model.EventType
+	payload       []byte
+	attempts      int // Made so far
+}
+
+// webhookPayload is the JSON body sent to webhooks
+type webhookPayload struct {
+	ID         string       `json:"id"`
+	Type       string       `json:"type"`
+	OccurredAt string       `json:"occurredAt"`
+	ActorID    string       `json:"actorId"`
+	SubjectID  string       `json:"subjectId,omitempty"` // Followed user, for follow events
+	Post       *webhookPost `json:"post,omitempty"`      // For post events; as it was, for deletes
+}
+
+// webhookPost is a post as sent to webhooks
+type webhookPost struct {
+	ID         string `json:"id"`
+	UserID     string `json:"userId"`
+	Content    string `json:"content"`
+	Visibility string `json:"visibility"`
+	CreatedAt  string `json:"createdAt"`
+}
+
+// WebhookDispatcher is the event bus consumer that sends users' webhooks
+// the events they subscribed to. Deliveries run on a pool of workers, so a
+// slow endpoint holds up only its own events. Failed attempts are retried
+// with exponential backoff; events still not delivered after the last
+// attempt become dead letters, and a webhook whose events keep ending up
+// there is disabled.
+type WebhookDispatcher struct {
+	db     *model.Database
+	cfg    WebhookConfig
+	client *http.Client
+	jobs   chan *webhookJob
+}
+
+// NewWebhookDispatcher creates a dispatcher. Deliveries start once Run is
+// called.
+func NewWebhookDispatcher(db *model.Database, cfg WebhookConfig) *WebhookDispatcher {
+	dialer := linkpreview.NewDialer(cfg.Timeout, cfg.AllowPrivateNetworks)
+	return &WebhookDispatcher{
+		db:  db,
+		cfg: cfg,
+		client: &http.Client{
+			Transport: &http.Transport{
+				DialContext:           dialer.DialContext,
+				TLSHandshakeTimeout:   cfg.Timeout,
+				ResponseHeaderTimeout: cfg.Timeout,
+				MaxIdleConns:          10,
+				IdleConnTimeout:       30 * time.Second,
+			},
+			Timeout: cfg.Timeout,
+			// A redirect is reported as a failed delivery rather than
+			// followed to somewhere the owner didn't register
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		jobs: make(chan *webhookJob, cfg.QueueSize),
+	}
+}
+
+// Run delivers queued events until ctx is cancelled
+func (d *WebhookDispatcher) Run(ctx context.Context) {
+	for range d.cfg.Workers {
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case job := <-d.jobs:
+					d.attempt(ctx, job)
+				}
+			}
+		}()
+	}
+	<-ctx.Done()
+}
+
+// Handle implements Consumer by queueing the event for every webhook
+// subscribed to it. Delivery happens later, so Handle only waits when the
+// queue is full.
+func (d *WebhookDispatcher) Handle(ctx context.Context, event model.Event) error {
+	webhooks := d.db.WebhooksForEvent(event)
+	if len(webhooks) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(newWebhookPayload(event))
+	if err != nil {
+		return err
+	}
+	for _, webhook := range webhooks {
+		job := &webhookJob{
+			webhookID:     webhook.ID,
+			eventSequence: event.Sequence,
+			eventType:     event.Type,
+			payload:       payload,
+		}
+		if err := d.enqueue(ctx, job); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Redeliver queues a dead letter for delivery again
+func (d *WebhookDispatcher) Redeliver(ctx context.Context, deadLetter *model.DeadLetter) error {
+	return d.enqueue(ctx, &webhookJob{
+		webhookID:     deadLetter.WebhookID,
+		eventSequence: deadLetter.EventSequence,
+		eventType:     deadLetter.EventType,
+		payload:       deadLetter.Payload,
+	})
+}
+
+// enqueue waits for room in the queue
+func (d *WebhookDispatcher) enqueue(ctx context.Context, job *webhookJob) error {
+	select {
+	case d.jobs <- job:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// attempt makes one delivery attempt, logs it, and schedules a retry or
+// gives up on the event
+func (d *WebhookDispatcher) attempt(ctx context.Context, job *webhookJob) {
+	webhook := d.db.GetWebhook(job.webhookID)
+	if webhook == nil {
+		return
+	}
+	if !webhook.Active {
+		d.deadLetter(job, model.ErrWebhookDisabled.Error())
+		return
+	}
+
+	job.attempts++
+	delivery := d.post(ctx, webhook, job)
+	d.db.LogWebhookDelivery(delivery)
+	if delivery.Succeeded() || ctx.Err() != nil {
+		return
+	}
+
+	if job.attempts >= d.cfg.MaxAttempts {
+		d.deadLetter(job, delivery.Error)
+		return
+	}
+
+	delay := min(d.cfg.MinRetry<<(job.attempts-1), d.cfg.MaxRetry)
+	log.Printf("Webhook %s failed to take event %d (attempt %d), retrying in %v: %s", webhook.ID, job.eventSequence, job.attempts, delay, delivery.Error)
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-time.After(delay):
+			d.enqueue(ctx, job)
+		}
+	}()
+}
+
+// post sends an event to a webhook once
+func (d *WebhookDispatcher) post(ctx context.Context, webhook *model.Webhook, job *webhookJob) model.WebhookDelivery {
+	delivery := model.WebhookDelivery{
+		WebhookID:     webhook.ID,
+		EventSequence: job.eventSequence,
+		EventType:     job.eventType,
+		Attempt:       job.attempts,
+		At:            time.Now(),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(job.payload))
+	if err != nil {
+		delivery.Error = err.Error()
+		return delivery
+	}
+	timestamp := delivery.At.Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", d.cfg.UserAgent)
+	req.Header.Set(WebhookEventHeader, string(job.eventType))
+	req.Header.Set(WebhookIDHeader, webhookEventID(job.eventSequence))
+	req.Header.Set(WebhookTimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(WebhookSignatureHeader, SignWebhookPayload(webhook.Secret, timestamp, job.payload))
+
+	resp, err := d.client.Do(req)
+	delivery.Duration = time.Since(delivery.At)
+	if err != nil {
+		delivery.Error = err.Error()
+		return delivery
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, webhookResponseLimit))
+
+	delivery.StatusCode = resp.StatusCode
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		delivery.Error = fmt.Sprintf("endpoint responded %s", resp.Status)
+	}
+	return delivery
+}
+
+// deadLetter gives up on an event
+func (d *WebhookDispatcher) deadLetter(job *webhookJob, lastError string) {
+	webhook := d.db.AddDeadLetter(model.DeadLetter{
+		WebhookID:     job.webhookID,
+		EventSequence: job.eventSequence,
+		EventType:     job.eventType,
+		Payload:       job.payload,
+		Attempts:      job.attempts,
+		LastError:     lastError,
+		FailedAt:      time.Now(),
+	})
+	log.Printf("Webhook %s gave up on event %d after %d attempts: %s", job.webhookID, job.eventSequence, job.attempts, lastError)
+	if webhook != nil && webhook.DisabledReason != "" && webhook.ConsecutiveFailures == model.WebhookDisableAfter {
+		log.Printf("Webhook %s disabled: %s", webhook.ID, webhook.DisabledReason)
+	}
+}
+
+// SignWebhookPayload returns the signature header value for a payload.
+// Receivers recompute it with their secret to check that a request came
+// from us, and reject old timestamps to stop replays.
+func SignWebhookPayload(secret string, timestamp int64, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.", timestamp)
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// webhookEventID identifies an event to webhook receivers, who may see it
+// more than once
+func webhookEventID(sequence int64) string {
+	return fmt.Sprintf("event%d", sequence)
+}
+
+// newWebhookPayload builds the body sent for an event
+func newWebhookPayload(event model.Event) *webhookPayload {
+	payload := &webhookPayload{
+		ID:         webhookEventID(event.Sequence),
+		Type:       string(event.Type),
+		OccurredAt: event.OccurredAt.Format(time.RFC3339),
+		ActorID:    event.ActorID,
+		SubjectID:  event.SubjectID,
+	}
+	if event.Post != nil {
+		payload.Post = &webhookPost{
+			ID:         event.Post.ID,
+			UserID:     event.Post.UserID,
+			Content:    event.Post.Content,
+			Visibility: string(event.Post.Visibility),
+			CreatedAt:  event.Post.CreatedAt.Format(time.RFC3339),
+		}
+	}
+	return payload
+}
+
+// toProtoWebhook converts a webhook to its proto representation, without
+// its secret
+func toProtoWebhook(w *model.Webhook) *user.Webhook {
+	pbWebhook := &user.Webhook{
+		Id:                  w.ID,
+		UserId:              w.UserID,
+		Url:                 w.URL,
+		Events:              make([]user.WebhookEvent, 0, len(w.Events)),
+		Active:              w.Active,
+		DisabledReason:      w.DisabledReason,
+		ConsecutiveFailures: int32(w.ConsecutiveFailures),
+		CreatedAt:           w.CreatedAt.Unix(),
+	}
+	for _, e := range w.Events {
+		pbWebhook.Events = append(pbWebhook.Events, webhookEvents[e])
+	}
+	return pbWebhook
+}
+
+// toProtoDeadLetter converts a dead letter to its proto representation
+func toProtoDeadLetter(dl *model.DeadLetter) *user.DeadLetter {
+	return &user.DeadLetter{
+		Id:        dl.ID,
+		WebhookId: dl.WebhookID,
+		EventId:   webhookEventID(dl.EventSequence),
+		Event:     webhookEvents[dl.EventType],
+		Payload:   string(dl.Payload),
+		Attempts:  int32(dl.Attempts),
+		LastError: dl.LastError,
+		FailedAt:  dl.FailedAt.Unix(),
+	}
+}
+
+// fromProtoWebhookEvents converts proto event types to model ones
+func fromProtoWebhookEvents(events []user.WebhookEvent) []model.EventType {
+	types := make([]model.EventType, 0, len(events))
+	for _, e := range events {
+		for t, pb := range webhookEvents {
+			if pb == e {
+				types = append(types, t)
+			}
+		}
+	}
+	return types
+}
+
+// CreateWebhook implements the gRPC method to register a webhook. Its
+// secret is only ever returned here.
+func (s *UserServer) CreateWebhook(ctx context.Context, req *user.CreateWebhookRequest) (*user.Webhook, error) {
+	log.Printf("User %s creating webhook for %s", req.UserId, req.Url)
+
+	webhook, err := s.db.CreateWebhook(req.UserId, req.Url, fromProtoWebhookEvents(req.Events))
+	if err != nil {
+		log.Printf("Error creating webhook: %v", err)
+		return nil, err
+	}
+
+	pbWebhook := toProtoWebhook(webhook)
+	pbWebhook.Secret = webhook.Secret
+	return pbWebhook, nil
+}
+
+// DeleteWebhook implements the gRPC method to delete a webhook
+func (s *UserServer) DeleteWebhook(ctx context.Context, req *user.WebhookRequest) (*user.Webhook, error) {
+	log.Printf("User %s deleting webhook: %s", req.UserId, req.WebhookId)
+
+	webhook, err := s.db.DeleteWebhook(req.UserId, req.WebhookId)
+	if err != nil {
+		log.Printf("Error deleting webhook: %v", err)
+		return nil, err
+	}
+
+	return toProtoWebhook(webhook), nil
+}
+
+// SetWebhookActive implements the gRPC method to enable or disable a
+// webhook
+func (s *UserServer) SetWebhookActive(ctx context.Context, req *user.SetWebhookActiveRequest) (*user.Webhook, error) {
+	log.Printf("User %s setting webhook %s active: %v", req.UserId, req.WebhookId, req.Active)
+
+	webhook, err := s.db.SetWebhookActive(req.UserId, req.WebhookId, req.Active)
+	if err != nil {
+		log.Printf("Error updating webhook: %v", err)
+		return nil, err
+	}
+
+	return toProtoWebhook(webhook), nil
+}
+
+// ListWebhooks implements the gRPC method to list a user's webhooks
+func (s *UserServer) ListWebhooks(ctx context.Context, req *user.ListWebhooksRequest) (*user.ListWebhooksResponse, error) {
+	log.Printf("Listing webhooks of user %s", req.UserId)
+
+	webhooks := s.db.GetWebhooks(req.UserId)
+	resp := &user.ListWebhooksResponse{Webhooks: make([]*user.Webhook, 0, len(webhooks))}
+	for _, w := range webhooks {
+		resp.Webhooks = append(resp.Webhooks, toProtoWebhook(w))
+	}
+	return resp, nil
+}
+
+// ListWebhookDeliveries implements the gRPC method to list a webhook's
+// delivery attempts
+func (s *UserServer) ListWebhookDeliveries(ctx context.Context, req *user.ListWebhookDeliveriesRequest) (*user.ListWebhookDeliveriesResponse, error) {
+	log.Printf("Listing deliveries of webhook %s", req.WebhookId)
+
+	deliveries, err := s.db.GetWebhookDeliveries(req.UserId, req.WebhookId, int(req.First))
+	if err != nil {
+		log.Printf("Error listing webhook deliveries: %v", err)
+		return nil, err
+	}
+
+	resp := &user.ListWebhookDeliveriesResponse{Deliveries: make([]*user.WebhookDelivery, 0, len(deliveries))}
+	for _, d := range deliveries {
+		resp.Deliveries = append(resp.Deliveries, &user.WebhookDelivery{
+			Id:          d.ID,
+			WebhookId:   d.WebhookID,
+			EventId:     webhookEventID(d.EventSequence),
+			Event:       webhookEvents[d.EventType],
+			Attempt:     int32(d.Attempt),
+			StatusCode:  int32(d.StatusCode),
+			Error:       d.Error,
+			DurationMs:  d.Duration.Milliseconds(),
+			DeliveredAt: d.At.Unix(),
+		})
+	}
+	return resp, nil
+}
+
+// ListDeadLetters implements the gRPC method to list a webhook's dead
+// letters
+func (s *UserServer) ListDeadLetters(ctx context.Context, req *user.WebhookRequest) (*user.ListDeadLettersResponse, error) {
+	log.Printf("Listing dead letters of webhook %s", req.WebhookId)
+
+	deadLetters, err := s.db.GetDeadLetters(req.UserId, req.WebhookId)
+	if err != nil {
+		log.Printf("Error listing dead letters: %v", err)
+		return nil, err
+	}
+
+	resp := &user.ListDeadLettersResponse{DeadLetters: make([]*user.DeadLetter, 0, len(deadLetters))}
+	for _, dl := range deadLetters {
+		resp.DeadLetters = append(resp.DeadLetters, toProtoDeadLetter(dl))
+	}
+	return resp, nil
+}
+
+// RedeliverDeadLetter implements the gRPC method to deliver a dead letter
+// again. If it can't be queued it is put back, so it isn't lost.
+func (s *UserServer) RedeliverDeadLetter(ctx context.Context, req *user.RedeliverDeadLetterRequest) (*user.DeadLetter, error) {
+	log.Printf("User %s redelivering dead letter: %s", req.UserId, req.DeadLetterId)
+
+	deadLetter, err := s.db.TakeDeadLetter(req.UserId, req.DeadLetterId)
+	if err != nil {
+		log.Printf("Error redelivering dead letter: %v", err)
+		return nil, err
+	}
+	if err := s.webhooks.Redeliver(ctx, deadLetter); err != nil {
+		s.db.RestoreDeadLetter(deadLetter)
+		log.Printf("Error redelivering dead letter: %v", err)
+		return nil, err
+	}
+
+	return toProtoDeadLetter(deadLetter), nil
+}
+
+// CreateWebhook calls the user service to register a webhook
+func (c *Client) CreateWebhook(ctx context.Context, req *user.CreateWebhookRequest) (*user.Webhook, error) {
+	return c.users.CreateWebhook(ctx, req)
+}
+
+// DeleteWebhook calls the user service to delete a webhook
+func (c *Client) DeleteWebhook(ctx context.Context, req *user.WebhookRequest) (*user.Webhook, error) {
+	return c.users.DeleteWebhook(ctx, req)
+}
+
+// SetWebhookActive calls the user service to enable or disable a webhook
+func (c *Client) SetWebhookActive(ctx context.Context, req *user.SetWebhookActiveRequest) (*user.Webhook, error) {
+	return c.users.SetWebhookActive(ctx, req)
+}
+
+// ListWebhooks calls the user service to list a user's webhooks
+func (c *Client) ListWebhooks(ctx context.Context, req *user.ListWebhooksRequest) (*user.ListWebhooksResponse, error) {
+	return c.users.ListWebhooks(ctx, req)
+}
+
+// ListWebhookDeliveries calls the user service to list a webhook's
+// delivery attempts
+func (c *Client) ListWebhookDeliveries(ctx context.Context, req *user.ListWebhookDeliveriesRequest) (*user.ListWebhookDeliveriesResponse, error) {
+	return c.users.ListWebhookDeliveries(ctx, req)
+}
+
+// ListDeadLetters calls the user service to list a webhook's dead letters
+func (c *Client) ListDeadLetters(ctx context.Context, req *user.WebhookRequest) (*user.ListDeadLettersResponse, error) {
+	return c.users.ListDeadLetters(ctx, req)
+}
+
+// RedeliverDeadLetter calls the user service to deliver a dead letter
+// again
+func (c *Client) RedeliverDeadLetter(ctx context.Context, req *user.RedeliverDeadLetterRequest) (*user.DeadLetter, error) {
+	return c.users.RedeliverDeadLetter(ctx, req)
+}
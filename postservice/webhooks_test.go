@@ -0,0 +1,315 @@
+package postservice
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/paper-social/feed-service/model"
+	"github.com/paper-social/feed-service/proto/user"
+)
+
+// receivedWebhook is a request a test receiver got
+type receivedWebhook struct {
+	header http.Header
+	body   []byte
+	at     time.Time
+}
+
+// webhookReceiver is an endpoint answering with the statuses it is given,
+// in turn, then with the last one
+type webhookReceiver struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	statuses []int
+	received []receivedWebhook
+}
+
+func newWebhookReceiver(t *testing.T, statuses ...int) *webhookReceiver {
+	r := &webhookReceiver{statuses: statuses}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+
+		r.mu.Lock()
+		r.received = append(r.received, receivedWebhook{header: req.Header.Clone(), body: body, at: time.Now()})
+		status := r.statuses[0]
+		if len(r.statuses) > 1 {
+			r.statuses = r.statuses[1:]
+		}
+		r.mu.Unlock()
+
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(r.Close)
+	return r
+}
+
+// setStatuses replaces the statuses still to be answered
+func (r *webhookReceiver) setStatuses(statuses ...int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.statuses = statuses
+}
+
+func (r *webhookReceiver) requests() []receivedWebhook {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]receivedWebhook(nil), r.received...)
+}
+
+// testWebhookConfig retries quickly and may reach httptest servers
+func testWebhookConfig() WebhookConfig {
+	cfg := DefaultWebhookConfig()
+	cfg.Timeout = 2 * time.Second
+	cfg.Workers = 2
+	cfg.QueueSize = 16
+	cfg.MaxAttempts = 3
+	cfg.MinRetry = 20 * time.Millisecond
+	cfg.MaxRetry = 40 * time.Millisecond
+	cfg.AllowPrivateNetworks = true
+	return cfg
+}
+
+// startDispatcher runs a dispatcher until the test ends
+func startDispatcher(t *testing.T, db *model.Database, cfg WebhookConfig) *WebhookDispatcher {
+	d := NewWebhookDispatcher(db, cfg)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		d.Run(ctx)
+		close(done)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+	return d
+}
+
+// postCreated creates a post by user1 and returns its event
+func postCreated(t *testing.T, db *model.Database, content string) model.Event {
+	t.Helper()
+	p, err := db.CreatePost("user1", content, model.PostOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	events, _ := db.ReadOutbox(0, 1000)
+	for _, event := range events {
+		if event.Type == model.EventPostCreated && event.PostID == p.ID {
+			return event
+		}
+	}
+	t.Fatalf("no event recorded for %s", p.ID)
+	return model.Event{}
+}
+
+func createTestWebhook(t *testing.T, db *model.Database, url string) *model.Webhook {
+	t.Helper()
+	webhook, err := db.CreateWebhook("user1", url, []model.EventType{model.EventPostCreated})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return webhook
+}
+
+func TestWebhookSignature(t *testing.T) {
+	db := model.NewDatabase()
+	receiver := newWebhookReceiver(t, http.StatusNoContent)
+	webhook := createTestWebhook(t, db, receiver.URL)
+	d := startDispatcher(t, db, testWebhookConfig())
+
+	event := postCreated(t, db, "Signed")
+	if err := d.Handle(context.Background(), event); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, "the delivery", func() bool { return len(receiver.requests()) == 1 })
+
+	req := receiver.requests()[0]
+	timestamp := req.header.Get(WebhookTimestampHeader)
+	if _, err := strconv.ParseInt(timestamp, 10, 64); err != nil {
+		t.Fatalf("timestamp header %q: %v", timestamp, err)
+	}
+	mac := hmac.New(sha256.New, []byte(webhook.Secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(req.body)
+	if want := "sha256=" + hex.EncodeToString(mac.Sum(nil)); req.header.Get(WebhookSignatureHeader) != want {
+		t.Errorf("signature = %q, want %q", req.header.Get(WebhookSignatureHeader), want)
+	}
+
+	// A signature made with another secret doesn't match
+	ts, _ := strconv.ParseInt(timestamp, 10, 64)
+	if SignWebhookPayload("whsec_other", ts, req.body) == req.header.Get(WebhookSignatureHeader) {
+		t.Error("signature doesn't depend on the secret")
+	}
+
+	if req.header.Get(WebhookEventHeader) != string(model.EventPostCreated) ||
+		req.header.Get(WebhookIDHeader) != webhookEventID(event.Sequence) ||
+		req.header.Get("Content-Type") != "application/json" {
+		t.Errorf("headers = %v", req.header)
+	}
+
+	var payload webhookPayload
+	if err := json.Unmarshal(req.body, &payload); err != nil {
+		t.Fatal(err)
+	}
+	if payload.ID != webhookEventID(event.Sequence) || payload.Post == nil || payload.Post.Content != "Signed" {
+		t.Errorf("payload = %+v", payload)
+	}
+}
+
+func TestWebhookRetriesWithBackoff(t *testing.T) {
+	db := model.NewDatabase()
+	receiver := newWebhookReceiver(t, http.StatusServiceUnavailable, http.StatusInternalServerError, http.StatusOK)
+	webhook := createTestWebhook(t, db, receiver.URL)
+	cfg := testWebhookConfig()
+	d := startDispatcher(t, db, cfg)
+
+	if err := d.Handle(context.Background(), postCreated(t, db, "Retried")); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, "the third attempt", func() bool {
+		deliveries, _ := db.GetWebhookDeliveries("user1", webhook.ID, 10)
+		return len(deliveries) == 3
+	})
+
+	requests := receiver.requests()
+	if len(requests) != 3 {
+		t.Fatalf("got %d requests, want 3", len(requests))
+	}
+	for i, wantGap := range []time.Duration{cfg.MinRetry, 2 * cfg.MinRetry} {
+		if gap := requests[i+1].at.Sub(requests[i].at); gap < wantGap {
+			t.Errorf("retry %d came after %v, want at least %v", i+1, gap, wantGap)
+		}
+		if requests[i+1].header.Get(WebhookIDHeader) != requests[0].header.Get(WebhookIDHeader) {
+			t.Errorf("retry %d has a different event ID", i+1)
+		}
+	}
+
+	// Newest first
+	deliveries, _ := db.GetWebhookDeliveries("user1", webhook.ID, 10)
+	for i, want := range []struct {
+		attempt, status int
+		ok              bool
+	}{{3, 200, true}, {2, 500, false}, {1, 503, false}} {
+		got := deliveries[i]
+		if got.Attempt != want.attempt || got.StatusCode != want.status || got.Succeeded() != want.ok {
+			t.Errorf("delivery %d = %+v, want attempt %d, status %d", i, got, want.attempt, want.status)
+		}
+	}
+	if deadLetters, _ := db.GetDeadLetters("user1", webhook.ID); len(deadLetters) != 0 {
+		t.Errorf("got %d dead letters for a delivered event", len(deadLetters))
+	}
+}
+
+func TestWebhookDeadLetterAndRedelivery(t *testing.T) {
+	db := model.NewDatabase()
+	receiver := newWebhookReceiver(t, http.StatusBadGateway)
+	webhook := createTestWebhook(t, db, receiver.URL)
+	d := startDispatcher(t, db, testWebhookConfig())
+	users := &UserServer{db: db, webhooks: d}
+
+	if err := d.Handle(context.Background(), postCreated(t, db, "Lost")); err != nil {
+		t.Fatal(err)
+	}
+	var deadLetters []*model.DeadLetter
+	waitFor(t, "the dead letter", func() bool {
+		deadLetters, _ = db.GetDeadLetters("user1", webhook.ID)
+		return len(deadLetters) == 1
+	})
+
+	dl := deadLetters[0]
+	if dl.Attempts != 3 || !strings.Contains(dl.LastError, "502") {
+		t.Errorf("dead letter = %+v, want 3 attempts ending in a 502", dl)
+	}
+	if w := db.GetWebhook(webhook.ID); w.ConsecutiveFailures != 1 || !w.Active {
+		t.Errorf("webhook = %+v, want active with 1 failure", w)
+	}
+
+	// Redelivered once the endpoint is back, with the same payload
+	receiver.setStatuses(http.StatusOK)
+	if _, err := users.RedeliverDeadLetter(context.Background(), &user.RedeliverDeadLetterRequest{UserId: "user1", DeadLetterId: dl.ID}); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, "the redelivery", func() bool { return len(receiver.requests()) == 4 })
+
+	requests := receiver.requests()
+	if string(requests[3].body) != string(dl.Payload) {
+		t.Errorf("redelivered %s, want %s", requests[3].body, dl.Payload)
+	}
+	if deadLetters, _ := db.GetDeadLetters("user1", webhook.ID); len(deadLetters) != 0 {
+		t.Errorf("dead letter still listed after redelivery")
+	}
+	waitFor(t, "the failure count to reset", func() bool {
+		return db.GetWebhook(webhook.ID).ConsecutiveFailures == 0
+	})
+}
+
+func TestWebhookRedeliveryKeepsDeadLetterIfNotQueued(t *testing.T) {
+	db := model.NewDatabase()
+	webhook := createTestWebhook(t, db, "https://example.com/hook")
+	db.AddDeadLetter(model.DeadLetter{WebhookID: webhook.ID, EventSequence: 1, EventType: model.EventPostCreated, Payload: []byte("{}"), Attempts: 3, FailedAt: time.Now()})
+	deadLetters, _ := db.GetDeadLetters("user1", webhook.ID)
+
+	// No workers and no room in the queue, so queueing waits until the
+	// request is cancelled
+	cfg := testWebhookConfig()
+	cfg.QueueSize = 0
+	users := &UserServer{db: db, webhooks: NewWebhookDispatcher(db, cfg)}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := users.RedeliverDeadLetter(ctx, &user.RedeliverDeadLetterRequest{UserId: "user1", DeadLetterId: deadLetters[0].ID}); err == nil {
+		t.Fatal("redelivery succeeded with a full queue")
+	}
+
+	after, _ := db.GetDeadLetters("user1", webhook.ID)
+	if len(after) != 1 || after[0].ID != deadLetters[0].ID {
+		t.Errorf("dead letters = %v, want %s kept", after, deadLetters[0].ID)
+	}
+}
+
+func TestWebhookDisabledAfterRepeatedFailures(t *testing.T) {
+	db := model.NewDatabase()
+	receiver := newWebhookReceiver(t, http.StatusInternalServerError)
+	webhook := createTestWebhook(t, db, receiver.URL)
+	cfg := testWebhookConfig()
+	cfg.MaxAttempts = 1
+	d := startDispatcher(t, db, cfg)
+
+	for i := range model.WebhookDisableAfter {
+		if err := d.Handle(context.Background(), postCreated(t, db, "Failing "+strconv.Itoa(i))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	waitFor(t, "the webhook to be disabled", func() bool { return !db.GetWebhook(webhook.ID).Active })
+
+	w := db.GetWebhook(webhook.ID)
+	if w.ConsecutiveFailures != model.WebhookDisableAfter || w.DisabledReason == "" {
+		t.Errorf("webhook = %+v", w)
+	}
+
+	// Disabled webhooks aren't sent new events
+	if len(db.WebhooksForEvent(postCreated(t, db, "Ignored"))) != 0 {
+		t.Error("disabled webhook still subscribed")
+	}
+
+	// Enabling it gives it a fresh start
+	enabled, err := db.SetWebhookActive("user1", webhook.ID, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !enabled.Active || enabled.ConsecutiveFailures != 0 || enabled.DisabledReason != "" {
+		t.Errorf("enabled webhook = %+v", enabled)
+	}
+}
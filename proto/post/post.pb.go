@@ -21,10 +21,223 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// Request message for ListPostsByUser
+// Kind of change a PostEvent describes
+type PostEventType int32
+
+const (
+	PostEventType_POST_EVENT_TYPE_CREATED PostEventType = 0
+	PostEventType_POST_EVENT_TYPE_UPDATED PostEventType = 1
+	PostEventType_POST_EVENT_TYPE_DELETED PostEventType = 2
+)
+
+// Enum value maps for PostEventType.
+var (
+	PostEventType_name = map[int32]string{
+		0: "POST_EVENT_TYPE_CREATED",
+		1: "POST_EVENT_TYPE_UPDATED",
+		2: "POST_EVENT_TYPE_DELETED",
+	}
+	PostEventType_value = map[string]int32{
+		"POST_EVENT_TYPE_CREATED": 0,
+		"POST_EVENT_TYPE_UPDATED": 1,
+		"POST_EVENT_TYPE_DELETED": 2,
+	}
+)
+
+func (x PostEventType) Enum() *PostEventType {
+	p := new(PostEventType)
+	*p = x
+	return p
+}
+
+func (x PostEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PostEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_post_post_proto_enumTypes[0].Descriptor()
+}
+
+func (PostEventType) Type() protoreflect.EnumType {
+	return &file_proto_post_post_proto_enumTypes[0]
+}
+
+func (x PostEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PostEventType.Descriptor instead.
+func (PostEventType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_post_post_proto_rawDescGZIP(), []int{0}
+}
+
+// Who can see a published post. Authors always see their own posts.
+type Visibility int32
+
+const (
+	Visibility_VISIBILITY_PUBLIC    Visibility = 0
+	Visibility_VISIBILITY_FOLLOWERS Visibility = 1 // Followers of the author and mentioned users
+	Visibility_VISIBILITY_MENTIONED Visibility = 2 // Only users mentioned in the post
+)
+
+// Enum value maps for Visibility.
+var (
+	Visibility_name = map[int32]string{
+		0: "VISIBILITY_PUBLIC",
+		1: "VISIBILITY_FOLLOWERS",
+		2: "VISIBILITY_MENTIONED",
+	}
+	Visibility_value = map[string]int32{
+		"VISIBILITY_PUBLIC":    0,
+		"VISIBILITY_FOLLOWERS": 1,
+		"VISIBILITY_MENTIONED": 2,
+	}
+)
+
+func (x Visibility) Enum() *Visibility {
+	p := new(Visibility)
+	*p = x
+	return p
+}
+
+func (x Visibility) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Visibility) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_post_post_proto_enumTypes[1].Descriptor()
+}
+
+func (Visibility) Type() protoreflect.EnumType {
+	return &file_proto_post_post_proto_enumTypes[1]
+}
+
+func (x Visibility) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Visibility.Descriptor instead.
+func (Visibility) EnumDescriptor() ([]byte, []int) {
+	return file_proto_post_post_proto_rawDescGZIP(), []int{1}
+}
+
+// Publication state of a post
+type PostStatus int32
+
+const (
+	PostStatus_POST_STATUS_PUBLISHED PostStatus = 0
+	PostStatus_POST_STATUS_DRAFT     PostStatus = 1
+	PostStatus_POST_STATUS_SCHEDULED PostStatus = 2
+)
+
+// Enum value maps for PostStatus.
+var (
+	PostStatus_name = map[int32]string{
+		0: "POST_STATUS_PUBLISHED",
+		1: "POST_STATUS_DRAFT",
+		2: "POST_STATUS_SCHEDULED",
+	}
+	PostStatus_value = map[string]int32{
+		"POST_STATUS_PUBLISHED": 0,
+		"POST_STATUS_DRAFT":     1,
+		"POST_STATUS_SCHEDULED": 2,
+	}
+)
+
+func (x PostStatus) Enum() *PostStatus {
+	p := new(PostStatus)
+	*p = x
+	return p
+}
+
+func (x PostStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PostStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_post_post_proto_enumTypes[2].Descriptor()
+}
+
+func (PostStatus) Type() protoreflect.EnumType {
+	return &file_proto_post_post_proto_enumTypes[2]
+}
+
+func (x PostStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PostStatus.Descriptor instead.
+func (PostStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_post_post_proto_rawDescGZIP(), []int{2}
+}
+
+// Kind of structured entity parsed from post content
+type EntityType int32
+
+const (
+	EntityType_ENTITY_TYPE_UNSPECIFIED EntityType = 0
+	EntityType_ENTITY_TYPE_LINK        EntityType = 1
+	EntityType_ENTITY_TYPE_IMAGE_LINK  EntityType = 2
+	EntityType_ENTITY_TYPE_MENTION     EntityType = 3
+	EntityType_ENTITY_TYPE_HASHTAG     EntityType = 4
+	EntityType_ENTITY_TYPE_CASHTAG     EntityType = 5
+)
+
+// Enum value maps for EntityType.
+var (
+	EntityType_name = map[int32]string{
+		0: "ENTITY_TYPE_UNSPECIFIED",
+		1: "ENTITY_TYPE_LINK",
+		2: "ENTITY_TYPE_IMAGE_LINK",
+		3: "ENTITY_TYPE_MENTION",
+		4: "ENTITY_TYPE_HASHTAG",
+		5: "ENTITY_TYPE_CASHTAG",
+	}
+	EntityType_value = map[string]int32{
+		"ENTITY_TYPE_UNSPECIFIED": 0,
+		"ENTITY_TYPE_LINK":        1,
+		"ENTITY_TYPE_IMAGE_LINK":  2,
+		"ENTITY_TYPE_MENTION":     3,
+		"ENTITY_TYPE_HASHTAG":     4,
+		"ENTITY_TYPE_CASHTAG":     5,
+	}
+)
+
+func (x EntityType) Enum() *EntityType {
+	p := new(EntityType)
+	*p = x
+	return p
+}
+
+func (x EntityType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EntityType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_post_post_proto_enumTypes[3].Descriptor()
+}
+
+func (EntityType) Type() protoreflect.EnumType {
+	return &file_proto_post_post_proto_enumTypes[3]
+}
+
+func (x EntityType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EntityType.Descriptor instead.
+func (EntityType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_post_post_proto_rawDescGZIP(), []int{3}
+}
+
+// Request message for ListPostsByUser. Set first or after to page through
+// the posts newest first, otherwise every post is returned.
 type ListPostsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ViewerId      string                 `protobuf:"bytes,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"` // User the posts are shown to, for visibility and poll results
+	First         int32                  `protobuf:"varint,3,opt,name=first,proto3" json:"first,omitempty"`
+	After         string                 `protobuf:"bytes,4,opt,name=after,proto3" json:"after,omitempty"` // end_cursor of the previous page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -36,59 +249,1546 @@ func (x *ListPostsRequest) Reset() {
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPostsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ListPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPostsRequest) ProtoMessage() {}
+
+func (x *ListPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_post_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPostsRequest.ProtoReflect.Descriptor instead.
+func (*ListPostsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_post_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListPostsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListPostsRequest) GetViewerId() string {
+	if x != nil {
+		return x.ViewerId
+	}
+	return ""
+}
+
+func (x *ListPostsRequest) GetFirst() int32 {
+	if x != nil {
+		return x.First
+	}
+	return 0
+}
+
+func (x *ListPostsRequest) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+// Request message for GetPost
+type GetPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ViewerId      string                 `protobuf:"bytes,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostRequest) Reset() {
+	*x = GetPostRequest{}
+	mi := &file_proto_post_post_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostRequest) ProtoMessage() {}
+
+func (x *GetPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_post_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostRequest.ProtoReflect.Descriptor instead.
+func (*GetPostRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_post_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetPostRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetPostRequest) GetViewerId() string {
+	if x != nil {
+		return x.ViewerId
+	}
+	return ""
+}
+
+// Request message for GetPosts
+type GetPostsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	ViewerId      string                 `protobuf:"bytes,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostsRequest) Reset() {
+	*x = GetPostsRequest{}
+	mi := &file_proto_post_post_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostsRequest) ProtoMessage() {}
+
+func (x *GetPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_post_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostsRequest.ProtoReflect.Descriptor instead.
+func (*GetPostsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_post_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetPostsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *GetPostsRequest) GetViewerId() string {
+	if x != nil {
+		return x.ViewerId
+	}
+	return ""
+}
+
+// Response message for ListPostsByUser
+type ListPostsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Posts         []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	EndCursor     string                 `protobuf:"bytes,2,opt,name=end_cursor,json=endCursor,proto3" json:"end_cursor,omitempty"` // Set when paging
+	HasNextPage   bool                   `protobuf:"varint,3,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPostsResponse) Reset() {
+	*x = ListPostsResponse{}
+	mi := &file_proto_post_post_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPostsResponse) ProtoMessage() {}
+
+func (x *ListPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_post_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPostsResponse.ProtoReflect.Descriptor instead.
+func (*ListPostsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_post_post_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListPostsResponse) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *ListPostsResponse) GetEndCursor() string {
+	if x != nil {
+		return x.EndCursor
+	}
+	return ""
+}
+
+func (x *ListPostsResponse) GetHasNextPage() bool {
+	if x != nil {
+		return x.HasNextPage
+	}
+	return false
+}
+
+// Request message for CreatePost
+type CreatePostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Media         []*Media               `protobuf:"bytes,3,rep,name=media,proto3" json:"media,omitempty"` // Attachments already stored by the media service
+	Poll          *PollInput             `protobuf:"bytes,4,opt,name=poll,proto3" json:"poll,omitempty"`
+	Visibility    Visibility             `protobuf:"varint,5,opt,name=visibility,proto3,enum=post.Visibility" json:"visibility,omitempty"`
+	ReplyToId     string                 `protobuf:"bytes,6,opt,name=reply_to_id,json=replyToId,proto3" json:"reply_to_id,omitempty"` // Post being replied to, which the author must be able to see
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePostRequest) Reset() {
+	*x = CreatePostRequest{}
+	mi := &file_proto_post_post_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePostRequest) ProtoMessage() {}
+
+func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_post_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePostRequest.ProtoReflect.Descriptor instead.
+func (*CreatePostRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_post_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreatePostRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetMedia() []*Media {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+func (x *CreatePostRequest) GetPoll() *PollInput {
+	if x != nil {
+		return x.Poll
+	}
+	return nil
+}
+
+func (x *CreatePostRequest) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VISIBILITY_PUBLIC
+}
+
+func (x *CreatePostRequest) GetReplyToId() string {
+	if x != nil {
+		return x.ReplyToId
+	}
+	return ""
+}
+
+// Poll to attach to a new post
+type PollInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Options       []string               `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Unix timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PollInput) Reset() {
+	*x = PollInput{}
+	mi := &file_proto_post_post_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PollInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollInput) ProtoMessage() {}
+
+func (x *PollInput) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_post_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollInput.ProtoReflect.Descriptor instead.
+func (*PollInput) Descriptor() ([]byte, []int) {
+	return file_proto_post_post_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PollInput) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *PollInput) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+// Request message for UpdatePost
+type UpdatePostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePostRequest) Reset() {
+	*x = UpdatePostRequest{}
+	mi := &file_proto_post_post_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePostRequest) ProtoMessage() {}
+
+func (x *UpdatePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_post_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePostRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePostRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_post_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdatePostRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdatePostRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// Request message for DeletePost
+type DeletePostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePostRequest) Reset() {
+	*x = DeletePostRequest{}
+	mi := &file_proto_post_post_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostRequest) ProtoMessage() {}
+
+func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_post_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostRequest.ProtoReflect.Descriptor instead.
+func (*DeletePostRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_post_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeletePostRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Request message for VotePoll
+type VotePollRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Option        int32                  `protobuf:"varint,3,opt,name=option,proto3" json:"option,omitempty"` // Index into the poll options
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VotePollRequest) Reset() {
+	*x = VotePollRequest{}
+	mi := &file_proto_post_post_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VotePollRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VotePollRequest) ProtoMessage() {}
+
+func (x *VotePollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_post_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VotePollRequest.ProtoReflect.Descriptor instead.
+func (*VotePollRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_post_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *VotePollRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *VotePollRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *VotePollRequest) GetOption() int32 {
+	if x != nil {
+		return x.Option
+	}
+	return 0
+}
+
+// Request message for LikePost, UnlikePost, RepostPost and UndoRepost
+type ReactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId        string                 `protobuf:"bytes,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactionRequest) Reset() {
+	*x = ReactionRequest{}
+	mi := &file_proto_post_post_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactionRequest) ProtoMessage() {}
+
+func (x *ReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_post_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactionRequest.ProtoReflect.Descriptor instead.
+func (*ReactionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_post_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ReactionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReactionRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+// Request message for SchedulePost
+type SchedulePostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PublishAt     int64                  `protobuf:"varint,2,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"` // Unix timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchedulePostRequest) Reset() {
+	*x = SchedulePostRequest{}
+	mi := &file_proto_post_post_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulePostRequest) ProtoMessage() {}
+
+func (x *SchedulePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_post_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulePostRequest.ProtoReflect.Descriptor instead.
+func (*SchedulePostRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_post_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SchedulePostRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SchedulePostRequest) GetPublishAt() int64 {
+	if x != nil {
+		return x.PublishAt
+	}
+	return 0
+}
+
+// Request message for CancelScheduledPost
+type CancelScheduledPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduledPostRequest) Reset() {
+	*x = CancelScheduledPostRequest{}
+	mi := &file_proto_post_post_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledPostRequest) ProtoMessage() {}
+
+func (x *CancelScheduledPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_post_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledPostRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledPostRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_post_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CancelScheduledPostRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Request message for PublishPost
+type PublishPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishPostRequest) Reset() {
+	*x = PublishPostRequest{}
+	mi := &file_proto_post_post_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishPostRequest) ProtoMessage() {}
+
+func (x *PublishPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_post_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishPostRequest.ProtoReflect.Descriptor instead.
+func (*PublishPostRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_post_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PublishPostRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Request message for ListDrafts
+type ListDraftsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDraftsRequest) Reset() {
+	*x = ListDraftsRequest{}
+	mi := &file_proto_post_post_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDraftsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDraftsRequest) ProtoMessage() {}
+
+func (x *ListDraftsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_post_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDraftsRequest.ProtoReflect.Descriptor instead.
+func (*ListDraftsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_post_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListDraftsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// Request message for PinPost
+type PinPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId        string                 `protobuf:"bytes,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinPostRequest) Reset() {
+	*x = PinPostRequest{}
+	mi := &file_proto_post_post_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinPostRequest) ProtoMessage() {}
+
+func (x *PinPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_post_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinPostRequest.ProtoReflect.Descriptor instead.
+func (*PinPostRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_post_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PinPostRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PinPostRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+// Request message for UnpinPost
+type UnpinPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId        string                 `protobuf:"bytes,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpinPostRequest) Reset() {
+	*x = UnpinPostRequest{}
+	mi := &file_proto_post_post_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpinPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinPostRequest) ProtoMessage() {}
+
+func (x *UnpinPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_post_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinPostRequest.ProtoReflect.Descriptor instead.
+func (*UnpinPostRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_post_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UnpinPostRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UnpinPostRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+// Request message for ListUserPosts
+type ListUserPostsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ViewerId      string                 `protobuf:"bytes,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"` // User the posts are shown to, for visibility and poll results
+	First         int32                  `protobuf:"varint,3,opt,name=first,proto3" json:"first,omitempty"`                      // Page size, defaults to 20
+	After         string                 `protobuf:"bytes,4,opt,name=after,proto3" json:"after,omitempty"`                       // end_cursor of the previous page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserPostsRequest) Reset() {
+	*x = ListUserPostsRequest{}
+	mi := &file_proto_post_post_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserPostsRequest) ProtoMessage() {}
+
+func (x *ListUserPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_post_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserPostsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserPostsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_post_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListUserPostsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListUserPostsRequest) GetViewerId() string {
+	if x != nil {
+		return x.ViewerId
+	}
+	return ""
+}
+
+func (x *ListUserPostsRequest) GetFirst() int32 {
+	if x != nil {
+		return x.First
+	}
+	return 0
+}
+
+func (x *ListUserPostsRequest) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+// Request message for SearchPosts
+type SearchPostsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	ViewerId      string                 `protobuf:"bytes,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"` // User the posts are shown to, for visibility and poll results
+	First         int32                  `protobuf:"varint,3,opt,name=first,proto3" json:"first,omitempty"`                      // Page size, defaults to 20
+	After         string                 `protobuf:"bytes,4,opt,name=after,proto3" json:"after,omitempty"`                       // end_cursor of the previous page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchPostsRequest) Reset() {
+	*x = SearchPostsRequest{}
+	mi := &file_proto_post_post_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPostsRequest) ProtoMessage() {}
+
+func (x *SearchPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_post_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPostsRequest.ProtoReflect.Descriptor instead.
+func (*SearchPostsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_post_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SearchPostsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchPostsRequest) GetViewerId() string {
+	if x != nil {
+		return x.ViewerId
+	}
+	return ""
+}
+
+func (x *SearchPostsRequest) GetFirst() int32 {
+	if x != nil {
+		return x.First
+	}
+	return 0
+}
+
+func (x *SearchPostsRequest) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+// Request message for RankTimeline
+type RankTimelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	First         int32                  `protobuf:"varint,2,opt,name=first,proto3" json:"first,omitempty"` // Defaults to 20, at most 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RankTimelineRequest) Reset() {
+	*x = RankTimelineRequest{}
+	mi := &file_proto_post_post_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RankTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RankTimelineRequest) ProtoMessage() {}
+
+func (x *RankTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_post_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RankTimelineRequest.ProtoReflect.Descriptor instead.
+func (*RankTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_post_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RankTimelineRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RankTimelineRequest) GetFirst() int32 {
+	if x != nil {
+		return x.First
+	}
+	return 0
+}
+
+// A page of posts
+type PostPage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Posts         []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	EndCursor     string                 `protobuf:"bytes,2,opt,name=end_cursor,json=endCursor,proto3" json:"end_cursor,omitempty"` // Empty if the page is empty
+	HasNextPage   bool                   `protobuf:"varint,3,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostPage) Reset() {
+	*x = PostPage{}
+	mi := &file_proto_post_post_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostPage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostPage) ProtoMessage() {}
+
+func (x *PostPage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_post_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostPage.ProtoReflect.Descriptor instead.
+func (*PostPage) Descriptor() ([]byte, []int) {
+	return file_proto_post_post_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PostPage) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *PostPage) GetEndCursor() string {
+	if x != nil {
+		return x.EndCursor
+	}
+	return ""
+}
+
+func (x *PostPage) GetHasNextPage() bool {
+	if x != nil {
+		return x.HasNextPage
+	}
+	return false
+}
+
+// Request message for WatchPosts. Leave log_id and after_sequence unset
+// to receive only new events.
+type WatchPostsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ViewerId      string                 `protobuf:"bytes,1,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"` // Only posts the viewer can see are sent
+	AuthorIds     []string               `protobuf:"bytes,2,rep,name=author_ids,json=authorIds,proto3" json:"author_ids,omitempty"`
+	LogId         string                 `protobuf:"bytes,3,opt,name=log_id,json=logId,proto3" json:"log_id,omitempty"`                          // log_id of the last event received
+	AfterSequence int64                  `protobuf:"varint,4,opt,name=after_sequence,json=afterSequence,proto3" json:"after_sequence,omitempty"` // Resume after this event
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPostsRequest) Reset() {
+	*x = WatchPostsRequest{}
+	mi := &file_proto_post_post_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPostsRequest) ProtoMessage() {}
+
+func (x *WatchPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_post_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPostsRequest.ProtoReflect.Descriptor instead.
+func (*WatchPostsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_post_post_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *WatchPostsRequest) GetViewerId() string {
+	if x != nil {
+		return x.ViewerId
+	}
+	return ""
+}
+
+func (x *WatchPostsRequest) GetAuthorIds() []string {
+	if x != nil {
+		return x.AuthorIds
+	}
+	return nil
+}
+
+func (x *WatchPostsRequest) GetLogId() string {
+	if x != nil {
+		return x.LogId
+	}
+	return ""
+}
+
+func (x *WatchPostsRequest) GetAfterSequence() int64 {
+	if x != nil {
+		return x.AfterSequence
+	}
+	return 0
+}
+
+// PostEvent is a change to a published post
+type PostEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          PostEventType          `protobuf:"varint,1,opt,name=type,proto3,enum=post.PostEventType" json:"type,omitempty"`
+	PostId        string                 `protobuf:"bytes,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`              // Author of the post
+	Post          *Post                  `protobuf:"bytes,4,opt,name=post,proto3" json:"post,omitempty"`                                // Unset for deletes
+	OccurredAt    int64                  `protobuf:"varint,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"` // Unix timestamp
+	Sequence      int64                  `protobuf:"varint,6,opt,name=sequence,proto3" json:"sequence,omitempty"`                       // Position in the event log, increasing by one per event
+	LogId         string                 `protobuf:"bytes,7,opt,name=log_id,json=logId,proto3" json:"log_id,omitempty"`                 // Identifies the log; it changes when the post service restarts
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostEvent) Reset() {
+	*x = PostEvent{}
+	mi := &file_proto_post_post_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostEvent) ProtoMessage() {}
+
+func (x *PostEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_post_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostEvent.ProtoReflect.Descriptor instead.
+func (*PostEvent) Descriptor() ([]byte, []int) {
+	return file_proto_post_post_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PostEvent) GetType() PostEventType {
+	if x != nil {
+		return x.Type
+	}
+	return PostEventType_POST_EVENT_TYPE_CREATED
+}
+
+func (x *PostEvent) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *PostEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PostEvent) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+func (x *PostEvent) GetOccurredAt() int64 {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return 0
+}
+
+func (x *PostEvent) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *PostEvent) GetLogId() string {
+	if x != nil {
+		return x.LogId
+	}
+	return ""
+}
+
+// Response message for DeletePost
+type DeletePostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePostResponse) Reset() {
+	*x = DeletePostResponse{}
+	mi := &file_proto_post_post_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostResponse) ProtoMessage() {}
+
+func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_post_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostResponse.ProtoReflect.Descriptor instead.
+func (*DeletePostResponse) Descriptor() ([]byte, []int) {
+	return file_proto_post_post_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeletePostResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeletePostResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Post represents a single post in the system
+type Post struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
+	Entities      []*Entity              `protobuf:"bytes,5,rep,name=entities,proto3" json:"entities,omitempty"`
+	LinkPreviews  []*LinkPreview         `protobuf:"bytes,6,rep,name=link_previews,json=linkPreviews,proto3" json:"link_previews,omitempty"` // Only links unfurled so far
+	Media         []*Media               `protobuf:"bytes,7,rep,name=media,proto3" json:"media,omitempty"`
+	Poll          *Poll                  `protobuf:"bytes,8,opt,name=poll,proto3" json:"poll,omitempty"`
+	Status        PostStatus             `protobuf:"varint,9,opt,name=status,proto3,enum=post.PostStatus" json:"status,omitempty"`
+	PublishAt     int64                  `protobuf:"varint,10,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"` // Unix timestamp, set for scheduled posts
+	Pinned        bool                   `protobuf:"varint,11,opt,name=pinned,proto3" json:"pinned,omitempty"`                        // Pinned to the author's profile
+	Visibility    Visibility             `protobuf:"varint,12,opt,name=visibility,proto3,enum=post.Visibility" json:"visibility,omitempty"`
+	ReplyToId     string                 `protobuf:"bytes,13,opt,name=reply_to_id,json=replyToId,proto3" json:"reply_to_id,omitempty"` // Post this one replies to
+	LikeCount     int32                  `protobuf:"varint,14,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
+	ReplyCount    int32                  `protobuf:"varint,15,opt,name=reply_count,json=replyCount,proto3" json:"reply_count,omitempty"`
+	RepostCount   int32                  `protobuf:"varint,16,opt,name=repost_count,json=repostCount,proto3" json:"repost_count,omitempty"`
+	Liked         bool                   `protobuf:"varint,17,opt,name=liked,proto3" json:"liked,omitempty"`       // By the viewer
+	Reposted      bool                   `protobuf:"varint,18,opt,name=reposted,proto3" json:"reposted,omitempty"` // By the viewer
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Post) Reset() {
+	*x = Post{}
+	mi := &file_proto_post_post_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Post) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Post) ProtoMessage() {}
+
+func (x *Post) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_post_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Post.ProtoReflect.Descriptor instead.
+func (*Post) Descriptor() ([]byte, []int) {
+	return file_proto_post_post_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Post) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Post) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Post) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Post) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Post) GetEntities() []*Entity {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+func (x *Post) GetLinkPreviews() []*LinkPreview {
+	if x != nil {
+		return x.LinkPreviews
+	}
+	return nil
+}
+
+func (x *Post) GetMedia() []*Media {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+func (x *Post) GetPoll() *Poll {
+	if x != nil {
+		return x.Poll
+	}
+	return nil
+}
+
+func (x *Post) GetStatus() PostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PostStatus_POST_STATUS_PUBLISHED
+}
+
+func (x *Post) GetPublishAt() int64 {
+	if x != nil {
+		return x.PublishAt
+	}
+	return 0
+}
+
+func (x *Post) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *Post) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VISIBILITY_PUBLIC
+}
+
+func (x *Post) GetReplyToId() string {
+	if x != nil {
+		return x.ReplyToId
+	}
+	return ""
+}
+
+func (x *Post) GetLikeCount() int32 {
+	if x != nil {
+		return x.LikeCount
+	}
+	return 0
+}
+
+func (x *Post) GetReplyCount() int32 {
+	if x != nil {
+		return x.ReplyCount
+	}
+	return 0
 }
 
-func (*ListPostsRequest) ProtoMessage() {}
-
-func (x *ListPostsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_post_post_proto_msgTypes[0]
+func (x *Post) GetRepostCount() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.RepostCount
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ListPostsRequest.ProtoReflect.Descriptor instead.
-func (*ListPostsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_post_post_proto_rawDescGZIP(), []int{0}
+func (x *Post) GetLiked() bool {
+	if x != nil {
+		return x.Liked
+	}
+	return false
 }
 
-func (x *ListPostsRequest) GetUserId() string {
+func (x *Post) GetReposted() bool {
 	if x != nil {
-		return x.UserId
+		return x.Reposted
 	}
-	return ""
+	return false
 }
 
-// Response message for ListPostsByUser
-type ListPostsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Posts         []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Poll attached to a post, as seen by the requesting viewer. Vote counts
+// are only filled in when results_visible is set.
+type Poll struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Options        []*PollOption          `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`
+	ExpiresAt      int64                  `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Unix timestamp
+	Closed         bool                   `protobuf:"varint,3,opt,name=closed,proto3" json:"closed,omitempty"`
+	ResultsVisible bool                   `protobuf:"varint,4,opt,name=results_visible,json=resultsVisible,proto3" json:"results_visible,omitempty"`
+	TotalVotes     int32                  `protobuf:"varint,5,opt,name=total_votes,json=totalVotes,proto3" json:"total_votes,omitempty"`
+	ViewerVote     int32                  `protobuf:"varint,6,opt,name=viewer_vote,json=viewerVote,proto3" json:"viewer_vote,omitempty"` // Option index, -1 if the viewer hasn't voted
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ListPostsResponse) Reset() {
-	*x = ListPostsResponse{}
-	mi := &file_proto_post_post_proto_msgTypes[1]
+func (x *Poll) Reset() {
+	*x = Poll{}
+	mi := &file_proto_post_post_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPostsResponse) String() string {
+func (x *Poll) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPostsResponse) ProtoMessage() {}
+func (*Poll) ProtoMessage() {}
 
-func (x *ListPostsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_post_post_proto_msgTypes[1]
+func (x *Poll) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_post_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -99,42 +1799,76 @@ func (x *ListPostsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPostsResponse.ProtoReflect.Descriptor instead.
-func (*ListPostsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_post_post_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use Poll.ProtoReflect.Descriptor instead.
+func (*Poll) Descriptor() ([]byte, []int) {
+	return file_proto_post_post_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ListPostsResponse) GetPosts() []*Post {
+func (x *Poll) GetOptions() []*PollOption {
 	if x != nil {
-		return x.Posts
+		return x.Options
 	}
 	return nil
 }
 
-// Request message for CreatePost
-type CreatePostRequest struct {
+func (x *Poll) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *Poll) GetClosed() bool {
+	if x != nil {
+		return x.Closed
+	}
+	return false
+}
+
+func (x *Poll) GetResultsVisible() bool {
+	if x != nil {
+		return x.ResultsVisible
+	}
+	return false
+}
+
+func (x *Poll) GetTotalVotes() int32 {
+	if x != nil {
+		return x.TotalVotes
+	}
+	return 0
+}
+
+func (x *Poll) GetViewerVote() int32 {
+	if x != nil {
+		return x.ViewerVote
+	}
+	return 0
+}
+
+type PollOption struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Votes         int32                  `protobuf:"varint,2,opt,name=votes,proto3" json:"votes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePostRequest) Reset() {
-	*x = CreatePostRequest{}
-	mi := &file_proto_post_post_proto_msgTypes[2]
+func (x *PollOption) Reset() {
+	*x = PollOption{}
+	mi := &file_proto_post_post_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePostRequest) String() string {
+func (x *PollOption) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePostRequest) ProtoMessage() {}
+func (*PollOption) ProtoMessage() {}
 
-func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_post_post_proto_msgTypes[2]
+func (x *PollOption) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_post_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -145,49 +1879,54 @@ func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePostRequest.ProtoReflect.Descriptor instead.
-func (*CreatePostRequest) Descriptor() ([]byte, []int) {
-	return file_proto_post_post_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use PollOption.ProtoReflect.Descriptor instead.
+func (*PollOption) Descriptor() ([]byte, []int) {
+	return file_proto_post_post_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *CreatePostRequest) GetUserId() string {
+func (x *PollOption) GetText() string {
 	if x != nil {
-		return x.UserId
+		return x.Text
 	}
 	return ""
 }
 
-func (x *CreatePostRequest) GetContent() string {
+func (x *PollOption) GetVotes() int32 {
 	if x != nil {
-		return x.Content
+		return x.Votes
 	}
-	return ""
+	return 0
 }
 
-// Request message for UpdatePost
-type UpdatePostRequest struct {
+// Media is an uploaded attachment on a post
+type Media struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	ThumbnailUrl  string                 `protobuf:"bytes,3,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
+	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Width         int32                  `protobuf:"varint,5,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int32                  `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	AltText       string                 `protobuf:"bytes,7,opt,name=alt_text,json=altText,proto3" json:"alt_text,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePostRequest) Reset() {
-	*x = UpdatePostRequest{}
-	mi := &file_proto_post_post_proto_msgTypes[3]
+func (x *Media) Reset() {
+	*x = Media{}
+	mi := &file_proto_post_post_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePostRequest) String() string {
+func (x *Media) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePostRequest) ProtoMessage() {}
+func (*Media) ProtoMessage() {}
 
-func (x *UpdatePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_post_post_proto_msgTypes[3]
+func (x *Media) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_post_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -198,94 +1937,89 @@ func (x *UpdatePostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePostRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePostRequest) Descriptor() ([]byte, []int) {
-	return file_proto_post_post_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use Media.ProtoReflect.Descriptor instead.
+func (*Media) Descriptor() ([]byte, []int) {
+	return file_proto_post_post_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *UpdatePostRequest) GetId() string {
+func (x *Media) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdatePostRequest) GetContent() string {
+func (x *Media) GetUrl() string {
 	if x != nil {
-		return x.Content
+		return x.Url
 	}
 	return ""
 }
 
-// Request message for DeletePost
-type DeletePostRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *DeletePostRequest) Reset() {
-	*x = DeletePostRequest{}
-	mi := &file_proto_post_post_proto_msgTypes[4]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Media) GetThumbnailUrl() string {
+	if x != nil {
+		return x.ThumbnailUrl
+	}
+	return ""
 }
 
-func (x *DeletePostRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Media) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
 }
 
-func (*DeletePostRequest) ProtoMessage() {}
-
-func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_post_post_proto_msgTypes[4]
+func (x *Media) GetWidth() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Width
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use DeletePostRequest.ProtoReflect.Descriptor instead.
-func (*DeletePostRequest) Descriptor() ([]byte, []int) {
-	return file_proto_post_post_proto_rawDescGZIP(), []int{4}
+func (x *Media) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
 }
 
-func (x *DeletePostRequest) GetId() string {
+func (x *Media) GetAltText() string {
 	if x != nil {
-		return x.Id
+		return x.AltText
 	}
 	return ""
 }
 
-// Response message for DeletePost
-type DeletePostResponse struct {
+// Entity is a typed span of post content
+type Entity struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Type          EntityType             `protobuf:"varint,1,opt,name=type,proto3,enum=post.EntityType" json:"type,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`    // Raw text as it appears in the content
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`  // Normalized value: URL, username, tag or symbol
+	Start         int32                  `protobuf:"varint,4,opt,name=start,proto3" json:"start,omitempty"` // UTF-16 offset of the first code unit
+	End           int32                  `protobuf:"varint,5,opt,name=end,proto3" json:"end,omitempty"`     // UTF-16 offset after the last code unit
+	ByteStart     int32                  `protobuf:"varint,6,opt,name=byte_start,json=byteStart,proto3" json:"byte_start,omitempty"`
+	ByteEnd       int32                  `protobuf:"varint,7,opt,name=byte_end,json=byteEnd,proto3" json:"byte_end,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeletePostResponse) Reset() {
-	*x = DeletePostResponse{}
-	mi := &file_proto_post_post_proto_msgTypes[5]
+func (x *Entity) Reset() {
+	*x = Entity{}
+	mi := &file_proto_post_post_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePostResponse) String() string {
+func (x *Entity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePostResponse) ProtoMessage() {}
+func (*Entity) ProtoMessage() {}
 
-func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_post_post_proto_msgTypes[5]
+func (x *Entity) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_post_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -296,51 +2030,90 @@ func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePostResponse.ProtoReflect.Descriptor instead.
-func (*DeletePostResponse) Descriptor() ([]byte, []int) {
-	return file_proto_post_post_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use Entity.ProtoReflect.Descriptor instead.
+func (*Entity) Descriptor() ([]byte, []int) {
+	return file_proto_post_post_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *DeletePostResponse) GetSuccess() bool {
+func (x *Entity) GetType() EntityType {
 	if x != nil {
-		return x.Success
+		return x.Type
 	}
-	return false
+	return EntityType_ENTITY_TYPE_UNSPECIFIED
 }
 
-func (x *DeletePostResponse) GetMessage() string {
+func (x *Entity) GetText() string {
 	if x != nil {
-		return x.Message
+		return x.Text
 	}
 	return ""
 }
 
-// Post represents a single post in the system
-type Post struct {
+func (x *Entity) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Entity) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *Entity) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *Entity) GetByteStart() int32 {
+	if x != nil {
+		return x.ByteStart
+	}
+	return 0
+}
+
+func (x *Entity) GetByteEnd() int32 {
+	if x != nil {
+		return x.ByteEnd
+	}
+	return 0
+}
+
+// LinkPreview is the unfurled metadata of a page linked from a post
+type LinkPreview struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,4,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	SiteName      string                 `protobuf:"bytes,5,opt,name=site_name,json=siteName,proto3" json:"site_name,omitempty"`
+	AuthorName    string                 `protobuf:"bytes,6,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
+	Type          string                 `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`
+	FetchedAt     int64                  `protobuf:"varint,8,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"` // Unix timestamp
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Post) Reset() {
-	*x = Post{}
-	mi := &file_proto_post_post_proto_msgTypes[6]
+func (x *LinkPreview) Reset() {
+	*x = LinkPreview{}
+	mi := &file_proto_post_post_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Post) String() string {
+func (x *LinkPreview) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Post) ProtoMessage() {}
+func (*LinkPreview) ProtoMessage() {}
 
-func (x *Post) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_post_post_proto_msgTypes[6]
+func (x *LinkPreview) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_post_post_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -351,35 +2124,63 @@ func (x *Post) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Post.ProtoReflect.Descriptor instead.
-func (*Post) Descriptor() ([]byte, []int) {
-	return file_proto_post_post_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use LinkPreview.ProtoReflect.Descriptor instead.
+func (*LinkPreview) Descriptor() ([]byte, []int) {
+	return file_proto_post_post_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *Post) GetId() string {
+func (x *LinkPreview) GetUrl() string {
 	if x != nil {
-		return x.Id
+		return x.Url
 	}
 	return ""
 }
 
-func (x *Post) GetUserId() string {
+func (x *LinkPreview) GetTitle() string {
 	if x != nil {
-		return x.UserId
+		return x.Title
 	}
 	return ""
 }
 
-func (x *Post) GetContent() string {
+func (x *LinkPreview) GetDescription() string {
 	if x != nil {
-		return x.Content
+		return x.Description
 	}
 	return ""
 }
 
-func (x *Post) GetCreatedAt() int64 {
+func (x *LinkPreview) GetImageUrl() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetSiteName() string {
+	if x != nil {
+		return x.SiteName
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetAuthorName() string {
+	if x != nil {
+		return x.AuthorName
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetFetchedAt() int64 {
+	if x != nil {
+		return x.FetchedAt
 	}
 	return 0
 }
@@ -388,31 +2189,199 @@ var File_proto_post_post_proto protoreflect.FileDescriptor
 
 const file_proto_post_post_proto_rawDesc = "" +
 	"\n" +
-	"\x15proto/post/post.proto\x12\x04post\"+\n" +
+	"\x15proto/post/post.proto\x12\x04post\"t\n" +
 	"\x10ListPostsRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"5\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tviewer_id\x18\x02 \x01(\tR\bviewerId\x12\x14\n" +
+	"\x05first\x18\x03 \x01(\x05R\x05first\x12\x14\n" +
+	"\x05after\x18\x04 \x01(\tR\x05after\"=\n" +
+	"\x0eGetPostRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tviewer_id\x18\x02 \x01(\tR\bviewerId\"@\n" +
+	"\x0fGetPostsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\x12\x1b\n" +
+	"\tviewer_id\x18\x02 \x01(\tR\bviewerId\"x\n" +
 	"\x11ListPostsResponse\x12 \n" +
 	"\x05posts\x18\x01 \x03(\v2\n" +
-	".post.PostR\x05posts\"F\n" +
+	".post.PostR\x05posts\x12\x1d\n" +
+	"\n" +
+	"end_cursor\x18\x02 \x01(\tR\tendCursor\x12\"\n" +
+	"\rhas_next_page\x18\x03 \x01(\bR\vhasNextPage\"\xe0\x01\n" +
 	"\x11CreatePostRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x18\n" +
-	"\acontent\x18\x02 \x01(\tR\acontent\"=\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12!\n" +
+	"\x05media\x18\x03 \x03(\v2\v.post.MediaR\x05media\x12#\n" +
+	"\x04poll\x18\x04 \x01(\v2\x0f.post.PollInputR\x04poll\x120\n" +
+	"\n" +
+	"visibility\x18\x05 \x01(\x0e2\x10.post.VisibilityR\n" +
+	"visibility\x12\x1e\n" +
+	"\vreply_to_id\x18\x06 \x01(\tR\treplyToId\"D\n" +
+	"\tPollInput\x12\x18\n" +
+	"\aoptions\x18\x01 \x03(\tR\aoptions\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\x03R\texpiresAt\"=\n" +
 	"\x11UpdatePostRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
 	"\acontent\x18\x02 \x01(\tR\acontent\"#\n" +
 	"\x11DeletePostRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"H\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"[\n" +
+	"\x0fVotePollRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06option\x18\x03 \x01(\x05R\x06option\"C\n" +
+	"\x0fReactionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\apost_id\x18\x02 \x01(\tR\x06postId\"D\n" +
+	"\x13SchedulePostRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"publish_at\x18\x02 \x01(\x03R\tpublishAt\",\n" +
+	"\x1aCancelScheduledPostRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"$\n" +
+	"\x12PublishPostRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\",\n" +
+	"\x11ListDraftsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"B\n" +
+	"\x0ePinPostRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\apost_id\x18\x02 \x01(\tR\x06postId\"D\n" +
+	"\x10UnpinPostRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\apost_id\x18\x02 \x01(\tR\x06postId\"x\n" +
+	"\x14ListUserPostsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tviewer_id\x18\x02 \x01(\tR\bviewerId\x12\x14\n" +
+	"\x05first\x18\x03 \x01(\x05R\x05first\x12\x14\n" +
+	"\x05after\x18\x04 \x01(\tR\x05after\"s\n" +
+	"\x12SearchPostsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1b\n" +
+	"\tviewer_id\x18\x02 \x01(\tR\bviewerId\x12\x14\n" +
+	"\x05first\x18\x03 \x01(\x05R\x05first\x12\x14\n" +
+	"\x05after\x18\x04 \x01(\tR\x05after\"D\n" +
+	"\x13RankTimelineRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05first\x18\x02 \x01(\x05R\x05first\"o\n" +
+	"\bPostPage\x12 \n" +
+	"\x05posts\x18\x01 \x03(\v2\n" +
+	".post.PostR\x05posts\x12\x1d\n" +
+	"\n" +
+	"end_cursor\x18\x02 \x01(\tR\tendCursor\x12\"\n" +
+	"\rhas_next_page\x18\x03 \x01(\bR\vhasNextPage\"\x8d\x01\n" +
+	"\x11WatchPostsRequest\x12\x1b\n" +
+	"\tviewer_id\x18\x01 \x01(\tR\bviewerId\x12\x1d\n" +
+	"\n" +
+	"author_ids\x18\x02 \x03(\tR\tauthorIds\x12\x15\n" +
+	"\x06log_id\x18\x03 \x01(\tR\x05logId\x12%\n" +
+	"\x0eafter_sequence\x18\x04 \x01(\x03R\rafterSequence\"\xda\x01\n" +
+	"\tPostEvent\x12'\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x13.post.PostEventTypeR\x04type\x12\x17\n" +
+	"\apost_id\x18\x02 \x01(\tR\x06postId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1e\n" +
+	"\x04post\x18\x04 \x01(\v2\n" +
+	".post.PostR\x04post\x12\x1f\n" +
+	"\voccurred_at\x18\x05 \x01(\x03R\n" +
+	"occurredAt\x12\x1a\n" +
+	"\bsequence\x18\x06 \x01(\x03R\bsequence\x12\x15\n" +
+	"\x06log_id\x18\a \x01(\tR\x05logId\"H\n" +
 	"\x12DeletePostResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"h\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xd5\x04\n" +
 	"\x04Post\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x18\n" +
 	"\acontent\x18\x03 \x01(\tR\acontent\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x04 \x01(\x03R\tcreatedAt2\xf8\x01\n" +
+	"created_at\x18\x04 \x01(\x03R\tcreatedAt\x12(\n" +
+	"\bentities\x18\x05 \x03(\v2\f.post.EntityR\bentities\x126\n" +
+	"\rlink_previews\x18\x06 \x03(\v2\x11.post.LinkPreviewR\flinkPreviews\x12!\n" +
+	"\x05media\x18\a \x03(\v2\v.post.MediaR\x05media\x12\x1e\n" +
+	"\x04poll\x18\b \x01(\v2\n" +
+	".post.PollR\x04poll\x12(\n" +
+	"\x06status\x18\t \x01(\x0e2\x10.post.PostStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"publish_at\x18\n" +
+	" \x01(\x03R\tpublishAt\x12\x16\n" +
+	"\x06pinned\x18\v \x01(\bR\x06pinned\x120\n" +
+	"\n" +
+	"visibility\x18\f \x01(\x0e2\x10.post.VisibilityR\n" +
+	"visibility\x12\x1e\n" +
+	"\vreply_to_id\x18\r \x01(\tR\treplyToId\x12\x1d\n" +
+	"\n" +
+	"like_count\x18\x0e \x01(\x05R\tlikeCount\x12\x1f\n" +
+	"\vreply_count\x18\x0f \x01(\x05R\n" +
+	"replyCount\x12!\n" +
+	"\frepost_count\x18\x10 \x01(\x05R\vrepostCount\x12\x14\n" +
+	"\x05liked\x18\x11 \x01(\bR\x05liked\x12\x1a\n" +
+	"\breposted\x18\x12 \x01(\bR\breposted\"\xd4\x01\n" +
+	"\x04Poll\x12*\n" +
+	"\aoptions\x18\x01 \x03(\v2\x10.post.PollOptionR\aoptions\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\x03R\texpiresAt\x12\x16\n" +
+	"\x06closed\x18\x03 \x01(\bR\x06closed\x12'\n" +
+	"\x0fresults_visible\x18\x04 \x01(\bR\x0eresultsVisible\x12\x1f\n" +
+	"\vtotal_votes\x18\x05 \x01(\x05R\n" +
+	"totalVotes\x12\x1f\n" +
+	"\vviewer_vote\x18\x06 \x01(\x05R\n" +
+	"viewerVote\"6\n" +
+	"\n" +
+	"PollOption\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x14\n" +
+	"\x05votes\x18\x02 \x01(\x05R\x05votes\"\xba\x01\n" +
+	"\x05Media\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12#\n" +
+	"\rthumbnail_url\x18\x03 \x01(\tR\fthumbnailUrl\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x14\n" +
+	"\x05width\x18\x05 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x06 \x01(\x05R\x06height\x12\x19\n" +
+	"\balt_text\x18\a \x01(\tR\aaltText\"\xba\x01\n" +
+	"\x06Entity\x12$\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x10.post.EntityTypeR\x04type\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12\x14\n" +
+	"\x05start\x18\x04 \x01(\x05R\x05start\x12\x10\n" +
+	"\x03end\x18\x05 \x01(\x05R\x03end\x12\x1d\n" +
+	"\n" +
+	"byte_start\x18\x06 \x01(\x05R\tbyteStart\x12\x19\n" +
+	"\bbyte_end\x18\a \x01(\x05R\abyteEnd\"\xe5\x01\n" +
+	"\vLinkPreview\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1b\n" +
+	"\timage_url\x18\x04 \x01(\tR\bimageUrl\x12\x1b\n" +
+	"\tsite_name\x18\x05 \x01(\tR\bsiteName\x12\x1f\n" +
+	"\vauthor_name\x18\x06 \x01(\tR\n" +
+	"authorName\x12\x12\n" +
+	"\x04type\x18\a \x01(\tR\x04type\x12\x1d\n" +
+	"\n" +
+	"fetched_at\x18\b \x01(\x03R\tfetchedAt*f\n" +
+	"\rPostEventType\x12\x1b\n" +
+	"\x17POST_EVENT_TYPE_CREATED\x10\x00\x12\x1b\n" +
+	"\x17POST_EVENT_TYPE_UPDATED\x10\x01\x12\x1b\n" +
+	"\x17POST_EVENT_TYPE_DELETED\x10\x02*W\n" +
+	"\n" +
+	"Visibility\x12\x15\n" +
+	"\x11VISIBILITY_PUBLIC\x10\x00\x12\x18\n" +
+	"\x14VISIBILITY_FOLLOWERS\x10\x01\x12\x18\n" +
+	"\x14VISIBILITY_MENTIONED\x10\x02*Y\n" +
+	"\n" +
+	"PostStatus\x12\x19\n" +
+	"\x15POST_STATUS_PUBLISHED\x10\x00\x12\x15\n" +
+	"\x11POST_STATUS_DRAFT\x10\x01\x12\x19\n" +
+	"\x15POST_STATUS_SCHEDULED\x10\x02*\xa6\x01\n" +
+	"\n" +
+	"EntityType\x12\x1b\n" +
+	"\x17ENTITY_TYPE_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10ENTITY_TYPE_LINK\x10\x01\x12\x1a\n" +
+	"\x16ENTITY_TYPE_IMAGE_LINK\x10\x02\x12\x17\n" +
+	"\x13ENTITY_TYPE_MENTION\x10\x03\x12\x17\n" +
+	"\x13ENTITY_TYPE_HASHTAG\x10\x04\x12\x17\n" +
+	"\x13ENTITY_TYPE_CASHTAG\x10\x052\xc7\t\n" +
 	"\vPostService\x12B\n" +
-	"\x0fListPostsByUser\x12\x16.post.ListPostsRequest\x1a\x17.post.ListPostsResponse\x121\n" +
+	"\x0fListPostsByUser\x12\x16.post.ListPostsRequest\x1a\x17.post.ListPostsResponse\x12+\n" +
+	"\aGetPost\x12\x14.post.GetPostRequest\x1a\n" +
+	".post.Post\x12:\n" +
+	"\bGetPosts\x12\x15.post.GetPostsRequest\x1a\x17.post.ListPostsResponse\x121\n" +
 	"\n" +
 	"CreatePost\x12\x17.post.CreatePostRequest\x1a\n" +
 	".post.Post\x121\n" +
@@ -420,7 +2389,39 @@ const file_proto_post_post_proto_rawDesc = "" +
 	"UpdatePost\x12\x17.post.UpdatePostRequest\x1a\n" +
 	".post.Post\x12?\n" +
 	"\n" +
-	"DeletePost\x12\x17.post.DeletePostRequest\x1a\x18.post.DeletePostResponseB1Z/github.com/paper-social/feed-service/proto/postb\x06proto3"
+	"DeletePost\x12\x17.post.DeletePostRequest\x1a\x18.post.DeletePostResponse\x12-\n" +
+	"\bVotePoll\x12\x15.post.VotePollRequest\x1a\n" +
+	".post.Post\x12-\n" +
+	"\bLikePost\x12\x15.post.ReactionRequest\x1a\n" +
+	".post.Post\x12/\n" +
+	"\n" +
+	"UnlikePost\x12\x15.post.ReactionRequest\x1a\n" +
+	".post.Post\x12/\n" +
+	"\n" +
+	"RepostPost\x12\x15.post.ReactionRequest\x1a\n" +
+	".post.Post\x12/\n" +
+	"\n" +
+	"UndoRepost\x12\x15.post.ReactionRequest\x1a\n" +
+	".post.Post\x120\n" +
+	"\tSaveDraft\x12\x17.post.CreatePostRequest\x1a\n" +
+	".post.Post\x125\n" +
+	"\fSchedulePost\x12\x19.post.SchedulePostRequest\x1a\n" +
+	".post.Post\x12C\n" +
+	"\x13CancelScheduledPost\x12 .post.CancelScheduledPostRequest\x1a\n" +
+	".post.Post\x123\n" +
+	"\vPublishPost\x12\x18.post.PublishPostRequest\x1a\n" +
+	".post.Post\x12>\n" +
+	"\n" +
+	"ListDrafts\x12\x17.post.ListDraftsRequest\x1a\x17.post.ListPostsResponse\x12+\n" +
+	"\aPinPost\x12\x14.post.PinPostRequest\x1a\n" +
+	".post.Post\x12/\n" +
+	"\tUnpinPost\x12\x16.post.UnpinPostRequest\x1a\n" +
+	".post.Post\x12;\n" +
+	"\rListUserPosts\x12\x1a.post.ListUserPostsRequest\x1a\x0e.post.PostPage\x127\n" +
+	"\vSearchPosts\x12\x18.post.SearchPostsRequest\x1a\x0e.post.PostPage\x12B\n" +
+	"\fRankTimeline\x12\x19.post.RankTimelineRequest\x1a\x17.post.ListPostsResponse\x128\n" +
+	"\n" +
+	"WatchPosts\x12\x17.post.WatchPostsRequest\x1a\x0f.post.PostEvent0\x01B1Z/github.com/paper-social/feed-service/proto/postb\x06proto3"
 
 var (
 	file_proto_post_post_proto_rawDescOnce sync.Once
@@ -434,31 +2435,108 @@ func file_proto_post_post_proto_rawDescGZIP() []byte {
 	return file_proto_post_post_proto_rawDescData
 }
 
-var file_proto_post_post_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_proto_post_post_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_proto_post_post_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_proto_post_post_proto_goTypes = []any{
-	(*ListPostsRequest)(nil),   // 0: post.ListPostsRequest
-	(*ListPostsResponse)(nil),  // 1: post.ListPostsResponse
-	(*CreatePostRequest)(nil),  // 2: post.CreatePostRequest
-	(*UpdatePostRequest)(nil),  // 3: post.UpdatePostRequest
-	(*DeletePostRequest)(nil),  // 4: post.DeletePostRequest
-	(*DeletePostResponse)(nil), // 5: post.DeletePostResponse
-	(*Post)(nil),               // 6: post.Post
+	(PostEventType)(0),                 // 0: post.PostEventType
+	(Visibility)(0),                    // 1: post.Visibility
+	(PostStatus)(0),                    // 2: post.PostStatus
+	(EntityType)(0),                    // 3: post.EntityType
+	(*ListPostsRequest)(nil),           // 4: post.ListPostsRequest
+	(*GetPostRequest)(nil),             // 5: post.GetPostRequest
+	(*GetPostsRequest)(nil),            // 6: post.GetPostsRequest
+	(*ListPostsResponse)(nil),          // 7: post.ListPostsResponse
+	(*CreatePostRequest)(nil),          // 8: post.CreatePostRequest
+	(*PollInput)(nil),                  // 9: post.PollInput
+	(*UpdatePostRequest)(nil),          // 10: post.UpdatePostRequest
+	(*DeletePostRequest)(nil),          // 11: post.DeletePostRequest
+	(*VotePollRequest)(nil),            // 12: post.VotePollRequest
+	(*ReactionRequest)(nil),            // 13: post.ReactionRequest
+	(*SchedulePostRequest)(nil),        // 14: post.SchedulePostRequest
+	(*CancelScheduledPostRequest)(nil), // 15: post.CancelScheduledPostRequest
+	(*PublishPostRequest)(nil),         // 16: post.PublishPostRequest
+	(*ListDraftsRequest)(nil),          // 17: post.ListDraftsRequest
+	(*PinPostRequest)(nil),             // 18: post.PinPostRequest
+	(*UnpinPostRequest)(nil),           // 19: post.UnpinPostRequest
+	(*ListUserPostsRequest)(nil),       // 20: post.ListUserPostsRequest
+	(*SearchPostsRequest)(nil),         // 21: post.SearchPostsRequest
+	(*RankTimelineRequest)(nil),        // 22: post.RankTimelineRequest
+	(*PostPage)(nil),                   // 23: post.PostPage
+	(*WatchPostsRequest)(nil),          // 24: post.WatchPostsRequest
+	(*PostEvent)(nil),                  // 25: post.PostEvent
+	(*DeletePostResponse)(nil),         // 26: post.DeletePostResponse
+	(*Post)(nil),                       // 27: post.Post
+	(*Poll)(nil),                       // 28: post.Poll
+	(*PollOption)(nil),                 // 29: post.PollOption
+	(*Media)(nil),                      // 30: post.Media
+	(*Entity)(nil),                     // 31: post.Entity
+	(*LinkPreview)(nil),                // 32: post.LinkPreview
 }
 var file_proto_post_post_proto_depIdxs = []int32{
-	6, // 0: post.ListPostsResponse.posts:type_name -> post.Post
-	0, // 1: post.PostService.ListPostsByUser:input_type -> post.ListPostsRequest
-	2, // 2: post.PostService.CreatePost:input_type -> post.CreatePostRequest
-	3, // 3: post.PostService.UpdatePost:input_type -> post.UpdatePostRequest
-	4, // 4: post.PostService.DeletePost:input_type -> post.DeletePostRequest
-	1, // 5: post.PostService.ListPostsByUser:output_type -> post.ListPostsResponse
-	6, // 6: post.PostService.CreatePost:output_type -> post.Post
-	6, // 7: post.PostService.UpdatePost:output_type -> post.Post
-	5, // 8: post.PostService.DeletePost:output_type -> post.DeletePostResponse
-	5, // [5:9] is the sub-list for method output_type
-	1, // [1:5] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	27, // 0: post.ListPostsResponse.posts:type_name -> post.Post
+	30, // 1: post.CreatePostRequest.media:type_name -> post.Media
+	9,  // 2: post.CreatePostRequest.poll:type_name -> post.PollInput
+	1,  // 3: post.CreatePostRequest.visibility:type_name -> post.Visibility
+	27, // 4: post.PostPage.posts:type_name -> post.Post
+	0,  // 5: post.PostEvent.type:type_name -> post.PostEventType
+	27, // 6: post.PostEvent.post:type_name -> post.Post
+	31, // 7: post.Post.entities:type_name -> post.Entity
+	32, // 8: post.Post.link_previews:type_name -> post.LinkPreview
+	30, // 9: post.Post.media:type_name -> post.Media
+	28, // 10: post.Post.poll:type_name -> post.Poll
+	2,  // 11: post.Post.status:type_name -> post.PostStatus
+	1,  // 12: post.Post.visibility:type_name -> post.Visibility
+	29, // 13: post.Poll.options:type_name -> post.PollOption
+	3,  // 14: post.Entity.type:type_name -> post.EntityType
+	4,  // 15: post.PostService.ListPostsByUser:input_type -> post.ListPostsRequest
+	5,  // 16: post.PostService.GetPost:input_type -> post.GetPostRequest
+	6,  // 17: post.PostService.GetPosts:input_type -> post.GetPostsRequest
+	8,  // 18: post.PostService.CreatePost:input_type -> post.CreatePostRequest
+	10, // 19: post.PostService.UpdatePost:input_type -> post.UpdatePostRequest
+	11, // 20: post.PostService.DeletePost:input_type -> post.DeletePostRequest
+	12, // 21: post.PostService.VotePoll:input_type -> post.VotePollRequest
+	13, // 22: post.PostService.LikePost:input_type -> post.ReactionRequest
+	13, // 23: post.PostService.UnlikePost:input_type -> post.ReactionRequest
+	13, // 24: post.PostService.RepostPost:input_type -> post.ReactionRequest
+	13, // 25: post.PostService.UndoRepost:input_type -> post.ReactionRequest
+	8,  // 26: post.PostService.SaveDraft:input_type -> post.CreatePostRequest
+	14, // 27: post.PostService.SchedulePost:input_type -> post.SchedulePostRequest
+	15, // 28: post.PostService.CancelScheduledPost:input_type -> post.CancelScheduledPostRequest
+	16, // 29: post.PostService.PublishPost:input_type -> post.PublishPostRequest
+	17, // 30: post.PostService.ListDrafts:input_type -> post.ListDraftsRequest
+	18, // 31: post.PostService.PinPost:input_type -> post.PinPostRequest
+	19, // 32: post.PostService.UnpinPost:input_type -> post.UnpinPostRequest
+	20, // 33: post.PostService.ListUserPosts:input_type -> post.ListUserPostsRequest
+	21, // 34: post.PostService.SearchPosts:input_type -> post.SearchPostsRequest
+	22, // 35: post.PostService.RankTimeline:input_type -> post.RankTimelineRequest
+	24, // 36: post.PostService.WatchPosts:input_type -> post.WatchPostsRequest
+	7,  // 37: post.PostService.ListPostsByUser:output_type -> post.ListPostsResponse
+	27, // 38: post.PostService.GetPost:output_type -> post.Post
+	7,  // 39: post.PostService.GetPosts:output_type -> post.ListPostsResponse
+	27, // 40: post.PostService.CreatePost:output_type -> post.Post
+	27, // 41: post.PostService.UpdatePost:output_type -> post.Post
+	26, // 42: post.PostService.DeletePost:output_type -> post.DeletePostResponse
+	27, // 43: post.PostService.VotePoll:output_type -> post.Post
+	27, // 44: post.PostService.LikePost:output_type -> post.Post
+	27, // 45: post.PostService.UnlikePost:output_type -> post.Post
+	27, // 46: post.PostService.RepostPost:output_type -> post.Post
+	27, // 47: post.PostService.UndoRepost:output_type -> post.Post
+	27, // 48: post.PostService.SaveDraft:output_type -> post.Post
+	27, // 49: post.PostService.SchedulePost:output_type -> post.Post
+	27, // 50: post.PostService.CancelScheduledPost:output_type -> post.Post
+	27, // 51: post.PostService.PublishPost:output_type -> post.Post
+	7,  // 52: post.PostService.ListDrafts:output_type -> post.ListPostsResponse
+	27, // 53: post.PostService.PinPost:output_type -> post.Post
+	27, // 54: post.PostService.UnpinPost:output_type -> post.Post
+	23, // 55: post.PostService.ListUserPosts:output_type -> post.PostPage
+	23, // 56: post.PostService.SearchPosts:output_type -> post.PostPage
+	7,  // 57: post.PostService.RankTimeline:output_type -> post.ListPostsResponse
+	25, // 58: post.PostService.WatchPosts:output_type -> post.PostEvent
+	37, // [37:59] is the sub-list for method output_type
+	15, // [15:37] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_proto_post_post_proto_init() }
@@ -471,13 +2549,14 @@ func file_proto_post_post_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_post_post_proto_rawDesc), len(file_proto_post_post_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   7,
+			NumEnums:      4,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_post_post_proto_goTypes,
 		DependencyIndexes: file_proto_post_post_proto_depIdxs,
+		EnumInfos:         file_proto_post_post_proto_enumTypes,
 		MessageInfos:      file_proto_post_post_proto_msgTypes,
 	}.Build()
 	File_proto_post_post_proto = out.File
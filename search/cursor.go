@@ -0,0 +1,93 @@
+package search
+
+import (
+	"encoding/base64"
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidCursor is returned for cursors that weren't produced by us
+var ErrInvalidCursor = errors.New("invalid search cursor")
+
+// Cursor marks a position in search results. Scores depend on when they
+// are computed and on the rest of the index, so the cursor carries the
+// ranking of the first page and later pages are ranked the same way.
+type Cursor struct {
+	Ranking Ranking
+	Score   float64
+	ID      string
+}
+
+// CursorFor returns the cursor pointing just after hit in results ranked
+// by r
+func CursorFor(hit Hit, r Ranking) Cursor {
+	return Cursor{Ranking: r, Score: hit.Score, ID: hit.ID}
+}
+
+// Encode returns the cursor as an opaque string
+func (c Cursor) Encode() string {
+	freqs := make([]string, len(c.Ranking.DocFreqs))
+	for i, df := range c.Ranking.DocFreqs {
+		freqs[i] = strconv.Itoa(df)
+	}
+	raw := "search:" + strconv.FormatInt(c.Ranking.At.UnixNano(), 10) + ":" +
+		strconv.Itoa(c.Ranking.Docs) + ":" + strconv.Itoa(c.Ranking.TotalLen) + ":" + strings.Join(freqs, ",") + ":" +
+		strconv.FormatUint(math.Float64bits(c.Score), 16) + ":" + c.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeCursor parses a cursor produced by Cursor.Encode
+func DecodeCursor(s string) (Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	fields := strings.SplitN(string(raw), ":", 7)
+	if len(fields) != 7 || fields[0] != "search" || fields[6] == "" {
+		return Cursor{}, ErrInvalidCursor
+	}
+	nanos, err := strconv.ParseInt(fields[1], 10, 64)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+	docs, err := strconv.Atoi(fields[2])
+	if err != nil || docs < 1 {
+		return Cursor{}, ErrInvalidCursor
+	}
+	totalLen, err := strconv.Atoi(fields[3])
+	if err != nil || totalLen < 0 {
+		return Cursor{}, ErrInvalidCursor
+	}
+	var freqs []int
+	if fields[4] != "" {
+		for _, f := range strings.Split(fields[4], ",") {
+			df, err := strconv.Atoi(f)
+			if err != nil || df < 0 || df > docs {
+				return Cursor{}, ErrInvalidCursor
+			}
+			freqs = append(freqs, df)
+		}
+	}
+	bits, err := strconv.ParseUint(fields[5], 16, 64)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	return Cursor{
+		Ranking: Ranking{At: time.Unix(0, nanos), Docs: docs, TotalLen: totalLen, DocFreqs: freqs},
+		Score:   math.Float64frombits(bits),
+		ID:      fields[6],
+	}, nil
+}
+
+// After reports whether hit comes after the cursor in ranked order
+func (c Cursor) After(hit Hit) bool {
+	if hit.Score != c.Score {
+		return hit.Score < c.Score
+	}
+	return hit.ID < c.ID
+}
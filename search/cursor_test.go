@@ -0,0 +1,107 @@
+package search
+
+import (
+	"encoding/base64"
+	"fmt"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestCursorEncoding(t *testing.T) {
+	for _, c := range []Cursor{
+		{Ranking: Ranking{At: searchNow, Docs: 40, TotalLen: 310, DocFreqs: []int{3, 12}}, Score: 1.0 / 3, ID: "post:12"},
+		{Ranking: Ranking{At: searchNow, Docs: 1}, Score: 2, ID: "post1"}, // Filters only
+	} {
+		got, err := DecodeCursor(c.Encode())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got.Ranking.At.Equal(c.Ranking.At) {
+			t.Errorf("decoded ranking time %v, want %v", got.Ranking.At, c.Ranking.At)
+		}
+		got.Ranking.At = c.Ranking.At
+		if !reflect.DeepEqual(got, c) {
+			t.Errorf("DecodeCursor(Encode()) = %+v, want %+v", got, c)
+		}
+	}
+
+	encode := func(raw string) string { return base64.RawURLEncoding.EncodeToString([]byte(raw)) }
+	for _, s := range []string{
+		"",
+		"not base64!",
+		encode("timeline:1:2:3::0:post1"),
+		encode("search:1:2:3::0"),
+		encode("search:1:2:3::0:"),
+		encode("search:x:2:3::0:post1"),
+		encode("search:1:0:3::0:post1"),  // No documents
+		encode("search:1:2:-1::0:post1"), // Negative length
+		encode("search:1:2:3:1,x:0:post1"),
+		encode("search:1:2:3:3:0:post1"), // More documents with a term than in all
+		encode("search:1:2:3::z:post1"),
+	} {
+		if _, err := DecodeCursor(s); err != ErrInvalidCursor {
+			t.Errorf("DecodeCursor(%q) = %v, want %v", s, err, ErrInvalidCursor)
+		}
+	}
+}
+
+func TestCursorPaging(t *testing.T) {
+	for _, size := range []int{1, 4, 5, 25, 30} {
+		t.Run(fmt.Sprint(size), func(t *testing.T) {
+			ix := NewIndex(DefaultConfig())
+			// Some posts score the same, so pages have to split ties, and
+			// lengths differ, so the order depends on the average length
+			for i := range 25 {
+				text := "Weekend plans"
+				if i%3 == 0 {
+					text += " and more plans for the evening"
+				}
+				ix.Add(Document{
+					ID:        fmt.Sprintf("post%02d", i),
+					AuthorID:  "alice",
+					Text:      text,
+					CreatedAt: searchNow.Add(-time.Duration(i%5) * time.Hour),
+				})
+			}
+			q, err := ParseQuery("weekend plans")
+			if err != nil {
+				t.Fatal(err)
+			}
+			all := hitIDs(ix.Search(q, ix.RankingFor(q, searchNow)))
+			if len(all) != 25 {
+				t.Fatalf("got %d hits, want 25", len(all))
+			}
+
+			var paged []string
+			ranking := ix.RankingFor(q, searchNow)
+			var cursor *Cursor
+			for {
+				hits := ix.Search(q, ranking)
+				if cursor != nil {
+					hits = slices.DeleteFunc(hits, func(h Hit) bool { return !cursor.After(h) })
+				}
+				page := hits[:min(size, len(hits))]
+				if len(page) == 0 {
+					break
+				}
+				paged = append(paged, hitIDs(page)...)
+
+				c, err := DecodeCursor(CursorFor(page[len(page)-1], ranking).Encode())
+				if err != nil {
+					t.Fatal(err)
+				}
+				cursor, ranking = &c, c.Ranking
+
+				// Posts written between pages, which don't match, change
+				// the index statistics but not the order of later pages
+				ix.Add(Document{ID: fmt.Sprintf("new%02d", len(paged)), AuthorID: "bob", Text: "Plans", CreatedAt: searchNow.Add(-48 * time.Hour)})
+				ix.Add(Document{ID: fmt.Sprintf("long%02d", len(paged)), AuthorID: "bob", Text: "Nothing to do with the query at all, just a lot of words", CreatedAt: searchNow})
+			}
+			if !slices.Equal(paged, all) {
+				t.Errorf("pages of %d = %v, want %v", size, paged, all)
+			}
+		})
+	}
+}
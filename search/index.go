@@ -0,0 +1,326 @@
+// Package search is an embedded full-text index of posts. Text is split
+// into words, lowercased, stemmed for English and stripped of stop words,
+// and kept in an inverted index with word positions for phrase queries.
+// Results are ranked by BM25 with a boost for recent posts.
+package search
+
+import (
+	"math"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Config controls ranking
+type Config struct {
+	// BM25 parameters: K1 is how quickly repeated words stop adding to a
+	// post's score, B how much long posts are penalized
+	K1 float64
+	B  float64
+
+	// A post gets up to RecencyWeight times its score on top of it for
+	// being new, halving every RecencyHalfLife
+	RecencyWeight   float64
+	RecencyHalfLife time.Duration
+}
+
+// DefaultConfig returns the configuration used by the post service
+func DefaultConfig() Config {
+	return Config{
+		K1:              1.2,
+		B:               0.75,
+		RecencyWeight:   1,
+		RecencyHalfLife: 7 * 24 * time.Hour,
+	}
+}
+
+// Document is a post as it is indexed
+type Document struct {
+	ID        string
+	AuthorID  string
+	Text      string
+	CreatedAt time.Time
+	Features  []Feature
+}
+
+// indexedDoc is what the index keeps of a document besides its postings
+type indexedDoc struct {
+	authorID  string
+	createdAt time.Time
+	features  []Feature
+	length    int      // Indexed words
+	terms     []string // Distinct terms, for removing the document
+}
+
+// Index is an inverted index of documents. It is safe for concurrent use.
+type Index struct {
+	cfg Config
+
+	mu       sync.RWMutex
+	docs     map[string]*indexedDoc
+	postings map[string]map[string][]int // Term to document ID to the term's positions
+	totalLen int                         // Indexed words over all documents
+}
+
+// NewIndex creates an empty index
+func NewIndex(cfg Config) *Index {
+	return &Index{
+		cfg:      cfg,
+		docs:     make(map[string]*indexedDoc),
+		postings: make(map[string]map[string][]int),
+	}
+}
+
+// Len returns the number of documents indexed
+func (ix *Index) Len() int {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	return len(ix.docs)
+}
+
+// Add indexes a document, replacing any earlier version of it
+func (ix *Index) Add(doc Document) {
+	tokens := Tokenize(doc.Text)
+	positions := make(map[string][]int)
+	var terms []string
+	for _, t := range tokens {
+		if _, seen := positions[t.Term]; !seen {
+			terms = append(terms, t.Term)
+		}
+		positions[t.Term] = append(positions[t.Term], t.Position)
+	}
+
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	ix.remove(doc.ID)
+	ix.docs[doc.ID] = &indexedDoc{
+		authorID:  doc.AuthorID,
+		createdAt: doc.CreatedAt,
+		features:  slices.Clone(doc.Features),
+		length:    len(tokens),
+		terms:     terms,
+	}
+	ix.totalLen += len(tokens)
+	for term, p := range positions {
+		if ix.postings[term] == nil {
+			ix.postings[term] = make(map[string][]int)
+		}
+		ix.postings[term][doc.ID] = p
+	}
+}
+
+// Remove drops a document from the index, if it is there
+func (ix *Index) Remove(id string) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	ix.remove(id)
+}
+
+// remove drops a document. Must be called with mu held for writing.
+func (ix *Index) remove(id string) {
+	doc, exists := ix.docs[id]
+	if !exists {
+		return
+	}
+	for _, term := range doc.terms {
+		delete(ix.postings[term], id)
+		if len(ix.postings[term]) == 0 {
+			delete(ix.postings, term)
+		}
+	}
+	ix.totalLen -= doc.length
+	delete(ix.docs, id)
+}
+
+// Hit is a document matching a query
+type Hit struct {
+	ID    string
+	Score float64
+}
+
+// Ranking is what scores depend on besides the documents themselves: the
+// time recency is measured from, and the index statistics BM25 weighs
+// terms and lengths by. Later pages of results are ranked as the first
+// one was, so posts coming and going don't shift the order under a cursor.
+type Ranking struct {
+	At       time.Time
+	Docs     int   // Documents indexed
+	TotalLen int   // Indexed words over all documents
+	DocFreqs []int // Documents containing each term of the query
+}
+
+// RankingFor returns the ranking of q's results as of now
+func (ix *Index) RankingFor(q *Query, now time.Time) Ranking {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	return ix.rankingFor(q.scoredTerms(), now)
+}
+
+// rankingFor returns the ranking of terms as of now. The time is kept
+// without a monotonic clock reading, as a cursor keeps it, so ages and
+// scores come out the same on every page. Must be called with mu held.
+func (ix *Index) rankingFor(terms []string, now time.Time) Ranking {
+	r := Ranking{At: now.Round(0), Docs: len(ix.docs), TotalLen: ix.totalLen}
+	for _, term := range terms {
+		r.DocFreqs = append(r.DocFreqs, len(ix.postings[term]))
+	}
+	return r
+}
+
+// Search returns the documents matching q, best first, as ranked by r.
+// Documents with equal scores are ordered by ID, so the order is total. A
+// ranking that isn't of q's terms, as from a cursor of another query, is
+// replaced by the current one. A query with neither words nor filters
+// matches nothing.
+func (ix *Index) Search(q *Query, r Ranking) []Hit {
+	terms := q.scoredTerms()
+	filtered := q.From != "" || len(q.Has) > 0 || !q.Since.IsZero() || !q.Until.IsZero()
+	if len(terms) == 0 && !filtered {
+		return nil
+	}
+
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	if len(r.DocFreqs) != len(terms) || r.Docs == 0 {
+		r = ix.rankingFor(terms, r.At)
+	}
+
+	var hits []Hit
+	for id, doc := range ix.candidates(terms) {
+		if !q.matches(id, doc, ix.postings) {
+			continue
+		}
+		hits = append(hits, Hit{ID: id, Score: ix.score(terms, id, doc, r)})
+	}
+
+	slices.SortFunc(hits, func(a, b Hit) int {
+		if a.Score != b.Score {
+			if a.Score > b.Score {
+				return -1
+			}
+			return 1
+		}
+		if a.ID > b.ID {
+			return -1
+		}
+		if a.ID < b.ID {
+			return 1
+		}
+		return 0
+	})
+	return hits
+}
+
+// candidates returns the documents containing every term, or every
+// document if there are no terms. Must be called with mu held.
+func (ix *Index) candidates(terms []string) map[string]*indexedDoc {
+	if len(terms) == 0 {
+		return ix.docs
+	}
+
+	// Walk the shortest postings list, checking the others
+	rarest := terms[0]
+	for _, term := range terms[1:] {
+		if len(ix.postings[term]) < len(ix.postings[rarest]) {
+			rarest = term
+		}
+	}
+
+	docs := make(map[string]*indexedDoc)
+	for id := range ix.postings[rarest] {
+		all := true
+		for _, term := range terms {
+			if _, exists := ix.postings[term][id]; !exists {
+				all = false
+				break
+			}
+		}
+		if all {
+			docs[id] = ix.docs[id]
+		}
+	}
+	return docs
+}
+
+// matches reports whether a document passes the filters of q and contains
+// its phrases. Terms were checked when picking candidates.
+func (q *Query) matches(id string, doc *indexedDoc, postings map[string]map[string][]int) bool {
+	if q.From != "" && doc.authorID != q.AuthorID {
+		return false
+	}
+	if !q.Since.IsZero() && doc.createdAt.Before(q.Since) {
+		return false
+	}
+	if !q.Until.IsZero() && !doc.createdAt.Before(q.Until) {
+		return false
+	}
+	for _, feature := range q.Has {
+		if !slices.Contains(doc.features, feature) {
+			return false
+		}
+	}
+	for _, phrase := range q.Phrases {
+		if !containsPhrase(id, phrase, postings) {
+			return false
+		}
+	}
+	return true
+}
+
+// containsPhrase reports whether a document has the words of phrase at
+// the same distances from each other
+func containsPhrase(id string, phrase []Token, postings map[string]map[string][]int) bool {
+	for _, start := range postings[phrase[0].Term][id] {
+		found := true
+		for _, t := range phrase[1:] {
+			if _, at := slices.BinarySearch(postings[t.Term][id], start+t.Position); !at {
+				found = false
+				break
+			}
+		}
+		if found {
+			return true
+		}
+	}
+	return false
+}
+
+// score ranks a matching document: the BM25 score of its terms, or one
+// for a query of filters only, boosted by how recent it is. Must be
+// called with mu held.
+func (ix *Index) score(terms []string, id string, doc *indexedDoc, r Ranking) float64 {
+	score := 1.0
+	if len(terms) > 0 {
+		score = ix.bm25(terms, id, doc, r)
+	}
+
+	age := max(r.At.Sub(doc.createdAt), 0)
+	recency := math.Exp2(-float64(age) / float64(ix.cfg.RecencyHalfLife))
+	return score * (1 + ix.cfg.RecencyWeight*recency)
+}
+
+// bm25 scores how well a document matches terms, weighing terms rare as
+// of r higher and discounting documents long for r. Must be called with
+// mu held.
+func (ix *Index) bm25(terms []string, id string, doc *indexedDoc, r Ranking) float64 {
+	n := float64(r.Docs)
+	avgLen := float64(r.TotalLen) / n
+	lengthNorm := 1 - ix.cfg.B
+	if avgLen > 0 {
+		lengthNorm += ix.cfg.B * float64(doc.length) / avgLen
+	}
+
+	var score float64
+	for i, term := range terms {
+		df := float64(r.DocFreqs[i])
+		idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+		tf := float64(len(ix.postings[term][id]))
+		score += idf * tf * (ix.cfg.K1 + 1) / (tf + ix.cfg.K1*lengthNorm)
+	}
+	return score
+}
@@ -0,0 +1,162 @@
+package search
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+// searchNow is the fixed time searches are tested at
+var searchNow = time.Date(2024, time.March, 15, 12, 0, 0, 0, time.UTC)
+
+// hitIDs returns the IDs of hits, in order
+func hitIDs(hits []Hit) []string {
+	ids := make([]string, len(hits))
+	for i, h := range hits {
+		ids[i] = h.ID
+	}
+	return ids
+}
+
+// search parses and runs query against ix, resolving from: as the user
+// IDs are the usernames
+func search(t *testing.T, ix *Index, query string) []Hit {
+	t.Helper()
+	q, err := ParseQuery(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q.AuthorID = q.From
+	return ix.Search(q, ix.RankingFor(q, searchNow))
+}
+
+func TestSearch(t *testing.T) {
+	ix := NewIndex(DefaultConfig())
+	for _, doc := range []Document{
+		{ID: "fox", AuthorID: "alice", Text: "The quick brown fox jumps over the lazy dog", CreatedAt: searchNow.Add(-time.Hour)},
+		{ID: "reversed", AuthorID: "bob", Text: "A brown and quick fox", CreatedAt: searchNow.Add(-time.Hour)},
+		{ID: "photo", AuthorID: "alice", Text: "Foxes in the garden again", CreatedAt: day(t, "2024-03-01"),
+			Features: []Feature{FeatureImage, FeatureMedia}},
+		{ID: "poll", AuthorID: "bob", Text: "Cats or dogs?", CreatedAt: day(t, "2024-02-10"), Features: []Feature{FeaturePoll}},
+		{ID: "link", AuthorID: "charlie", Text: "Dog training tips https://example.com", CreatedAt: day(t, "2024-03-10"),
+			Features: []Feature{FeatureLink}},
+	} {
+		ix.Add(doc)
+	}
+
+	tests := []struct {
+		query string
+		want  []string // In any order
+	}{
+		{"fox", []string{"fox", "reversed", "photo"}},
+		{"FOXES", []string{"fox", "reversed", "photo"}},
+		{"quick fox", []string{"fox", "reversed"}},
+		{"dog", []string{"fox", "poll", "link"}},
+		{"the", nil},
+		{"unicorn", nil},
+		{"fox unicorn", nil},
+		{`"quick brown fox"`, []string{"fox"}},
+		{`"brown quick"`, nil},
+		{`"lazy dogs"`, []string{"fox"}},
+		{`"jumps the lazy"`, nil},
+		{`"jumps over the lazy"`, []string{"fox"}},
+		{"from:alice", []string{"fox", "photo"}},
+		{"from:alice fox", []string{"fox", "photo"}},
+		{"from:bob fox", []string{"reversed"}},
+		{"from:nobody", nil},
+		{"has:image", []string{"photo"}},
+		{"has:media fox", []string{"photo"}},
+		{"has:poll", []string{"poll"}},
+		{"has:link dog", []string{"link"}},
+		{"has:image has:poll", nil},
+		{"since:2024-03-01", []string{"fox", "reversed", "photo", "link"}},
+		{"until:2024-03-01", []string{"poll"}},
+		{"since:2024-03-01 until:2024-03-11 dog", []string{"link"}},
+		{"since:2024-03-02 until:2024-03-02", nil},
+	}
+	for _, tt := range tests {
+		got := hitIDs(search(t, ix, tt.query))
+		slices.Sort(got)
+		want := slices.Clone(tt.want)
+		slices.Sort(want)
+		if !slices.Equal(got, want) {
+			t.Errorf("Search(%q) = %v, want %v", tt.query, got, want)
+		}
+	}
+}
+
+func TestSearchScoreOrder(t *testing.T) {
+	ix := NewIndex(DefaultConfig())
+	add := func(id, text string, age time.Duration) {
+		ix.Add(Document{ID: id, AuthorID: "alice", Text: text, CreatedAt: searchNow.Add(-age)})
+	}
+	add("once", "Soup for lunch and then a long walk in the park", time.Hour)
+	add("twice", "Soup, soup and more soup", time.Hour)
+	add("short", "Soup", time.Hour)
+	add("old", "Soup", 30*24*time.Hour)
+	add("rare", "Soup with saffron", time.Hour)
+	add("filler1", "Bread", time.Hour)
+	add("filler2", "Bread and butter", time.Hour)
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		// Repeats outscore a single mention, short posts long ones, and new
+		// posts the same post a month old, which still beats a long new one
+		{"soup", []string{"twice", "short", "rare", "old", "once"}},
+		// The rarer word weighs more
+		{"soup saffron", []string{"rare"}},
+		// Filters alone score every match the same, bar recency; ties go
+		// to the higher ID
+		{"from:alice since:2024-03-14", []string{"twice", "short", "rare", "once", "filler2", "filler1"}},
+	}
+	for _, tt := range tests {
+		hits := search(t, ix, tt.query)
+		if got := hitIDs(hits); !slices.Equal(got, tt.want) {
+			t.Errorf("Search(%q) = %v, want %v", tt.query, got, tt.want)
+		}
+		for i := 1; i < len(hits); i++ {
+			if hits[i].Score > hits[i-1].Score {
+				t.Errorf("Search(%q): %s scores above %s before it", tt.query, hits[i].ID, hits[i-1].ID)
+			}
+		}
+	}
+}
+
+func TestIndexReplaceAndRemove(t *testing.T) {
+	ix := NewIndex(DefaultConfig())
+	ix.Add(Document{ID: "post1", AuthorID: "alice", Text: "Hello world", CreatedAt: searchNow})
+	ix.Add(Document{ID: "post2", AuthorID: "bob", Text: "Hello there", CreatedAt: searchNow})
+
+	// An edit replaces the words indexed for the post
+	ix.Add(Document{ID: "post1", AuthorID: "alice", Text: "Goodbye world", CreatedAt: searchNow})
+	if ix.Len() != 2 {
+		t.Errorf("Len() = %d after an edit, want 2", ix.Len())
+	}
+	if got := hitIDs(search(t, ix, "hello")); !slices.Equal(got, []string{"post2"}) {
+		t.Errorf("hello = %v after the edit, want [post2]", got)
+	}
+	if got := hitIDs(search(t, ix, "goodbye")); !slices.Equal(got, []string{"post1"}) {
+		t.Errorf("goodbye = %v after the edit, want [post1]", got)
+	}
+
+	ix.Remove("post1")
+	ix.Remove("post1")
+	if ix.Len() != 1 {
+		t.Errorf("Len() = %d after removing, want 1", ix.Len())
+	}
+	if got := search(t, ix, "world"); len(got) != 0 {
+		t.Errorf("world = %v after removing post1, want nothing", hitIDs(got))
+	}
+}
+
+// day parses a since: style date
+func day(t *testing.T, s string) time.Time {
+	t.Helper()
+	d, err := time.Parse(dateLayout, s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
@@ -0,0 +1,180 @@
+package search
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// dateLayout is how since: and until: dates are written
+const dateLayout = "2006-01-02"
+
+var ErrEmptyQuery = errors.New("empty search query")
+
+// Feature is something a post has besides text, as asked for with has:
+type Feature string
+
+const (
+	FeatureImage Feature = "image" // Image attachments or links
+	FeatureMedia Feature = "media" // Attachments of any kind
+	FeatureLink  Feature = "link"  // Links of any kind
+	FeaturePoll  Feature = "poll"
+)
+
+// Query is a parsed search query. Posts must contain every term and
+// phrase and pass every filter.
+type Query struct {
+	Terms   []string  // Stemmed words, each of which must appear
+	Phrases [][]Token // Words that must appear in this order, positions relative to the first
+	From    string    // Username of the author, from from:
+	Has     []Feature // From has:
+	Since   time.Time // Inclusive, from since:
+	Until   time.Time // Exclusive, from until:
+
+	// AuthorID is the user From names. The index knows authors by ID, so
+	// callers resolve From before searching.
+	AuthorID string
+}
+
+// ParseQuery parses a search query. Words are matched in any order and
+// "quoted phrases" in the order given. These operators filter results:
+//
+//	from:username      posts by a user
+//	has:image          posts with an image; also has:media, has:link, has:poll
+//	since:2006-01-02   posts from that day on, in UTC
+//	until:2006-01-02   posts from before that day, in UTC
+//
+// An unknown operator is searched for as words.
+func ParseQuery(s string) (*Query, error) {
+	q := &Query{}
+	empty := true
+	for _, part := range splitQuery(s) {
+		empty = false
+		if part.quoted {
+			q.addPhrase(Tokenize(part.text))
+			continue
+		}
+
+		op, value, isOp := strings.Cut(part.text, ":")
+		if !isOp || value == "" {
+			q.addWords(part.text)
+			continue
+		}
+		switch strings.ToLower(op) {
+		case "from":
+			q.From = strings.TrimPrefix(value, "@")
+		case "has":
+			feature := Feature(strings.ToLower(value))
+			switch feature {
+			case FeatureImage, FeatureMedia, FeatureLink, FeaturePoll:
+				q.Has = append(q.Has, feature)
+			default:
+				return nil, fmt.Errorf("unknown has: filter %q", value)
+			}
+		case "since", "until":
+			day, err := time.Parse(dateLayout, value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s: date %q, want YYYY-MM-DD", op, value)
+			}
+			if strings.EqualFold(op, "since") {
+				q.Since = day
+			} else {
+				q.Until = day
+			}
+		default:
+			q.addWords(part.text)
+		}
+	}
+	if empty {
+		return nil, ErrEmptyQuery
+	}
+	return q, nil
+}
+
+// addWords adds the words of unquoted text. Words joined by punctuation,
+// like "e-mail", must appear together, as if quoted.
+func (q *Query) addWords(text string) {
+	tokens := Tokenize(text)
+	if len(words(text)) > 1 {
+		q.addPhrase(tokens)
+		return
+	}
+	for _, t := range tokens {
+		q.Terms = append(q.Terms, t.Term)
+	}
+}
+
+// addPhrase adds a phrase, or a term if only one word of it is indexed
+func (q *Query) addPhrase(tokens []Token) {
+	switch len(tokens) {
+	case 0:
+		return
+	case 1:
+		q.Terms = append(q.Terms, tokens[0].Term)
+		return
+	}
+
+	phrase := make([]Token, len(tokens))
+	for i, t := range tokens {
+		phrase[i] = Token{Term: t.Term, Position: t.Position - tokens[0].Position}
+	}
+	q.Phrases = append(q.Phrases, phrase)
+}
+
+// scoredTerms returns every term of the query, including those of
+// phrases, once each in the order they appear
+func (q *Query) scoredTerms() []string {
+	seen := make(map[string]bool)
+	var terms []string
+	add := func(term string) {
+		if !seen[term] {
+			seen[term] = true
+			terms = append(terms, term)
+		}
+	}
+	for _, term := range q.Terms {
+		add(term)
+	}
+	for _, phrase := range q.Phrases {
+		for _, t := range phrase {
+			add(t.Term)
+		}
+	}
+	return terms
+}
+
+// queryPart is a word, operator or quoted phrase of a query
+type queryPart struct {
+	text   string
+	quoted bool
+}
+
+// splitQuery splits a query at spaces outside of double quotes. An
+// unterminated quote runs to the end of the query.
+func splitQuery(s string) []queryPart {
+	var parts []queryPart
+	for {
+		s = strings.TrimLeftFunc(s, unicode.IsSpace)
+		if s == "" {
+			return parts
+		}
+
+		if rest, isQuoted := strings.CutPrefix(s, `"`); isQuoted {
+			text, after, _ := strings.Cut(rest, `"`)
+			if strings.TrimSpace(text) != "" {
+				parts = append(parts, queryPart{text: text, quoted: true})
+			}
+			s = after
+			continue
+		}
+
+		end := strings.IndexFunc(s, unicode.IsSpace)
+		if end < 0 {
+			end = len(s)
+		}
+		parts = append(parts, queryPart{text: s[:end]})
+		s = s[end:]
+	}
+}
@@ -0,0 +1,51 @@
+package search
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseQuery(t *testing.T) {
+	tests := []struct {
+		query string
+		want  *Query
+	}{
+		{"running dogs", &Query{Terms: []string{"run", "dog"}}},
+		{"the dogs of war", &Query{Terms: []string{"dog", "war"}}},
+		{`"the quick brown fox"`, &Query{Phrases: [][]Token{{{"quick", 0}, {"brown", 1}, {"fox", 2}}}}},
+		{`"fox of the woods"`, &Query{Phrases: [][]Token{{{"fox", 0}, {"wood", 3}}}}},
+		{`"foxes"`, &Query{Terms: []string{"fox"}}},
+		{`cat "unterminated phrase`, &Query{Terms: []string{"cat"}, Phrases: [][]Token{{{"untermin", 0}, {"phrase", 1}}}}},
+		{"e-mail", &Query{Phrases: [][]Token{{{"e", 0}, {"mail", 1}}}}},
+		{"from:@Alice cats", &Query{Terms: []string{"cat"}, From: "Alice"}},
+		{"FROM:bob", &Query{From: "bob"}},
+		{"has:image has:POLL", &Query{Has: []Feature{FeatureImage, FeaturePoll}}},
+		{"has:media has:link", &Query{Has: []Feature{FeatureMedia, FeatureLink}}},
+		{"since:2024-03-01 until:2024-04-01", &Query{Since: day(t, "2024-03-01"), Until: day(t, "2024-04-01")}},
+		{"color:red", &Query{Phrases: [][]Token{{{"color", 0}, {"red", 1}}}}},
+		{"from:", &Query{}},
+		{"the", &Query{}},
+	}
+	for _, tt := range tests {
+		got, err := ParseQuery(tt.query)
+		if err != nil {
+			t.Errorf("ParseQuery(%q) error: %v", tt.query, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseQuery(%q) = %+v, want %+v", tt.query, got, tt.want)
+		}
+	}
+
+	for _, query := range []string{"has:video", "since:yesterday", "until:2024-13-01"} {
+		if _, err := ParseQuery(query); err == nil {
+			t.Errorf("ParseQuery(%q) succeeded", query)
+		}
+	}
+	for _, query := range []string{"", "   ", `""`} {
+		if _, err := ParseQuery(query); !errors.Is(err, ErrEmptyQuery) {
+			t.Errorf("ParseQuery(%q) = %v, want %v", query, err, ErrEmptyQuery)
+		}
+	}
+}
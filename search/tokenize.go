@@ -0,0 +1,88 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+// maxTokenLength caps the runes of an indexed word; longer runs of letters
+// are noise rather than words
+const maxTokenLength = 64
+
+// Token is a word of text as it is indexed: lowercased and stemmed, at
+// its position among the words of the text
+type Token struct {
+	Term     string
+	Position int
+}
+
+// Tokenize splits text into words at anything that isn't a letter or a
+// digit, lowercases and stems them, and drops stop words. Apostrophes
+// inside words are dropped along with a possessive 's, so "Alice's" and
+// "alice" match. Positions count stop words too, so phrases only match
+// words that were next to each other.
+func Tokenize(text string) []Token {
+	var tokens []Token
+	position := 0
+	for _, word := range words(text) {
+		if !stopWords[word] {
+			tokens = append(tokens, Token{Term: Stem(word), Position: position})
+		}
+		position++
+	}
+	return tokens
+}
+
+// words splits text into lowercased words
+func words(text string) []string {
+	var (
+		words []string
+		word  strings.Builder
+		runes int
+	)
+	flush := func() {
+		if runes > 0 && runes <= maxTokenLength {
+			w := strings.TrimSuffix(word.String(), "'s")
+			words = append(words, strings.ReplaceAll(w, "'", ""))
+		}
+		word.Reset()
+		runes = 0
+	}
+
+	for _, r := range text {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			word.WriteRune(unicode.ToLower(r))
+			runes++
+		case (r == '\'' || r == '’') && runes > 0:
+			word.WriteByte('\'')
+		default:
+			flush()
+		}
+	}
+	flush()
+	return words
+}
+
+// stopWords are common English words that say nothing about what a post
+// is about, so they are neither indexed nor searched for
+var stopWords = wordSet(`
+		a about above after again against all am an and any are as at
+		be because been before being below between both but by
+		can could did do does doing down during each few for from further
+		had has have having he her here hers herself him himself his how
+		i if in into is it its itself just me more most my myself
+		no nor not now of off on once only or other our ours ourselves out over own
+		same she should so some such than that the their theirs them themselves
+		then there these they this those through to too under until up
+		very was we were what when where which while who whom why will with would
+		you your yours yourself yourselves`)
+
+// wordSet makes a set of the words in a whitespace separated list
+func wordSet(list string) map[string]bool {
+	set := make(map[string]bool)
+	for _, w := range strings.Fields(list) {
+		set[w] = true
+	}
+	return set
+}
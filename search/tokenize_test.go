@@ -0,0 +1,37 @@
+package search
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		name, text string
+		want       []Token
+	}{
+		{"stems and lowercases", "Running DOGS", []Token{{"run", 0}, {"dog", 1}}},
+		{"stop words are dropped but keep their positions", "the cat and the hat",
+			[]Token{{"cat", 1}, {"hat", 4}}},
+		{"only stop words", "it is what it is", nil},
+		{"punctuation splits words", "e-mail, #golang!", []Token{{"e", 0}, {"mail", 1}, {"golang", 2}}},
+		{"possessives", "Alice's cat’s toy", []Token{{"alic", 0}, {"cat", 1}, {"toi", 2}}},
+		{"apostrophes inside words", "don't rock'n'roll", []Token{{"dont", 0}, {"rocknrol", 1}}},
+		{"leading apostrophe", "'tis", []Token{{"ti", 0}}},
+		{"digits", "top 10 in 2024", []Token{{"top", 0}, {"10", 1}, {"2024", 3}}},
+		{"other scripts", "Über Straße 東京", []Token{{"über", 0}, {"straße", 1}, {"東京", 2}}},
+		{"over-long runs aren't words", "a " + strings.Repeat("x", maxTokenLength+1) + " word",
+			[]Token{{"word", 1}}},
+		{"longest token kept", strings.Repeat("x", maxTokenLength),
+			[]Token{{strings.Repeat("x", maxTokenLength), 0}}},
+		{"empty", "", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Tokenize(tt.text); !slices.Equal(got, tt.want) {
+				t.Errorf("Tokenize(%q) = %v, want %v", tt.text, got, tt.want)
+			}
+		})
+	}
+}